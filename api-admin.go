@@ -0,0 +1,145 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// minioAdminPrefix is the path of the MinIO admin API, which is
+// signed like the S3 API but exchanges JSON documents.
+const minioAdminPrefix = "/minio/admin/v3/"
+
+// executeAdminMethod sends a request to the MinIO admin API at path
+// and decodes the JSON response into v unless v is nil.
+func (c *Client) executeAdminMethod(ctx context.Context, method, path string, query url.Values, body []byte, v interface{}) error {
+	metadata := requestMetadata{
+		adminPath:   path,
+		queryValues: query,
+	}
+	if body != nil {
+		metadata.contentBody = bytes.NewReader(body)
+		metadata.contentLength = int64(len(body))
+		metadata.contentSHA256Hex = sum256Hex(body)
+	}
+
+	resp, err := c.executeMethod(ctx, method, metadata)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return adminRespToErrorResponse(resp, query.Get("bucket"))
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// adminRespToErrorResponse returns the JSON error of a failed admin
+// API request.
+func adminRespToErrorResponse(resp *http.Response, bucketName string) error {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	errResp := ErrorResponse{
+		StatusCode: resp.StatusCode,
+		Server:     resp.Header.Get("Server"),
+		BucketName: bucketName,
+	}
+	if err = json.Unmarshal(body, &errResp); err != nil || errResp.Code == "" {
+		// Not an admin API error, e.g. the endpoint is not MinIO.
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return httpRespToErrorResponse(resp, bucketName, "")
+	}
+	return errResp
+}
+
+// Parameters of the encryption of admin API payloads carrying secrets,
+// compatible with the EncryptData function of MinIO's madmin package:
+// the payload is encrypted with a key derived from the secret key of
+// the client using Argon2id, in the DARE stream format of
+// github.com/secure-io/sio-go.
+const (
+	adminSaltSize       = 32
+	adminArgon2idAESGCM = 0x00
+	adminNonceSize      = 8
+	adminFragmentSize   = 16 << 10
+)
+
+// encryptAdminData encrypts data with a key derived from password,
+// returning salt | AEAD ID | nonce | encrypted data.
+func encryptAdminData(password string, data []byte) ([]byte, error) {
+	header := make([]byte, adminSaltSize+1+adminNonceSize)
+	if _, err := io.ReadFull(rand.Reader, header); err != nil {
+		return nil, err
+	}
+	return sealAdminData(password, header, data)
+}
+
+// sealAdminData encrypts data like encryptAdminData, with the random
+// salt and nonce of header.
+func sealAdminData(password string, header, data []byte) ([]byte, error) {
+	salt, nonce := header[:adminSaltSize], header[adminSaltSize+1:]
+	header[adminSaltSize] = adminArgon2idAESGCM
+
+	block, err := aes.NewCipher(argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every fragment is sealed with the nonce followed by its sequence
+	// number, starting at 1. The additional data of the fragments is a
+	// flag marking the final fragment followed by the tag of the empty
+	// associated data of the stream, sealed with sequence number 0.
+	fragmentNonce := make([]byte, aead.NonceSize())
+	copy(fragmentNonce, nonce)
+	additionalData := aead.Seal([]byte{0x00}, fragmentNonce, nil, nil)
+	ciphertext := header
+	for seqNum := uint32(1); ; seqNum++ {
+		fragment := data
+		if len(fragment) > adminFragmentSize {
+			fragment = fragment[:adminFragmentSize]
+		} else {
+			additionalData[0] = 0x80
+		}
+		binary.LittleEndian.PutUint32(fragmentNonce[adminNonceSize:], seqNum)
+		ciphertext = aead.Seal(ciphertext, fragmentNonce, fragment, additionalData)
+		data = data[len(fragment):]
+		if additionalData[0] == 0x80 {
+			return ciphertext, nil
+		}
+	}
+}
@@ -164,6 +164,8 @@ func (c *Client) appendObjectDo(ctx context.Context, bucketName, objectName stri
 		ChecksumSHA256:    h.Get(ChecksumSHA256.Key()),
 		ChecksumCRC64NVME: h.Get(ChecksumCRC64NVME.Key()),
 		ChecksumMode:      h.Get(ChecksumFullObjectMode.Key()),
+
+		responseHeader: uploadResponseHeader(h),
 	}, nil
 }
 
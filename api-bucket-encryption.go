@@ -38,6 +38,10 @@ func (c *Client) SetBucketEncryption(ctx context.Context, bucketName string, con
 		return errInvalidArgument("configuration cannot be empty")
 	}
 
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
 	buf, err := xml.Marshal(config)
 	if err != nil {
 		return err
@@ -132,3 +136,47 @@ func (c *Client) GetBucketEncryption(ctx context.Context, bucketName string) (*s
 
 	return encryptionConfig, nil
 }
+
+// ResolvedEncryption is the server side encryption which applies
+// to an upload.
+type ResolvedEncryption struct {
+	EncryptionInfo
+
+	// BucketDefault is set if the default encryption of the
+	// bucket applies, rather than the one of the upload.
+	BucketDefault bool
+}
+
+// GetBucketEncryptionResolved returns the server side encryption which
+// applies to an upload with opts. Unless opts carries an encryption, the
+// default encryption configuration of the bucket applies, if any.
+func (c *Client) GetBucketEncryptionResolved(ctx context.Context, bucketName string, opts PutObjectOptions) (ResolvedEncryption, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return ResolvedEncryption{}, err
+	}
+	if opts.ServerSideEncryption != nil {
+		h := make(http.Header)
+		opts.ServerSideEncryption.Marshal(h)
+		return ResolvedEncryption{EncryptionInfo: encryptionInfo(h)}, nil
+	}
+
+	config, err := c.GetBucketEncryption(ctx, bucketName)
+	if err != nil {
+		if ToErrorResponse(err).Code == "ServerSideEncryptionConfigurationNotFoundError" {
+			return ResolvedEncryption{}, nil
+		}
+		return ResolvedEncryption{}, err
+	}
+	rule, ok := config.Default()
+	if !ok {
+		return ResolvedEncryption{}, nil
+	}
+	return ResolvedEncryption{
+		EncryptionInfo: EncryptionInfo{
+			Algorithm:        rule.Apply.SSEAlgorithm,
+			KMSKeyID:         rule.Apply.KmsMasterKeyID,
+			BucketKeyEnabled: rule.BucketKeyEnabled && rule.Apply.IsKMS(),
+		},
+		BucketDefault: true,
+	}, nil
+}
@@ -0,0 +1,89 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/sse"
+)
+
+func TestGetBucketEncryptionResolved(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	resolved, err := clnt.GetBucketEncryptionResolved(ctx, "bucket", PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.IsEncrypted() || resolved.BucketDefault {
+		t.Fatalf("expected no encryption, got %+v", resolved)
+	}
+
+	config := sse.NewConfigurationSSEKMSBucketKey("bucket-key")
+	if err = config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.SetBucketEncryption(ctx, "bucket", config); err != nil {
+		t.Fatal(err)
+	}
+	got, err := clnt.GetBucketEncryption(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule, ok := got.Default(); !ok || !rule.BucketKeyEnabled || rule.Apply.KmsMasterKeyID != "bucket-key" {
+		t.Fatalf("unexpected configuration %+v", got)
+	}
+
+	resolved, err = clnt.GetBucketEncryptionResolved(ctx, "bucket", PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ResolvedEncryption{
+		EncryptionInfo: EncryptionInfo{Algorithm: sse.AlgorithmKMS, KMSKeyID: "bucket-key", BucketKeyEnabled: true},
+		BucketDefault:  true,
+	}
+	if resolved != want || resolved.Type() != encrypt.KMS {
+		t.Fatalf("expected %+v, got %+v", want, resolved)
+	}
+
+	// The encryption of the upload takes precedence.
+	resolved, err = clnt.GetBucketEncryptionResolved(ctx, "bucket", PutObjectOptions{ServerSideEncryption: encrypt.NewSSE()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.BucketDefault || resolved.Type() != encrypt.S3 {
+		t.Fatalf("expected SSE-S3 of the upload, got %+v", resolved)
+	}
+
+	invalid := []*sse.Configuration{
+		{},
+		{Rules: []sse.Rule{{Apply: sse.ApplySSEByDefault{SSEAlgorithm: "rot13"}}}},
+		{Rules: []sse.Rule{{Apply: sse.ApplySSEByDefault{SSEAlgorithm: sse.AlgorithmAES256}, BucketKeyEnabled: true}}},
+	}
+	for i, config := range invalid {
+		if config.Validate() == nil {
+			t.Errorf("Test %d: expected invalid configuration", i+1)
+		}
+		if clnt.SetBucketEncryption(ctx, "bucket", config) == nil {
+			t.Errorf("Test %d: expected invalid configuration to be rejected", i+1)
+		}
+	}
+}
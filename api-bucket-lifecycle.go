@@ -51,6 +51,49 @@ func (c *Client) SetBucketLifecycle(ctx context.Context, bucketName string, conf
 	return c.putBucketLifecycle(ctx, bucketName, buf)
 }
 
+// SetBucketLifecycleRule adds rule to the lifecycle configuration of the
+// bucket, replacing the rule with the same ID and keeping all other
+// rules. The configuration is validated before it is saved. It is read
+// and written back, changes made by others meanwhile are lost.
+func (c *Client) SetBucketLifecycleRule(ctx context.Context, bucketName string, rule lifecycle.Rule) error {
+	config, err := c.getBucketLifecycleOrEmpty(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if err = config.SetRule(rule); err != nil {
+		return errInvalidArgument(err.Error())
+	}
+	if err = config.Validate(); err != nil {
+		return errInvalidArgument(err.Error())
+	}
+	return c.SetBucketLifecycle(ctx, bucketName, config)
+}
+
+// RemoveBucketLifecycleRule removes the rule with the ID id from the
+// lifecycle configuration of the bucket, keeping all other rules.
+// Removing the last rule removes the configuration. It is not an error
+// if there is no such rule.
+func (c *Client) RemoveBucketLifecycleRule(ctx context.Context, bucketName, id string) error {
+	config, err := c.getBucketLifecycleOrEmpty(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if !config.RemoveRule(id) {
+		return nil
+	}
+	return c.SetBucketLifecycle(ctx, bucketName, config)
+}
+
+// getBucketLifecycleOrEmpty returns the lifecycle configuration of the
+// bucket, an empty one if it has none.
+func (c *Client) getBucketLifecycleOrEmpty(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+	config, err := c.GetBucketLifecycle(ctx, bucketName)
+	if ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+		return lifecycle.NewConfiguration(), nil
+	}
+	return config, err
+}
+
 // Saves a new bucket lifecycle.
 func (c *Client) putBucketLifecycle(ctx context.Context, bucketName string, buf []byte) error {
 	// Get resources properly escaped and lined up before
@@ -167,3 +210,22 @@ func (c *Client) getBucketLifecycle(ctx context.Context, bucketName string) ([]b
 
 	return lcBytes, updatedAt, nil
 }
+
+// PredictObjectLifecycle returns when the rules of config expire or
+// transition the object described by info, as returned by StatObject or
+// a listing. The object is taken to be the current version, use
+// lifecycle.Configuration.Predict for noncurrent versions. Tag filters
+// match info.UserTags, which S3 does not return, use GetObjectTagging
+// to fill them in.
+func PredictObjectLifecycle(config *lifecycle.Configuration, info ObjectInfo) lifecycle.Prediction {
+	return config.Predict(lifecycle.Object{
+		Name:         info.Key,
+		Size:         info.Size,
+		ModTime:      info.LastModified,
+		StorageClass: info.StorageClass,
+		UserTags:     info.UserTags,
+		IsLatest:     true,
+		DeleteMarker: info.IsDeleteMarker,
+		NumVersions:  info.NumVersions,
+	})
+}
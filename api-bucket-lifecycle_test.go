@@ -0,0 +1,102 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/lifecycle"
+)
+
+func TestSetBucketLifecycleRule(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	logs, err := lifecycle.NewRule().ID("logs").Prefix("logs/").ExpireDays(30).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp, err := lifecycle.NewRule().ID("tmp").Prefix("tmp/").ExpireDays(1).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rule := range []lifecycle.Rule{logs, tmp} {
+		if err = clnt.SetBucketLifecycleRule(ctx, "bucket", rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Updating one rule keeps the other.
+	logs.Expiration.Days = 90
+	if err = clnt.SetBucketLifecycleRule(ctx, "bucket", logs); err != nil {
+		t.Fatal(err)
+	}
+	config, err := clnt.GetBucketLifecycle(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &lifecycle.Configuration{Rules: []lifecycle.Rule{logs, tmp}}
+	if d := lifecycle.Diff(want, config); !d.IsEmpty() {
+		t.Fatalf("unexpected changes %+v", d)
+	}
+
+	// A rule conflicting with another one is rejected.
+	conflict, err := lifecycle.NewRule().ID("other").Prefix("logs/").ExpireDays(7).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = clnt.SetBucketLifecycleRule(ctx, "bucket", conflict)
+	if ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	for _, id := range []string{"tmp", "missing", "logs"} {
+		if err = clnt.RemoveBucketLifecycleRule(ctx, "bucket", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = clnt.GetBucketLifecycle(ctx, "bucket")
+	if ToErrorResponse(err).Code != "NoSuchLifecycleConfiguration" {
+		t.Fatalf("expected the configuration to be removed, got %v", err)
+	}
+}
+
+func TestPredictObjectLifecycle(t *testing.T) {
+	rule, err := lifecycle.NewRule().ID("tagged").Prefix("logs/").Tag("keep", "no").ExpireDays(1).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &lifecycle.Configuration{Rules: []lifecycle.Rule{rule}}
+	info := ObjectInfo{
+		Key:          "logs/a",
+		Size:         1,
+		LastModified: time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC),
+		UserTags:     URLMap{"keep": "no"},
+	}
+	p := PredictObjectLifecycle(config, info)
+	if want := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC); !p.Expiration.Equal(want) || p.ExpirationRuleID != "tagged" {
+		t.Fatalf("unexpected prediction %+v", p)
+	}
+
+	info.UserTags = nil
+	if p = PredictObjectLifecycle(config, info); !p.IsEmpty() {
+		t.Fatalf("expected no prediction without tags, got %+v", p)
+	}
+}
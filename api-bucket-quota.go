@@ -0,0 +1,148 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// QuotaType is the enforcement of a bucket quota.
+type QuotaType string
+
+// HardQuota rejects writes which would exceed the quota of a bucket.
+const HardQuota QuotaType = "hard"
+
+// BucketQuota is the quota of a bucket on MinIO.
+type BucketQuota struct {
+	// Size is the maximum size of the bucket in bytes, 0 if the bucket
+	// has no quota.
+	Size uint64    `json:"size"`
+	Type QuotaType `json:"quotatype,omitempty"`
+
+	// Quota is the size of the quota in older releases of MinIO.
+	Quota uint64 `json:"quota"`
+}
+
+// SetBucketQuota sets the quota of a bucket. This is a MinIO
+// extension of the admin API and requires admin credentials.
+func (c *Client) SetBucketQuota(ctx context.Context, bucketName string, quota BucketQuota) error {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if quota.Size == 0 {
+		quota.Size = quota.Quota
+	}
+	if quota.Type == "" {
+		quota.Type = HardQuota
+	}
+	quota.Quota = quota.Size
+
+	buf, err := json.Marshal(quota)
+	if err != nil {
+		return err
+	}
+	urlValues := make(url.Values)
+	urlValues.Set("bucket", bucketName)
+	return c.executeAdminMethod(ctx, http.MethodPut, "set-bucket-quota", urlValues, buf, nil)
+}
+
+// GetBucketQuota returns the quota of a bucket. MinIO returns an
+// XMinioAdminNoSuchQuotaConfiguration error if the bucket has no quota.
+func (c *Client) GetBucketQuota(ctx context.Context, bucketName string) (BucketQuota, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return BucketQuota{}, err
+	}
+
+	var quota BucketQuota
+	urlValues := make(url.Values)
+	urlValues.Set("bucket", bucketName)
+	if err := c.executeAdminMethod(ctx, http.MethodGet, "get-bucket-quota", urlValues, nil, &quota); err != nil {
+		return BucketQuota{}, err
+	}
+	if quota.Size == 0 {
+		quota.Size = quota.Quota
+	}
+	return quota, nil
+}
+
+// RemoveBucketQuota clears the quota of a bucket.
+func (c *Client) RemoveBucketQuota(ctx context.Context, bucketName string) error {
+	return c.SetBucketQuota(ctx, bucketName, BucketQuota{})
+}
+
+// BucketUsageInfo is the usage of a bucket as last computed by the
+// data scanner of MinIO.
+type BucketUsageInfo struct {
+	Size               uint64 `json:"size"`
+	ObjectsCount       uint64 `json:"objectsCount"`
+	VersionsCount      uint64 `json:"versionsCount"`
+	DeleteMarkersCount uint64 `json:"deleteMarkersCount"`
+
+	// ObjectSizesHistogram counts the objects in size ranges, e.g.
+	// "BETWEEN_1_MB_AND_10_MB".
+	ObjectSizesHistogram map[string]uint64 `json:"objectsSizesHistogram"`
+}
+
+// DataUsageInfo is the usage of all buckets of a MinIO deployment.
+type DataUsageInfo struct {
+	// LastUpdate is the time the usage was computed.
+	LastUpdate time.Time `json:"lastUpdate"`
+
+	ObjectsCount       uint64 `json:"objectsCount"`
+	VersionsCount      uint64 `json:"versionsCount"`
+	DeleteMarkersCount uint64 `json:"deleteMarkersCount"`
+	ObjectsTotalSize   uint64 `json:"objectsTotalSize"`
+	BucketsCount       uint64 `json:"bucketsCount"`
+
+	BucketsUsage map[string]BucketUsageInfo `json:"bucketsUsageInfo"`
+}
+
+// GetDataUsageInfo returns the usage of all buckets. The usage is
+// updated periodically by MinIO and may lag behind recent writes.
+// This is a MinIO extension of the admin API and requires admin
+// credentials.
+func (c *Client) GetDataUsageInfo(ctx context.Context) (DataUsageInfo, error) {
+	var info DataUsageInfo
+	if err := c.executeAdminMethod(ctx, http.MethodGet, "datausageinfo", nil, nil, &info); err != nil {
+		return DataUsageInfo{}, err
+	}
+	return info, nil
+}
+
+// GetBucketUsage returns the usage of a bucket and the time it was
+// computed, see GetDataUsageInfo. The usage of a bucket not scanned
+// yet is zero.
+func (c *Client) GetBucketUsage(ctx context.Context, bucketName string) (BucketUsageInfo, time.Time, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return BucketUsageInfo{}, time.Time{}, err
+	}
+	info, err := c.GetDataUsageInfo(ctx)
+	if err != nil {
+		return BucketUsageInfo{}, time.Time{}, err
+	}
+	return info.BucketsUsage[bucketName], info.LastUpdate, nil
+}
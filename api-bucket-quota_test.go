@@ -0,0 +1,75 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBucketQuota(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	if _, err := clnt.GetBucketQuota(ctx, "bucket"); ToErrorResponse(err).Code != "XMinioAdminNoSuchQuotaConfiguration" {
+		t.Fatalf("expected XMinioAdminNoSuchQuotaConfiguration, got %v", err)
+	}
+	if err := clnt.SetBucketQuota(ctx, "bucket", BucketQuota{Size: 1 << 30}); err != nil {
+		t.Fatal(err)
+	}
+	quota, err := clnt.GetBucketQuota(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.Size != 1<<30 || quota.Type != HardQuota {
+		t.Fatalf("unexpected quota %+v", quota)
+	}
+	if err = clnt.RemoveBucketQuota(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.GetBucketQuota(ctx, "bucket"); ToErrorResponse(err).Code != "XMinioAdminNoSuchQuotaConfiguration" {
+		t.Fatalf("expected quota to be removed, got %v", err)
+	}
+
+	err = clnt.SetBucketQuota(ctx, "missing", BucketQuota{Size: 1})
+	if resp := ToErrorResponse(err); resp.Code != "NoSuchBucket" || resp.StatusCode != 404 {
+		t.Fatalf("expected NoSuchBucket, got %#v", err)
+	}
+}
+
+func TestBucketUsage(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"a": "abc", "b/c": "de"})
+
+	usage, updated, err := clnt.GetBucketUsage(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.IsZero() || usage.Size != 5 || usage.ObjectsCount != 2 || usage.ObjectSizesHistogram["LESS_THAN_1024_B"] != 2 {
+		t.Fatalf("unexpected usage %+v", usage)
+	}
+
+	info, err := clnt.GetDataUsageInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.BucketsCount != 1 || info.ObjectsTotalSize != 5 {
+		t.Fatalf("unexpected data usage %+v", info)
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,6 +40,7 @@ func (c *Client) RemoveBucketReplication(ctx context.Context, bucketName string)
 }
 
 // SetBucketReplication sets a replication config on an existing bucket.
+// The config is validated and the bucket must have versioning enabled.
 func (c *Client) SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
@@ -48,10 +51,48 @@ func (c *Client) SetBucketReplication(ctx context.Context, bucketName string, cf
 	if cfg.Empty() {
 		return c.removeBucketReplication(ctx, bucketName)
 	}
+	if err := cfg.Validate(); err != nil {
+		return errInvalidArgument(err.Error())
+	}
+	if err := c.checkReplicationVersioning(ctx, bucketName); err != nil {
+		return err
+	}
 	// Save the updated replication.
 	return c.putBucketReplication(ctx, bucketName, cfg)
 }
 
+// SetBucketReplicationRule adds rule to the replication config of the
+// bucket, replacing the rule with the same ID and keeping all other
+// rules. It is read and written back, changes made by others meanwhile
+// are lost.
+func (c *Client) SetBucketReplicationRule(ctx context.Context, bucketName string, rule replication.Rule) error {
+	cfg, err := c.GetBucketReplication(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if err = cfg.SetRule(rule); err != nil {
+		return errInvalidArgument(err.Error())
+	}
+	return c.SetBucketReplication(ctx, bucketName, cfg)
+}
+
+// checkReplicationVersioning fails unless versioning is enabled on the
+// bucket. Callers allowed to configure replication but not to read the
+// versioning state are left to the server to check.
+func (c *Client) checkReplicationVersioning(ctx context.Context, bucketName string) error {
+	versioning, err := c.GetBucketVersioning(ctx, bucketName)
+	if ToErrorResponse(err).Code == "AccessDenied" {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !versioning.Enabled() {
+		return errInvalidArgument(fmt.Sprintf("Versioning must be enabled on bucket %s to replicate it.", bucketName))
+	}
+	return nil
+}
+
 // Saves a new bucket replication.
 func (c *Client) putBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
 	// Get resources properly escaped and lined up before
@@ -102,7 +143,8 @@ func (c *Client) removeBucketReplication(ctx context.Context, bucketName string)
 	if err != nil {
 		return err
 	}
-	if resp.StatusCode != http.StatusOK {
+	// MinIO answers with 200 OK, S3 with 204 No Content.
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		return httpRespToErrorResponse(resp, bucketName, "")
 	}
 	return nil
@@ -353,3 +395,15 @@ func (c *Client) CheckBucketReplication(ctx context.Context, bucketName string)
 	}
 	return nil
 }
+
+// ReplicationState returns the replication status of the object from
+// the X-Amz-Replication-Status header, empty if the object is not
+// replicated. The legacy COMPLETE status of older MinIO servers is
+// reported as ReplicationStatusComplete.
+func (o ObjectInfo) ReplicationState() ReplicationStatus {
+	status := ReplicationStatus(strings.ToUpper(o.ReplicationStatus))
+	if status == "COMPLETE" {
+		return ReplicationStatusComplete
+	}
+	return status
+}
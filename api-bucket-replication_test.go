@@ -0,0 +1,129 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/replication"
+)
+
+func TestReplicationResyncAndStatus(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			w.Header().Set("ETag", `"abc"`)
+			w.Header().Set(amzReplicationStatus, "COMPLETE")
+		case r.URL.Query().Has("replication-reset-status"):
+			if r.URL.Query().Get("arn") != "arn:minio:replication::id:target" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			status := replication.ResyncStarted
+			if polls.Add(1) > 2 {
+				status = replication.ResyncCompleted
+			}
+			w.Write([]byte(`{"target":[{"arn":"arn:minio:replication::id:target","resetid":"id","resyncStatus":"` + string(status) + `"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	opts := WaiterOptions{MinDelay: time.Millisecond, MaxWait: 5 * time.Second}
+	info, err := WaitUntilReplicationResyncDone(ctx, clnt, "bucket", "arn:minio:replication::id:target", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls.Load() != 3 || len(info.Targets) != 1 || info.Targets[0].Status() != replication.ResyncCompleted {
+		t.Fatalf("unexpected status %+v after %d polls", info, polls.Load())
+	}
+
+	oi, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.ReplicationState() != ReplicationStatusComplete {
+		t.Fatalf("expected %s, got %s", ReplicationStatusComplete, oi.ReplicationState())
+	}
+	if (ObjectInfo{}).ReplicationState() != "" || (ObjectInfo{ReplicationStatus: "REPLICA"}).ReplicationState() != ReplicationStatusReplica {
+		t.Fatal("unexpected replication state")
+	}
+}
+
+func TestSetBucketReplicationRule(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	dest := replication.NewTargetARN("", "id", "dest").String()
+	rule, err := replication.NewRule(dest).ID("all").Priority(1).ReplicateDeleteMarkers().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Replication requires versioning.
+	err = clnt.SetBucketReplicationRule(ctx, "bucket", rule)
+	if ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if err = clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	docs, err := replication.NewRule(dest).ID("docs").Priority(2).Prefix("docs/").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []replication.Rule{rule, docs} {
+		if err = clnt.SetBucketReplicationRule(ctx, "bucket", r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A rule with the priority of another one is rejected.
+	docs.Priority = 1
+	err = clnt.SetBucketReplicationRule(ctx, "bucket", docs)
+	if ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	cfg, err := clnt.GetBucketReplication(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Rules) != 2 || cfg.Rules[0].ID != "all" || cfg.Rules[1].Prefix() != "docs/" || cfg.Rules[1].Priority != 2 {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+
+	if err = clnt.RemoveBucketReplication(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, err = clnt.GetBucketReplication(ctx, "bucket"); err != nil || !cfg.Empty() {
+		t.Fatalf("expected no config, got %+v, %v", cfg, err)
+	}
+}
@@ -20,8 +20,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 )
@@ -33,6 +35,10 @@ func (c *Client) SetBucketVersioning(ctx context.Context, bucketName string, con
 		return err
 	}
 
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
 	buf, err := xml.Marshal(config)
 	if err != nil {
 		return err
@@ -76,7 +82,13 @@ func (c *Client) SuspendVersioning(ctx context.Context, bucketName string) error
 	return c.SetBucketVersioning(ctx, bucketName, BucketVersioningConfiguration{Status: "Suspended"})
 }
 
+// MaxExcludedPrefixes is the maximum number of prefixes that can be
+// excluded from versioning.
+const MaxExcludedPrefixes = 10
+
 // ExcludedPrefix - holds individual prefixes excluded from being versioned.
+// The prefix must end with a "/" and may contain "*" wildcards matching
+// any sequence of characters, e.g. "*/_temporary/".
 type ExcludedPrefix struct {
 	Prefix string
 }
@@ -110,6 +122,67 @@ func (b BucketVersioningConfiguration) Suspended() bool {
 	return b.Status == Suspended
 }
 
+// Validate checks the MinIO extensions of the configuration: prefixes
+// can only be excluded from versioning, as can folders, when it is
+// enabled, up to MaxExcludedPrefixes prefixes ending with a "/".
+func (b BucketVersioningConfiguration) Validate() error {
+	if len(b.ExcludedPrefixes) == 0 && !b.ExcludeFolders {
+		return nil
+	}
+	if !b.Enabled() {
+		return errInvalidArgument("Excluded prefixes and folders require versioning to be enabled.")
+	}
+	if len(b.ExcludedPrefixes) > MaxExcludedPrefixes {
+		return errInvalidArgument(fmt.Sprintf("Cannot exclude more than %d prefixes from versioning.", MaxExcludedPrefixes))
+	}
+	for _, excluded := range b.ExcludedPrefixes {
+		if !strings.HasSuffix(excluded.Prefix, "/") {
+			return errInvalidArgument(fmt.Sprintf("Excluded prefix %q must end with a '/'.", excluded.Prefix))
+		}
+	}
+	return nil
+}
+
+// Versioned tells whether versions of the object are kept, i.e. whether
+// versioning is enabled and the object is not excluded by a prefix or,
+// for folder objects ending with a "/", by ExcludeFolders.
+func (b BucketVersioningConfiguration) Versioned(objectName string) bool {
+	if !b.Enabled() {
+		return false
+	}
+	if b.ExcludeFolders && strings.HasSuffix(objectName, "/") {
+		return false
+	}
+	for _, excluded := range b.ExcludedPrefixes {
+		if matchWildcard(excluded.Prefix+"*", objectName) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchWildcard matches name against pattern, in which "*" matches
+// any sequence of characters.
+func matchWildcard(pattern, name string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == name
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(name, part)
+		if i < 0 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+	return len(name) >= len(last) && strings.HasSuffix(name, last)
+}
+
 // GetBucketVersioning gets the versioning configuration on
 // an existing bucket with a context to control cancellations and timeouts.
 func (c *Client) GetBucketVersioning(ctx context.Context, bucketName string) (BucketVersioningConfiguration, error) {
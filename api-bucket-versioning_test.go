@@ -0,0 +1,71 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestBucketVersioningExclusions(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	config := BucketVersioningConfiguration{
+		Status:           Enabled,
+		ExcludedPrefixes: []ExcludedPrefix{{Prefix: "tmp/"}, {Prefix: "*/_temporary/"}},
+		ExcludeFolders:   true,
+	}
+	if err := clnt.SetBucketVersioning(ctx, "bucket", config); err != nil {
+		t.Fatal(err)
+	}
+	got, err := clnt.GetBucketVersioning(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Enabled() || !got.ExcludeFolders || !reflect.DeepEqual(got.ExcludedPrefixes, config.ExcludedPrefixes) {
+		t.Fatalf("unexpected versioning configuration %+v", got)
+	}
+
+	for name, versioned := range map[string]bool{
+		"data/file.txt":                true,
+		"tmp/file.txt":                 false,
+		"jobs/1/_temporary/part-0":     false,
+		"jobs/1/_temporary_/part-0":    true,
+		"data/":                        false,
+		"tmp":                          true,
+		"jobs/1/2/_temporary/attempt/": false,
+	} {
+		if got.Versioned(name) != versioned {
+			t.Errorf("Versioned(%q) = %v, expected %v", name, !versioned, versioned)
+		}
+	}
+
+	invalid := []BucketVersioningConfiguration{
+		{Status: Suspended, ExcludedPrefixes: []ExcludedPrefix{{Prefix: "tmp/"}}},
+		{Status: Suspended, ExcludeFolders: true},
+		{Status: Enabled, ExcludedPrefixes: []ExcludedPrefix{{Prefix: "tmp"}}},
+		{Status: Enabled, ExcludedPrefixes: make([]ExcludedPrefix, MaxExcludedPrefixes+1)},
+	}
+	for i, config := range invalid {
+		if err = clnt.SetBucketVersioning(ctx, "bucket", config); ToErrorResponse(err).Code != "InvalidArgument" {
+			t.Errorf("Test %d: expected InvalidArgument, got %v", i+1, err)
+		}
+	}
+}
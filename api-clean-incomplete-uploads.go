@@ -0,0 +1,104 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// CleanIncompleteUploadsOptions represents options for
+// CleanIncompleteUploads call
+type CleanIncompleteUploadsOptions struct {
+	// DryRun lists the uploads which would be aborted without
+	// aborting anything.
+	DryRun bool
+
+	// OnProgress, if set, is called after each stale upload with the
+	// number of uploads aborted and failed so far. In dry-run mode
+	// aborted counts the uploads which would be aborted.
+	OnProgress func(aborted, failed int)
+}
+
+// AbortUploadError is the failure to abort an incomplete upload.
+type AbortUploadError struct {
+	Upload ObjectMultipartInfo
+	Err    error
+}
+
+// CleanIncompleteUploadsResult is the outcome of CleanIncompleteUploads.
+type CleanIncompleteUploadsResult struct {
+	// Aborted lists the uploads aborted, in dry-run mode the uploads
+	// which would be aborted.
+	Aborted []ObjectMultipartInfo
+
+	// Kept is the number of uploads which are not old enough.
+	Kept int
+
+	// Failed lists the uploads which could not be aborted.
+	Failed []AbortUploadError
+}
+
+// CleanIncompleteUploads aborts all incomplete multipart uploads below
+// prefix, recursively, which were initiated more than olderThan ago.
+// Aborting an upload removes all parts uploaded so far. Failures to
+// abort individual uploads are reported in the result, the returned
+// error is set if listing fails.
+func (c *Client) CleanIncompleteUploads(ctx context.Context, bucketName, prefix string, olderThan time.Duration, opts CleanIncompleteUploadsOptions) (CleanIncompleteUploadsResult, error) {
+	var result CleanIncompleteUploadsResult
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return result, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		return result, err
+	}
+	if olderThan < 0 {
+		return result, errInvalidArgument("Age of uploads to clean cannot be negative.")
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	p := c.ListIncompleteUploadsPaginator(bucketName, prefix, true)
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return result, err
+		}
+		for _, upload := range page {
+			if upload.Initiated.After(cutoff) {
+				result.Kept++
+				continue
+			}
+			if !opts.DryRun {
+				err = c.abortMultipartUpload(ctx, bucketName, upload.Key, upload.UploadID)
+				// Uploads completed or aborted meanwhile are gone anyway.
+				if err != nil && ToErrorResponse(err).Code != "NoSuchUpload" {
+					result.Failed = append(result.Failed, AbortUploadError{Upload: upload, Err: err})
+				}
+			}
+			if opts.DryRun || err == nil {
+				result.Aborted = append(result.Aborted, upload)
+			}
+			if opts.OnProgress != nil {
+				opts.OnProgress(len(result.Aborted), len(result.Failed))
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCleanIncompleteUploads(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	core := Core{clnt}
+	ctx := context.Background()
+	for _, key := range []string{"tmp/a", "tmp/b", "tmp/b", "keep/c"} {
+		if _, err := core.NewMultipartUpload(ctx, "bucket", key, PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	countUploads := func() int {
+		n := 0
+		for info := range clnt.ListIncompleteUploads(ctx, "bucket", "", true) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			n++
+		}
+		return n
+	}
+
+	// Nothing is an hour old yet.
+	result, err := clnt.CleanIncompleteUploads(ctx, "bucket", "tmp/", time.Hour, CleanIncompleteUploadsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Aborted) != 0 || result.Kept != 3 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	var progress int
+	result, err = clnt.CleanIncompleteUploads(ctx, "bucket", "tmp/", 0, CleanIncompleteUploadsOptions{
+		DryRun:     true,
+		OnProgress: func(aborted, _ int) { progress = aborted },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Aborted) != 3 || progress != 3 || countUploads() != 4 {
+		t.Fatalf("unexpected dry-run result %+v", result)
+	}
+
+	result, err = clnt.CleanIncompleteUploads(ctx, "bucket", "tmp/", 0, CleanIncompleteUploadsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Aborted) != 3 || len(result.Failed) != 0 || countUploads() != 1 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	if _, err = clnt.CleanIncompleteUploads(ctx, "bucket", "", -time.Second, CleanIncompleteUploadsOptions{}); err == nil {
+		t.Fatal("expected a negative age to fail")
+	}
+}
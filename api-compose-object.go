@@ -29,6 +29,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/s3name"
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 	"github.com/jie123108/minio-go/v7/pkg/tags"
 )
@@ -64,6 +65,14 @@ type CopyDestOptions struct {
 	UserTags    map[string]string
 	ReplaceTags bool
 
+	// MetadataDirective and TaggingDirective are the explicit
+	// x-amz-metadata-directive and x-amz-tagging-directive of the
+	// copy. DirectiveReplace is the same as setting ReplaceMetadata or
+	// ReplaceTags, DirectiveCopy keeps the metadata or tags of the
+	// source as is done if no directive is set.
+	MetadataDirective Directive
+	TaggingDirective  Directive
+
 	// Specifies whether you want to apply a Legal Hold to the copied object.
 	LegalHold LegalHoldStatus
 
@@ -71,9 +80,52 @@ type CopyDestOptions struct {
 	Mode            RetentionMode
 	RetainUntilDate time.Time
 
+	// NoOverwrite fails the copy with a PreconditionFailed error if
+	// the destination object exists.
+	NoOverwrite bool
+
 	Size int64 // Needs to be specified if progress bar is specified.
 	// Progress of the entire copy operation will be sent here.
 	Progress io.Reader
+
+	// Verify compares the destination with the source once copied by
+	// CopyObject, which fails with ErrCopyMismatch if they differ. The
+	// sizes and checksums of the objects are compared, and when they
+	// have no checksum in common VerifySamples byte ranges of
+	// VerifySampleSize bytes, spread over the objects and read in
+	// parallel, 8 ranges of 1 MiB by default.
+	Verify           bool
+	VerifySamples    int
+	VerifySampleSize int64
+}
+
+// Directive is the metadata or tagging directive of a copy.
+type Directive string
+
+const (
+	// DirectiveCopy copies the metadata or tags of the source.
+	DirectiveCopy Directive = "COPY"
+
+	// DirectiveReplace replaces the metadata or tags of the source by
+	// the ones of the destination options.
+	DirectiveReplace Directive = "REPLACE"
+)
+
+// IsValid - check whether this directive is valid or not.
+func (d Directive) IsValid() bool {
+	return d == DirectiveCopy || d == DirectiveReplace
+}
+
+// replaceMetadata returns true if the copy replaces the metadata of
+// the source.
+func (opts CopyDestOptions) replaceMetadata() bool {
+	return opts.ReplaceMetadata || opts.MetadataDirective == DirectiveReplace
+}
+
+// replaceTags returns true if the copy replaces the tags of the
+// source.
+func (opts CopyDestOptions) replaceTags() bool {
+	return opts.ReplaceTags || opts.TaggingDirective == DirectiveReplace
 }
 
 // Process custom-metadata to remove a `x-amz-meta-` prefix if
@@ -93,15 +145,45 @@ func filterCustomMeta(userMeta map[string]string) map[string]string {
 	return m
 }
 
+// sourceMetadata returns the user metadata and the content headers of
+// the source info, to set with ReplaceMetadata on the destination of a
+// multipart copy, which does not get the headers of the source.
+func sourceMetadata(info ObjectInfo) map[string]string {
+	meta := make(map[string]string, len(info.UserMetadata)+6)
+	for k, v := range info.UserMetadata {
+		meta[k] = v
+	}
+	for _, k := range []string{"Content-Type", "Content-Encoding", "Content-Disposition", "Content-Language", "Cache-Control", "Expires", amzWebsiteRedirectLocation} {
+		if v := info.Metadata.Get(k); v != "" {
+			meta[k] = v
+		}
+	}
+	return meta
+}
+
+// sourceTags returns the tags of the source object of info, which
+// StatObject only counts, nil if it has none.
+func (c *Client) sourceTags(ctx context.Context, bucket, object, versionID string, info ObjectInfo) (map[string]string, error) {
+	if info.UserTagCount == 0 {
+		return nil, nil
+	}
+	t, err := c.GetObjectTagging(ctx, bucket, object, GetObjectTaggingOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	return t.ToMap(), nil
+}
+
 // Marshal converts all the CopyDestOptions into their
 // equivalent HTTP header representation
 func (opts CopyDestOptions) Marshal(header http.Header) {
-	const replaceDirective = "REPLACE"
-	if opts.ReplaceTags {
-		header.Set(amzTaggingHeaderDirective, replaceDirective)
+	if opts.replaceTags() {
+		header.Set(amzTaggingHeaderDirective, string(DirectiveReplace))
 		if tags, _ := tags.NewTags(opts.UserTags, true); tags != nil {
 			header.Set(amzTaggingHeader, tags.String())
 		}
+	} else if opts.TaggingDirective == DirectiveCopy {
+		header.Set(amzTaggingHeaderDirective, string(DirectiveCopy))
 	}
 
 	if opts.LegalHold != LegalHoldStatus("") {
@@ -117,8 +199,16 @@ func (opts CopyDestOptions) Marshal(header http.Header) {
 		opts.Encryption.Marshal(header)
 	}
 
-	if opts.ReplaceMetadata {
-		header.Set("x-amz-metadata-directive", replaceDirective)
+	if opts.NoOverwrite {
+		header.Set("If-None-Match", "*")
+	}
+
+	if opts.MetadataDirective == DirectiveCopy && !opts.ReplaceMetadata {
+		header.Set("x-amz-metadata-directive", string(DirectiveCopy))
+	}
+
+	if opts.replaceMetadata() {
+		header.Set("x-amz-metadata-directive", string(DirectiveReplace))
 		for k, v := range filterCustomMeta(opts.UserMetadata) {
 			if isAmzHeader(k) || isStandardHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
 				header.Set(k, v)
@@ -141,6 +231,18 @@ func (opts CopyDestOptions) validate() (err error) {
 	if opts.Progress != nil && opts.Size < 0 {
 		return errInvalidArgument("For progress bar effective size needs to be specified")
 	}
+	if opts.MetadataDirective != "" && !opts.MetadataDirective.IsValid() {
+		return errInvalidArgument(fmt.Sprintf("Invalid metadata directive %q", opts.MetadataDirective))
+	}
+	if opts.TaggingDirective != "" && !opts.TaggingDirective.IsValid() {
+		return errInvalidArgument(fmt.Sprintf("Invalid tagging directive %q", opts.TaggingDirective))
+	}
+	if (opts.ReplaceMetadata && opts.MetadataDirective == DirectiveCopy) || (opts.ReplaceTags && opts.TaggingDirective == DirectiveCopy) {
+		return errInvalidArgument("Replace options conflict with the COPY directive")
+	}
+	if opts.VerifySamples < 0 || opts.VerifySampleSize < 0 {
+		return errInvalidArgument("Verify samples and sample size cannot be negative")
+	}
 	return nil
 }
 
@@ -156,16 +258,20 @@ type CopySrcOptions struct {
 	MatchRange           bool
 	Start, End           int64
 	Encryption           encrypt.ServerSide
+
+	// Client reads the source object if it is on another endpoint or
+	// needs other credentials than the client copying it. Such sources
+	// cannot be copied server-side, CopyObject streams them through
+	// this process with a regular, multipart if needed, upload.
+	// ComposeObject and CopyObjectPart do not support them.
+	Client *Client
 }
 
 // Marshal converts all the CopySrcOptions into their
 // equivalent HTTP header representation
 func (opts CopySrcOptions) Marshal(header http.Header) {
 	// Set the source header
-	header.Set("x-amz-copy-source", s3utils.EncodePath(opts.Bucket+"/"+opts.Object))
-	if opts.VersionID != "" {
-		header.Set("x-amz-copy-source", s3utils.EncodePath(opts.Bucket+"/"+opts.Object)+"?versionId="+opts.VersionID)
-	}
+	header.Set("x-amz-copy-source", s3name.CopySource(opts.Bucket, opts.Object, opts.VersionID))
 
 	if opts.MatchETag != "" {
 		header.Set("x-amz-copy-source-if-match", opts.MatchETag)
@@ -259,10 +365,7 @@ func (c *Client) copyObjectDo(ctx context.Context, srcBucket, srcObject, destBuc
 	}
 
 	// Set the source header
-	headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject))
-	if srcOpts.VersionID != "" {
-		headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject)+"?versionId="+srcOpts.VersionID)
-	}
+	headers.Set("x-amz-copy-source", s3name.CopySource(srcBucket, srcObject, srcOpts.VersionID))
 	// Send upload-part-copy request
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
 	defer closeResponse(resp)
@@ -285,6 +388,8 @@ func (c *Client) copyObjectDo(ctx context.Context, srcBucket, srcObject, destBuc
 		Key:          destObject,
 		ETag:         strings.Trim(cpObjRes.ETag, "\""),
 		LastModified: cpObjRes.LastModified,
+
+		ResponseHeader: extractResponseHeader(resp.Header),
 	}
 	return objInfo, nil
 }
@@ -295,7 +400,7 @@ func (c *Client) copyObjectPartDo(ctx context.Context, srcBucket, srcObject, des
 	headers := make(http.Header)
 
 	// Set source
-	headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject))
+	headers.Set("x-amz-copy-source", s3name.CopySource(srcBucket, srcObject, ""))
 
 	if startOffset < 0 {
 		return p, errInvalidArgument("startOffset must be non-negative")
@@ -377,6 +482,46 @@ func (c *Client) uploadPartCopy(ctx context.Context, bucket, object, uploadID st
 	return p, nil
 }
 
+// CopyObjectPart - creates part partNumber of the multipart upload
+// uploadID of dst by server-side copying src. If src.MatchRange is set
+// only the bytes from src.Start to src.End, inclusive, are copied. The
+// source is read with src.Encryption, which must carry the key of SSE-C
+// encrypted sources, and an SSE-C encrypted upload is written with
+// dst.Encryption. Other properties of dst are set when the upload is
+// initiated.
+//
+// Together with Core.NewMultipartUpload, Core.PutObjectPart and
+// Core.CompleteMultipartUpload objects can be spliced on the server,
+// e.g. to replace a byte range inside a large object without
+// downloading it. All parts but the last must be at least 5MiB.
+func (c *Client) CopyObjectPart(ctx context.Context, dst CopyDestOptions, uploadID string, partNumber int, src CopySrcOptions) (CompletePart, error) {
+	if err := src.validate(); err != nil {
+		return CompletePart{}, err
+	}
+	if c.isForeignSource(src) {
+		return CompletePart{}, errInvalidArgument("Sources of other clients cannot be copied as parts.")
+	}
+	if err := dst.validate(); err != nil {
+		return CompletePart{}, err
+	}
+	if uploadID == "" {
+		return CompletePart{}, errInvalidArgument("Upload ID cannot be empty.")
+	}
+	if partNumber < 1 || partNumber > maxPartsCount {
+		return CompletePart{}, errInvalidArgument(fmt.Sprintf("Part number must be between 1 and %d.", maxPartsCount))
+	}
+
+	h := make(http.Header)
+	src.Marshal(h)
+	if src.MatchRange {
+		h.Set("x-amz-copy-source-range", fmt.Sprintf("bytes=%d-%d", src.Start, src.End))
+	}
+	if dst.Encryption != nil && dst.Encryption.Type() == encrypt.SSEC {
+		dst.Encryption.Marshal(h)
+	}
+	return c.uploadPartCopy(ctx, dst.Bucket, dst.Object, uploadID, partNumber, h)
+}
+
 // ComposeObject - creates an object using server-side copying
 // of existing objects. It takes a list of source objects (with optional offsets)
 // and concatenates them into a new object using only server-side copying
@@ -391,6 +536,9 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 		if err := src.validate(); err != nil {
 			return UploadInfo{}, err
 		}
+		if c.isForeignSource(src) {
+			return UploadInfo{}, errInvalidArgument("Sources of other clients cannot be composed.")
+		}
 	}
 
 	if err := dst.validate(); err != nil {
@@ -468,14 +616,14 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	// user-metadata is specified, and there is only one source,
 	// (only) then metadata from source is copied.
 	var userMeta map[string]string
-	if dst.ReplaceMetadata {
+	if dst.replaceMetadata() {
 		userMeta = dst.UserMetadata
 	} else {
 		userMeta = srcObjectInfos[0].UserMetadata
 	}
 
 	var userTags map[string]string
-	if dst.ReplaceTags {
+	if dst.replaceTags() {
 		userTags = dst.UserTags
 	} else {
 		userTags = srcObjectInfos[0].UserTags
@@ -529,8 +677,12 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	}
 
 	// 4. Make final complete-multipart request.
+	complOpts := PutObjectOptions{ServerSideEncryption: dst.Encryption}
+	if dst.NoOverwrite {
+		complOpts.SetMatchETagExcept("*")
+	}
 	uploadInfo, err := c.completeMultipartUpload(ctx, dst.Bucket, dst.Object, uploadID,
-		completeMultipartUpload{Parts: objParts}, PutObjectOptions{ServerSideEncryption: dst.Encryption})
+		completeMultipartUpload{Parts: objParts}, complOpts)
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -17,6 +17,9 @@
 package minio
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
@@ -183,3 +186,131 @@ func TestDestOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyDirectives(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	_, err := clnt.PutObject(ctx, "bucket", "src", strings.NewReader("data"), 4, PutObjectOptions{
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := CopySrcOptions{Bucket: "bucket", Object: "src"}
+
+	// The tags are rewritten with the copy, the metadata is kept.
+	_, err = clnt.CopyObject(ctx, CopyDestOptions{
+		Bucket:            "bucket",
+		Object:            "retagged",
+		MetadataDirective: DirectiveCopy,
+		TaggingDirective:  DirectiveReplace,
+		UserTags:          map[string]string{"team": "blue", "stage": "prod"},
+	}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := clnt.StatObject(ctx, "bucket", "retagged", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otags, err := clnt.GetObjectTagging(ctx, "bucket", "retagged", GetObjectTaggingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.UserMetadata["Color"] != "blue" || otags.ToMap()["team"] != "blue" || len(otags.ToMap()) != 2 {
+		t.Fatalf("unexpected copy %+v with tags %v", st, otags.ToMap())
+	}
+
+	_, err = clnt.CopyObject(ctx, CopyDestOptions{
+		Bucket:            "bucket",
+		Object:            "remeta",
+		MetadataDirective: DirectiveReplace,
+		UserMetadata:      map[string]string{"Shape": "round"},
+	}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st, err = clnt.StatObject(ctx, "bucket", "remeta", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if st.UserMetadata["Shape"] != "round" || st.UserMetadata["Color"] != "" || st.UserTagCount != 1 {
+		t.Fatalf("unexpected copy %+v", st)
+	}
+
+	h := make(http.Header)
+	CopyDestOptions{MetadataDirective: DirectiveCopy, TaggingDirective: DirectiveCopy}.Marshal(h)
+	if h.Get("x-amz-metadata-directive") != "COPY" || h.Get(amzTaggingHeaderDirective) != "COPY" {
+		t.Fatalf("unexpected headers %v", h)
+	}
+
+	for _, dst := range []CopyDestOptions{
+		{Bucket: "bucket", Object: "x", MetadataDirective: "MERGE"},
+		{Bucket: "bucket", Object: "x", ReplaceTags: true, TaggingDirective: DirectiveCopy},
+	} {
+		if _, err = clnt.CopyObject(ctx, dst, src); err == nil {
+			t.Fatalf("expected %+v to fail", dst)
+		}
+	}
+}
+
+func TestCopyObjectPartSplice(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	core := Core{clnt}
+	ctx := context.Background()
+
+	const mib = 1 << 20
+	src := make([]byte, 11*mib)
+	for i := range src {
+		src[i] = byte(i % 251)
+	}
+	if _, err := clnt.PutObject(ctx, "bucket", "src", bytes.NewReader(src), int64(len(src)), PutObjectOptions{DisableMultipart: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replace the bytes from 5MiB to 10MiB.
+	dst := CopyDestOptions{Bucket: "bucket", Object: "dst"}
+	uploadID, err := core.NewMultipartUpload(ctx, "bucket", "dst", PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := clnt.CopyObjectPart(ctx, dst, uploadID, 1, CopySrcOptions{Bucket: "bucket", Object: "src", MatchRange: true, Start: 0, End: 5*mib - 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch := bytes.Repeat([]byte("x"), 5*mib)
+	middle, err := core.PutObjectPart(ctx, "bucket", "dst", uploadID, 2, bytes.NewReader(patch), int64(len(patch)), PutObjectPartOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tail, err := clnt.CopyObjectPart(ctx, dst, uploadID, 3, CopySrcOptions{Bucket: "bucket", Object: "src", MatchRange: true, Start: 10 * mib, End: 11*mib - 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := []CompletePart{head, {PartNumber: 2, ETag: middle.ETag}, tail}
+	if _, err = core.CompleteMultipartUpload(ctx, "bucket", "dst", uploadID, parts, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "dst", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(append(append([]byte{}, src[:5*mib]...), patch...), src[10*mib:]...)
+	if !bytes.Equal(got, want) {
+		t.Fatal("spliced object does not match")
+	}
+
+	for _, partNumber := range []int{0, maxPartsCount + 1} {
+		if _, err = clnt.CopyObjectPart(ctx, dst, uploadID, partNumber, CopySrcOptions{Bucket: "bucket", Object: "src"}); err == nil {
+			t.Fatalf("expected part number %d to fail", partNumber)
+		}
+	}
+	if _, err = clnt.CopyObjectPart(ctx, dst, "", 1, CopySrcOptions{Bucket: "bucket", Object: "src"}); err == nil {
+		t.Fatal("expected an empty upload ID to fail")
+	}
+}
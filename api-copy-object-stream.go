@@ -0,0 +1,138 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+)
+
+// isForeignSource reports whether the source of a copy is read by
+// another client, on another endpoint or with other credentials, and
+// cannot be copied server-side.
+func (c *Client) isForeignSource(src CopySrcOptions) bool {
+	s := src.Client
+	if s == nil || s == c {
+		return false
+	}
+	return s.endpointURL.String() != c.endpointURL.String() || s.credsProvider != c.credsProvider
+}
+
+// streamCopyObject copies a source read with src.Client by uploading
+// it with this client. Like a server-side copy the metadata and tags
+// of the source are kept unless replaced by dst.
+func (c *Client) streamCopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
+	getOpts := GetObjectOptions{
+		VersionID:            src.VersionID,
+		ServerSideEncryption: encrypt.SSE(src.Encryption),
+	}
+	if src.MatchETag != "" {
+		getOpts.SetMatchETag(src.MatchETag)
+	}
+	if src.NoMatchETag != "" {
+		getOpts.SetMatchETagExcept(src.NoMatchETag)
+	}
+	if !src.MatchModifiedSince.IsZero() {
+		getOpts.SetModified(src.MatchModifiedSince)
+	}
+	if !src.MatchUnmodifiedSince.IsZero() {
+		getOpts.SetUnmodified(src.MatchUnmodifiedSince)
+	}
+	if src.MatchRange {
+		if err := getOpts.SetRange(src.Start, src.End); err != nil {
+			return UploadInfo{}, err
+		}
+	}
+
+	// The info of the GET response describes the requested range.
+	obj, st, _, err := src.Client.getObject(ctx, src.Bucket, src.Object, getOpts)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer obj.Close()
+
+	putOpts := PutObjectOptions{
+		ServerSideEncryption: dst.Encryption,
+		LegalHold:            dst.LegalHold,
+		Mode:                 dst.Mode,
+		RetainUntilDate:      dst.RetainUntilDate,
+		Progress:             dst.Progress,
+	}
+	if dst.NoOverwrite {
+		putOpts.SetMatchETagExcept("*")
+	}
+	if dst.replaceMetadata() {
+		setCopyMetadata(&putOpts, dst.UserMetadata)
+	} else {
+		putOpts.ContentType = st.ContentType
+		putOpts.ContentEncoding = st.Metadata.Get("Content-Encoding")
+		putOpts.ContentDisposition = st.Metadata.Get("Content-Disposition")
+		putOpts.ContentLanguage = st.Metadata.Get("Content-Language")
+		putOpts.CacheControl = st.Metadata.Get("Cache-Control")
+		putOpts.Expires = st.Expires
+		putOpts.UserMetadata = st.UserMetadata
+	}
+	if dst.replaceTags() {
+		putOpts.UserTags = dst.UserTags
+	} else if st.UserTagCount > 0 {
+		t, err := src.Client.GetObjectTagging(ctx, src.Bucket, src.Object, GetObjectTaggingOptions{VersionID: src.VersionID})
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		putOpts.UserTags = t.ToMap()
+	}
+
+	return c.PutObject(ctx, dst.Bucket, dst.Object, obj, st.Size, putOpts)
+}
+
+// setCopyMetadata sets the metadata meta of a copy destination on opts.
+// The headers CopyDestOptions.Marshal sends as they are go to their
+// fields of opts, the others are user metadata. Headers set by other
+// options of the copy, like retention and encryption, are ignored.
+func setCopyMetadata(opts *PutObjectOptions, meta map[string]string) {
+	opts.UserMetadata = make(map[string]string, len(meta))
+	for k, v := range filterCustomMeta(meta) {
+		switch strings.ToLower(k) {
+		case "content-type":
+			opts.ContentType = v
+		case "content-encoding":
+			opts.ContentEncoding = v
+		case "content-disposition":
+			opts.ContentDisposition = v
+		case "content-language":
+			opts.ContentLanguage = v
+		case "cache-control":
+			opts.CacheControl = v
+		case "expires":
+			if t, err := http.ParseTime(v); err == nil {
+				opts.Expires = t
+			}
+		case "x-amz-website-redirect-location":
+			opts.WebsiteRedirectLocation = v
+		case "x-amz-storage-class":
+			opts.StorageClass = v
+		default:
+			if !isStandardHeader(k) && !isSSEHeader(k) && !isMinioHeader(k) {
+				opts.UserMetadata[k] = v
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCopyObjectFromOtherClient(t *testing.T) {
+	_, src := newTestServerClient(t)
+	_, dst := newTestServerClient(t)
+	ctx := context.Background()
+
+	data := strings.Repeat("0123456789", 2<<20) // Uploaded in parts.
+	_, err := src.PutObject(ctx, "bucket", "object", strings.NewReader(data), int64(len(data)), PutObjectOptions{
+		ContentType:  "text/plain",
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := dst.CopyObject(ctx,
+		CopyDestOptions{Bucket: "bucket", Object: "copy"},
+		CopySrcOptions{Bucket: "bucket", Object: "object", Client: src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(data)) {
+		t.Fatalf("expected %d bytes, got %d", len(data), info.Size)
+	}
+	st, err := dst.StatObject(ctx, "bucket", "copy", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.ContentType != "text/plain" || st.UserMetadata["Color"] != "blue" || st.UserTagCount != 1 {
+		t.Fatalf("metadata not copied: %+v", st)
+	}
+
+	// A range with replaced metadata and tags.
+	_, err = dst.CopyObject(ctx,
+		CopyDestOptions{
+			Bucket: "bucket", Object: "range",
+			ReplaceMetadata: true, UserMetadata: map[string]string{"X-Amz-Meta-Shape": "round", "Content-Type": "text/csv", "Cache-Control": "no-cache"},
+			ReplaceTags: true,
+		},
+		CopySrcOptions{Bucket: "bucket", Object: "object", Client: src, MatchRange: true, Start: 10, End: 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, st, err := dst.GetObjectBytes(ctx, "bucket", "range", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "01234" || st.UserMetadata["Shape"] != "round" || st.UserMetadata["Color"] != "" || st.UserTagCount != 0 ||
+		st.ContentType != "text/csv" || st.Metadata.Get("Cache-Control") != "no-cache" {
+		t.Fatalf("unexpected copy %q: %+v", got, st)
+	}
+
+	// Preconditions apply to the source.
+	_, err = dst.CopyObject(ctx,
+		CopyDestOptions{Bucket: "bucket", Object: "copy"},
+		CopySrcOptions{Bucket: "bucket", Object: "object", Client: src, MatchETag: "mismatch"})
+	if ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed, got %v", err)
+	}
+
+	if _, err = dst.ComposeObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "c"}, CopySrcOptions{Bucket: "bucket", Object: "object", Client: src}); err == nil {
+		t.Fatal("expected composing a foreign source to fail")
+	}
+}
@@ -0,0 +1,259 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/etag"
+)
+
+// Default number and size of the byte ranges compared by verified
+// copies without checksum in common, see CopyDestOptions.Verify.
+const (
+	defaultCopyVerifySamples    = 8
+	defaultCopyVerifySampleSize = 1 << 20
+)
+
+// ErrCopyMismatch is returned by CopyObject if the destination of a
+// verified copy differs from its source.
+type ErrCopyMismatch struct {
+	SrcBucket, SrcObject string
+	DstBucket, DstObject string
+	DstVersionID         string
+
+	// Property which differs, "size", "ETag", the name of a checksum
+	// like "CRC32C", or a byte range like "bytes 0-1048575" whose MD5
+	// sums are compared.
+	Property    string
+	Source      string
+	Destination string
+}
+
+// Error returns the error message naming the property which differs.
+func (e ErrCopyMismatch) Error() string {
+	return fmt.Sprintf("Copy %s/%s of %s/%s does not match its source: %s is %s, expected %s",
+		e.DstBucket, e.DstObject, e.SrcBucket, e.SrcObject, e.Property, e.Destination, e.Source)
+}
+
+// objectChecksums returns the checksums of the object by type.
+func objectChecksums(info ObjectInfo) map[ChecksumType]string {
+	checksums := make(map[ChecksumType]string)
+	for t, checksum := range map[ChecksumType]string{
+		ChecksumCRC32:     info.ChecksumCRC32,
+		ChecksumCRC32C:    info.ChecksumCRC32C,
+		ChecksumSHA1:      info.ChecksumSHA1,
+		ChecksumSHA256:    info.ChecksumSHA256,
+		ChecksumCRC64NVME: info.ChecksumCRC64NVME,
+	} {
+		if checksum != "" {
+			checksums[t] = checksum
+		}
+	}
+	return checksums
+}
+
+// encryptedETag tells whether the ETag of the object is not computed
+// from its content, as for objects encrypted with SSE-C or SSE-KMS.
+func encryptedETag(info ObjectInfo) bool {
+	sse := info.Metadata.Get("X-Amz-Server-Side-Encryption")
+	return sse == "aws:kms" || sse == "aws:kms:dsse" || info.Metadata.Get(encrypt.SseCustomerAlgorithm) != ""
+}
+
+// verifyCopy compares the destination of a copy, of which info is the
+// upload info, with its source, see CopyDestOptions.Verify.
+func (c *Client) verifyCopy(ctx context.Context, dst CopyDestOptions, src CopySrcOptions, info UploadInfo) error {
+	srcClient := c
+	if src.Client != nil {
+		srcClient = src.Client
+	}
+	srcOpts := func() GetObjectOptions {
+		return GetObjectOptions{VersionID: src.VersionID, ServerSideEncryption: encrypt.SSE(src.Encryption)}
+	}
+	dstOpts := func() GetObjectOptions {
+		return GetObjectOptions{VersionID: info.VersionID, ServerSideEncryption: dst.Encryption}
+	}
+
+	opts := srcOpts()
+	opts.Checksum = true
+	srcInfo, err := srcClient.StatObject(ctx, src.Bucket, src.Object, opts)
+	if err != nil {
+		return err
+	}
+	opts = dstOpts()
+	opts.Checksum = true
+	dstInfo, err := c.StatObject(ctx, dst.Bucket, dst.Object, opts)
+	if err != nil {
+		return err
+	}
+
+	mismatch := func(property, source, destination string) error {
+		return ErrCopyMismatch{
+			SrcBucket:    src.Bucket,
+			SrcObject:    src.Object,
+			DstBucket:    dst.Bucket,
+			DstObject:    dst.Object,
+			DstVersionID: info.VersionID,
+			Property:     property,
+			Source:       source,
+			Destination:  destination,
+		}
+	}
+	offset, size := int64(0), srcInfo.Size
+	if src.MatchRange {
+		offset, size = src.Start, src.End-src.Start+1
+	}
+	if dstInfo.Size != size {
+		return mismatch("size", strconv.FormatInt(size, 10), strconv.FormatInt(dstInfo.Size, 10))
+	}
+	if !src.MatchRange {
+		verified, err := compareCopySums(srcInfo, dstInfo, mismatch)
+		if err != nil || verified {
+			return err
+		}
+	}
+
+	samples, sampleSize := dst.VerifySamples, dst.VerifySampleSize
+	if samples == 0 {
+		samples = defaultCopyVerifySamples
+	}
+	if sampleSize == 0 {
+		sampleSize = defaultCopyVerifySampleSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, r := range copyVerifyRanges(size, samples, sampleSize) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := func() error {
+				srcSum, err := rangeMD5(ctx, srcClient, src.Bucket, src.Object, srcOpts(), offset+r[0], offset+r[1])
+				if err != nil {
+					return err
+				}
+				dstSum, err := rangeMD5(ctx, c, dst.Bucket, dst.Object, dstOpts(), r[0], r[1])
+				if err != nil {
+					return err
+				}
+				if srcSum != dstSum {
+					return mismatch(fmt.Sprintf("bytes %d-%d", r[0], r[1]), srcSum, dstSum)
+				}
+				return nil
+			}()
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// compareCopySums compares the checksums and ETags of the source and
+// the destination of a copy, returning whether they prove both have
+// the same content. Composite checksums and multipart ETags depend on
+// the parts of the objects and only prove it when equal.
+func compareCopySums(srcInfo, dstInfo ObjectInfo, mismatch func(property, source, destination string) error) (bool, error) {
+	verified := false
+	dstSums := objectChecksums(dstInfo)
+	for t, srcSum := range objectChecksums(srcInfo) {
+		dstSum, ok := dstSums[t]
+		switch {
+		case !ok:
+		case srcSum == dstSum:
+			verified = true
+		case !strings.Contains(srcSum, "-") && !strings.Contains(dstSum, "-"):
+			return false, mismatch(t.String(), srcSum, dstSum)
+		}
+	}
+
+	if !encryptedETag(srcInfo) && !encryptedETag(dstInfo) {
+		switch {
+		case etag.Equal(srcInfo.ETag, dstInfo.ETag):
+			verified = true
+		case etag.Parts(srcInfo.ETag) == 0 && etag.Parts(dstInfo.ETag) == 0:
+			return false, mismatch("ETag", srcInfo.ETag, dstInfo.ETag)
+		}
+	}
+	return verified, nil
+}
+
+// copyVerifyRanges returns the byte ranges, first and last offsets,
+// of an object of size bytes compared by a verified copy: the whole
+// object in up to samples chunks if it is no larger than the samples,
+// else samples ranges of sampleSize bytes spread from its start to
+// its end.
+func copyVerifyRanges(size int64, samples int, sampleSize int64) [][2]int64 {
+	var ranges [][2]int64
+	if size <= int64(samples)*sampleSize {
+		chunk := (size + int64(samples) - 1) / int64(samples)
+		for start := int64(0); start < size; start += chunk {
+			ranges = append(ranges, [2]int64{start, min(start+chunk, size) - 1})
+		}
+		return ranges
+	}
+	if samples == 1 {
+		return [][2]int64{{0, sampleSize - 1}}
+	}
+	for i := range samples {
+		start := int64(i) * (size - sampleSize) / int64(samples-1)
+		ranges = append(ranges, [2]int64{start, start + sampleSize - 1})
+	}
+	return ranges
+}
+
+// rangeMD5 returns the hex encoded MD5 sum of the bytes start to end of
+// the object.
+func rangeMD5(ctx context.Context, c *Client, bucketName, objectName string, opts GetObjectOptions, start, end int64) (string, error) {
+	if err := opts.SetRange(start, end); err != nil {
+		return "", err
+	}
+	obj, err := c.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return "", err
+	}
+	defer obj.Close()
+	h := md5.New()
+	n, err := io.Copy(h, obj)
+	if err != nil {
+		return "", err
+	}
+	if n != end-start+1 {
+		return "", io.ErrUnexpectedEOF
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
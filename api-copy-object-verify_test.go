@@ -0,0 +1,129 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// copyVerifyTransport hides the checksums and ETag of the object
+// "copy", and optionally corrupts its data, so verified copies of it
+// compare sampled ranges.
+type copyVerifyTransport struct {
+	http.RoundTripper
+	corrupt bool
+}
+
+func (t copyVerifyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil || !strings.HasSuffix(req.URL.Path, "/copy") {
+		return resp, err
+	}
+	if req.Method == http.MethodHead {
+		for k := range resp.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-checksum-") {
+				resp.Header.Del(k)
+			}
+		}
+		resp.Header.Set("ETag", `"00000000000000000000000000000000-2"`)
+	}
+	if req.Method == http.MethodGet && t.corrupt {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > 0 {
+			data[len(data)-1] ^= 0xff
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+	}
+	return resp, nil
+}
+
+func TestCopyObjectVerify(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	_, err := clnt.PutObject(ctx, "bucket", "source", bytes.NewReader(data), int64(len(data)), PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := CopySrcOptions{Bucket: "bucket", Object: "source"}
+
+	if _, err = clnt.CopyObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "etag", Verify: true}, src); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, corrupt := range []bool{false, true} {
+		c, err := New(srv.Endpoint(), &Options{
+			Creds:     credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+			Region:    srv.Region,
+			Transport: copyVerifyTransport{RoundTripper: http.DefaultTransport, corrupt: corrupt},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := c.CopyObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "copy", Verify: true, VerifySamples: 3, VerifySampleSize: 100}, src)
+		if !corrupt {
+			if err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		var mismatch ErrCopyMismatch
+		if !errors.As(err, &mismatch) || !strings.HasPrefix(mismatch.Property, "bytes ") || mismatch.DstObject != "copy" {
+			t.Fatalf("expected a mismatch of bytes, got %v", err)
+		}
+		if info.Key != "copy" {
+			t.Fatalf("expected the info of the copy, got %+v", info)
+		}
+	}
+
+	_, err = clnt.CopyObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "copy", Verify: true, VerifySamples: -1}, src)
+	if ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestCopyVerifyRanges(t *testing.T) {
+	for _, tc := range []struct {
+		size       int64
+		samples    int
+		sampleSize int64
+		want       [][2]int64
+	}{
+		{0, 8, 10, nil},
+		{10, 4, 10, [][2]int64{{0, 2}, {3, 5}, {6, 8}, {9, 9}}},
+		{100, 3, 10, [][2]int64{{0, 9}, {45, 54}, {90, 99}}},
+		{100, 1, 10, [][2]int64{{0, 9}}},
+	} {
+		if got := copyVerifyRanges(tc.size, tc.samples, tc.sampleSize); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("copyVerifyRanges(%d, %d, %d) = %v, want %v", tc.size, tc.samples, tc.sampleSize, got, tc.want)
+		}
+	}
+}
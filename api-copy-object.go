@@ -23,8 +23,19 @@ import (
 	"net/http"
 )
 
-// CopyObject - copy a source object into a new object
+// CopyObject - copy a source object into a new object. With dst.Verify
+// the copy is verified, and on ErrCopyMismatch the returned UploadInfo
+// is the one of the copied destination.
 func (c *Client) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
+	info, err := c.copyObject(ctx, dst, src)
+	if err != nil || !dst.Verify {
+		return info, err
+	}
+	return info, c.verifyCopy(ctx, dst, src, info)
+}
+
+// copyObject copies the source object without verification.
+func (c *Client) copyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
 	if err := src.validate(); err != nil {
 		return UploadInfo{}, err
 	}
@@ -33,6 +44,10 @@ func (c *Client) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySr
 		return UploadInfo{}, err
 	}
 
+	if c.isForeignSource(src) {
+		return c.streamCopyObject(ctx, dst, src)
+	}
+
 	header := make(http.Header)
 	dst.Marshal(header)
 	src.Marshal(header)
@@ -72,5 +87,8 @@ func (c *Client) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySr
 		VersionID:        resp.Header.Get(amzVersionID),
 		Expiration:       expTime,
 		ExpirationRuleID: ruleID,
+		Encryption:       encryptionInfo(resp.Header),
+
+		responseHeader: uploadResponseHeader(resp.Header),
 	}, nil
 }
@@ -0,0 +1,223 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// CopyPrefixOptions configures CopyPrefix.
+type CopyPrefixOptions struct {
+	// NumWorkers is the number of objects copied concurrently, 4 if
+	// zero.
+	NumWorkers int
+
+	// SourceClient reads the source objects, e.g. from another
+	// endpoint or with other credentials, see CopySrcOptions.Client.
+	SourceClient *Client
+
+	// SkipMetadata does not copy the user metadata and content
+	// headers of the objects, SkipTags does not copy their tags.
+	SkipMetadata bool
+	SkipTags     bool
+
+	// PreserveACL copies the canned ACL or the grants of the objects,
+	// which costs a request per object.
+	PreserveACL bool
+
+	// StartAfter resumes a copy after this source key, as passed to
+	// OnCheckpoint.
+	StartAfter string
+
+	// OnCheckpoint is called with a source key once it and all the
+	// keys before it are copied. The copy can be resumed after this
+	// key with StartAfter. Calls are serialized.
+	OnCheckpoint func(key string)
+}
+
+// CopyObjectError is the failure to copy an object.
+type CopyObjectError struct {
+	Key string
+	Err error
+}
+
+// CopyPrefixResult is the outcome of CopyPrefix.
+type CopyPrefixResult struct {
+	// Copied is the number of objects and Bytes their total size.
+	Copied int64
+	Bytes  int64
+
+	// Failed lists the objects which could not be copied, the
+	// checkpoint does not advance past them.
+	Failed []CopyObjectError
+}
+
+// CopyPrefix copies the objects below srcPrefix of srcBucket to
+// dstBucket, replacing srcPrefix in their keys by dstPrefix, e.g. to
+// migrate a bucket. Objects are copied concurrently with server-side
+// copies, or streamed from opts.SourceClient if the objects cannot be
+// copied server-side. The metadata and tags of the objects are
+// preserved unless skipped in opts.
+//
+// Failures to copy individual objects are reported in the result, the
+// returned error is set if listing fails.
+func (c *Client) CopyPrefix(ctx context.Context, srcBucket, srcPrefix, dstBucket, dstPrefix string, opts CopyPrefixOptions) (CopyPrefixResult, error) {
+	var result CopyPrefixResult
+	if err := s3utils.CheckValidBucketName(dstBucket); err != nil {
+		return result, err
+	}
+	src := opts.SourceClient
+	if src == nil {
+		src = c
+	}
+	workers := opts.NumWorkers
+	if workers <= 0 {
+		workers = 4
+	}
+
+	type copyJob struct {
+		seq  int
+		info ObjectInfo
+	}
+	var (
+		mu   sync.Mutex
+		done = make(map[int]string) // keys copied ahead of the checkpoint
+		next int                    // sequence of the next checkpoint
+	)
+	// complete records the result of a job and advances the checkpoint
+	// over the contiguous run of copied keys.
+	complete := func(job copyJob, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Failed = append(result.Failed, CopyObjectError{Key: job.info.Key, Err: err})
+			return
+		}
+		result.Copied++
+		result.Bytes += job.info.Size
+		done[job.seq] = job.info.Key
+		checkpoint := ""
+		for {
+			key, ok := done[next]
+			if !ok {
+				break
+			}
+			delete(done, next)
+			checkpoint = key
+			next++
+		}
+		if checkpoint != "" && opts.OnCheckpoint != nil {
+			opts.OnCheckpoint(checkpoint)
+		}
+	}
+
+	jobs := make(chan copyJob)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				dstKey := dstPrefix + strings.TrimPrefix(job.info.Key, srcPrefix)
+				complete(job, c.copyPrefixObject(ctx, src, srcBucket, job.info, dstBucket, dstKey, opts))
+			}
+		}()
+	}
+
+	var listErr error
+	seq := 0
+	for info := range src.ListObjects(ctx, srcBucket, ListObjectsOptions{Prefix: srcPrefix, Recursive: true, StartAfter: opts.StartAfter}) {
+		if info.Err != nil {
+			listErr = info.Err
+			break
+		}
+		jobs <- copyJob{seq: seq, info: info}
+		seq++
+	}
+	close(jobs)
+	wg.Wait()
+	return result, listErr
+}
+
+// copyPrefixObject copies an object of CopyPrefix.
+func (c *Client) copyPrefixObject(ctx context.Context, src *Client, srcBucket string, info ObjectInfo, dstBucket, dstKey string, opts CopyPrefixOptions) error {
+	var aclHeader map[string]string
+	if opts.PreserveACL {
+		acl, err := src.GetObjectACL(ctx, srcBucket, info.Key)
+		if err != nil {
+			return err
+		}
+		aclHeader = make(map[string]string)
+		for k, v := range acl.Metadata {
+			if k == "X-Amz-Acl" || strings.HasPrefix(k, "X-Amz-Grant-") {
+				aclHeader[k] = strings.Join(v, ",")
+			}
+		}
+	}
+
+	dst := CopyDestOptions{
+		Bucket:      dstBucket,
+		Object:      dstKey,
+		ReplaceTags: opts.SkipTags,
+	}
+	if opts.SkipMetadata || len(aclHeader) > 0 {
+		// The ACL headers are only applied with replaced metadata, the
+		// metadata of the source is set again unless skipped.
+		dst.ReplaceMetadata = true
+		dst.UserMetadata = make(map[string]string)
+		if !opts.SkipMetadata {
+			st, err := src.StatObject(ctx, srcBucket, info.Key, StatObjectOptions{})
+			if err != nil {
+				return err
+			}
+			for k, v := range st.Metadata {
+				// Tags, retention and encryption are not metadata
+				// of the copy.
+				if k == amzTaggingCount || strings.HasPrefix(k, "X-Amz-Object-Lock-") || k == "X-Amz-Server-Side-Encryption" {
+					continue
+				}
+				dst.UserMetadata[k] = strings.Join(v, ",")
+			}
+		}
+		for k, v := range aclHeader {
+			dst.UserMetadata[k] = v
+		}
+	}
+	source := CopySrcOptions{Bucket: srcBucket, Object: info.Key, Client: src}
+
+	if info.Size > singleCopyLimit && !c.isForeignSource(source) {
+		// Single copies are limited to 5GiB. The parts get the tags of
+		// the source from the client.
+		if !opts.SkipTags {
+			t, err := src.GetObjectTagging(ctx, srcBucket, info.Key, GetObjectTaggingOptions{})
+			if err != nil {
+				return err
+			}
+			dst.ReplaceTags = true
+			dst.UserTags = t.ToMap()
+		}
+		_, err := c.ComposeObject(ctx, dst, source)
+		return err
+	}
+	_, err := c.CopyObject(ctx, dst, source)
+	return err
+}
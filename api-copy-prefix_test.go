@@ -0,0 +1,108 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCopyPrefix(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"src/a": "a", "src/b/c": "bc", "src/d": "ddd", "other": "x"})
+	_, err := clnt.PutObject(ctx, "bucket", "src/e", strings.NewReader("e"), 1, PutObjectOptions{
+		ContentType:  "text/plain",
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.MakeBucket(ctx, "dst", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var checkpoint string
+	result, err := clnt.CopyPrefix(ctx, "bucket", "src/", "dst", "copy/", CopyPrefixOptions{
+		NumWorkers:   3,
+		OnCheckpoint: func(key string) { checkpoint = key },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Copied != 4 || result.Bytes != 7 || len(result.Failed) != 0 || checkpoint != "src/e" {
+		t.Fatalf("unexpected result %+v, checkpoint %q", result, checkpoint)
+	}
+	st, err := clnt.StatObject(ctx, "dst", "copy/e", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.ContentType != "text/plain" || st.UserMetadata["Color"] != "blue" || st.UserTagCount != 1 {
+		t.Fatalf("metadata not preserved: %+v", st)
+	}
+
+	// Resuming only copies the keys after the checkpoint.
+	if result, err = clnt.CopyPrefix(ctx, "bucket", "src/", "dst", "resumed/", CopyPrefixOptions{StartAfter: "src/b/c"}); err != nil || result.Copied != 2 {
+		t.Fatalf("expected 2 objects copied, got %+v: %v", result, err)
+	}
+
+	if _, err = clnt.CopyPrefix(ctx, "bucket", "src/e", "dst", "bare", CopyPrefixOptions{SkipMetadata: true, SkipTags: true}); err != nil {
+		t.Fatal(err)
+	}
+	if st, err = clnt.StatObject(ctx, "dst", "bare", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.UserMetadata) != 0 || st.UserTagCount != 0 {
+		t.Fatalf("metadata copied: %+v", st)
+	}
+
+	// Objects above the limit are copied in parts, with their tags.
+	func() {
+		defer func(limit int64) { singleCopyLimit = limit }(singleCopyLimit)
+		singleCopyLimit = 0
+		if _, err = clnt.CopyPrefix(ctx, "bucket", "src/e", "dst", "parts", CopyPrefixOptions{SkipMetadata: true}); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	tags, err := clnt.GetObjectTagging(ctx, "dst", "parts", GetObjectTaggingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := tags.Get("team"); v != "red" || tags.Count() != 1 {
+		t.Fatalf("tags not preserved: %v", tags.ToMap())
+	}
+
+	// Streamed from another server.
+	_, other := newTestServerClient(t)
+	if result, err = other.CopyPrefix(ctx, "bucket", "src/", "bucket", "", CopyPrefixOptions{SourceClient: clnt}); err != nil || result.Copied != 4 {
+		t.Fatalf("expected 4 objects copied, got %+v: %v", result, err)
+	}
+	if st, err = other.StatObject(ctx, "bucket", "e", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if st.ContentType != "text/plain" || st.UserMetadata["Color"] != "blue" || st.UserTagCount != 1 {
+		t.Fatalf("metadata not preserved: %+v", st)
+	}
+
+	result, err = clnt.CopyPrefix(ctx, "bucket", "src/", "missing", "", CopyPrefixOptions{})
+	if err != nil || len(result.Failed) != 4 || result.Copied != 0 {
+		t.Fatalf("expected 4 failures, got %+v: %v", result, err)
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
 )
 
 // BucketInfo container for bucket metadata.
@@ -32,6 +34,21 @@ type BucketInfo struct {
 	Name string `json:"name"`
 	// Date the bucket was created.
 	CreationDate time.Time `json:"creationDate"`
+	// Region of the bucket, returned by some servers or
+	// resolved by ListBucketsWithOptions.
+	Region string `json:"region,omitempty" xml:"BucketRegion"`
+
+	// A pointer keeps BucketInfo comparable, see Tags.
+	tags *map[string]string
+}
+
+// Tags returns the tags of the bucket resolved by ListBucketsWithOptions,
+// nil if they were not asked for.
+func (b BucketInfo) Tags() map[string]string {
+	if b.tags == nil {
+		return nil
+	}
+	return *b.tags
 }
 
 // StringMap represents map with custom UnmarshalXML
@@ -149,6 +166,105 @@ type UploadInfo struct {
 	ChecksumSHA256    string
 	ChecksumCRC64NVME string
 	ChecksumMode      string
+
+	// Server side encryption applied to the object.
+	Encryption EncryptionInfo
+
+	// A pointer keeps UploadInfo comparable, see ResponseHeader.
+	responseHeader *http.Header
+}
+
+// ResponseHeader returns the x-amz-* and custom headers of the
+// response, e.g. x-amz-request-charged.
+func (i UploadInfo) ResponseHeader() http.Header {
+	if i.responseHeader == nil {
+		return nil
+	}
+	return *i.responseHeader
+}
+
+// EncryptionInfo is the server side encryption the server
+// reports for an object.
+type EncryptionInfo struct {
+	// Algorithm is "AES256" for SSE-S3 or "aws:kms" for SSE-KMS.
+	Algorithm string `json:"algorithm,omitempty"`
+	// KMSKeyID is the key used for SSE-KMS.
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+	// BucketKeyEnabled is set if SSE-KMS uses an S3 Bucket Key.
+	BucketKeyEnabled bool `json:"bucketKeyEnabled,omitempty"`
+	// CustomerAlgorithm is set for SSE-C, e.g. "AES256".
+	CustomerAlgorithm string `json:"customerAlgorithm,omitempty"`
+}
+
+// IsEncrypted returns true if any server side encryption applies.
+func (e EncryptionInfo) IsEncrypted() bool {
+	return e.Algorithm != "" || e.CustomerAlgorithm != ""
+}
+
+// Type returns the server side encryption method, empty if the
+// object is not encrypted.
+func (e EncryptionInfo) Type() encrypt.Type {
+	switch {
+	case e.CustomerAlgorithm != "":
+		return encrypt.SSEC
+	case strings.HasPrefix(e.Algorithm, "aws:kms"):
+		return encrypt.KMS
+	case e.Algorithm != "":
+		return encrypt.S3
+	}
+	return ""
+}
+
+// encryptionInfo extracts the server side encryption headers.
+func encryptionInfo(h http.Header) EncryptionInfo {
+	return EncryptionInfo{
+		Algorithm:         h.Get(encrypt.SseGenericHeader),
+		KMSKeyID:          h.Get(encrypt.SseKmsKeyID),
+		BucketKeyEnabled:  h.Get(encrypt.SseBucketKeyEnabled) == "true",
+		CustomerAlgorithm: h.Get(encrypt.SseCustomerAlgorithm),
+	}
+}
+
+// ObjectLockInfo is the object lock retention and legal hold
+// the server reports for an object version.
+type ObjectLockInfo struct {
+	// Mode is GOVERNANCE or COMPLIANCE, empty without retention.
+	Mode RetentionMode `json:"mode,omitempty"`
+	// RetainUntilDate is the end of the retention period.
+	RetainUntilDate time.Time `json:"retainUntilDate,omitempty"`
+	// LegalHold is ON or OFF, empty if never set.
+	LegalHold LegalHoldStatus `json:"legalHold,omitempty"`
+}
+
+// RemainingRetention returns the time left until the retention
+// expires, zero if the object is not or no longer retained.
+func (l ObjectLockInfo) RemainingRetention() time.Duration {
+	if l.Mode == "" || l.RetainUntilDate.IsZero() {
+		return 0
+	}
+	if d := time.Until(l.RetainUntilDate); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// IsLocked returns true if the object version cannot be deleted
+// or overwritten because of retention or a legal hold.
+func (l ObjectLockInfo) IsLocked() bool {
+	return l.LegalHold == LegalHoldEnabled || l.RemainingRetention() > 0
+}
+
+// RetainedAt returns true if the retention is in effect at t, e.g.
+// to find the versions which can be deleted after a given date.
+func (l ObjectLockInfo) RetainedAt(t time.Time) bool {
+	return l.Mode != "" && l.RetainUntilDate.After(t)
+}
+
+// CanBypassGovernance returns true if the object version is only
+// locked by a GOVERNANCE retention, which can be bypassed by users
+// with the s3:BypassGovernanceRetention permission.
+func (l ObjectLockInfo) CanBypassGovernance() bool {
+	return l.Mode == Governance && l.RemainingRetention() > 0 && l.LegalHold != LegalHoldEnabled
 }
 
 // RestoreInfo contains information of the restore operation of an archived object
@@ -226,6 +342,16 @@ type ObjectInfo struct {
 	ChecksumCRC64NVME string
 	ChecksumMode      string
 
+	// Server side encryption of the object, not returned by listings.
+	Encryption EncryptionInfo `json:"encryption" xml:"-"`
+
+	// Object lock retention and legal hold, not returned by listings.
+	ObjectLock ObjectLockInfo `json:"objectLock" xml:"-"`
+
+	// ResponseHeader holds the x-amz-* and custom headers of the
+	// response, e.g. x-amz-request-charged, not returned by listings.
+	ResponseHeader http.Header `json:"-" xml:"-"`
+
 	Internal *struct {
 		K int // Data blocks
 		M int // Parity blocks
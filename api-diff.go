@@ -0,0 +1,188 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+)
+
+// BucketPrefix designates the objects of a bucket below a prefix.
+type BucketPrefix struct {
+	Bucket string
+	Prefix string
+}
+
+// DiffType is the kind of difference found by Diff.
+type DiffType string
+
+// Differences found by Diff.
+const (
+	// DiffOnlyInSource is an object missing in the target.
+	DiffOnlyInSource DiffType = "only-in-source"
+	// DiffOnlyInTarget is an object missing in the source.
+	DiffOnlyInTarget DiffType = "only-in-target"
+	// DiffContentDiffers is an object whose size, ETag or checksum
+	// differs between the source and the target.
+	DiffContentDiffers DiffType = "content-differs"
+)
+
+// DiffResult is a difference between the source and target of Diff.
+type DiffResult struct {
+	// Key is the name of the object relative to the prefixes.
+	Key  string
+	Type DiffType
+
+	// Source and Target are the listed objects, the missing one is
+	// zero.
+	Source ObjectInfo
+	Target ObjectInfo
+
+	Err error
+}
+
+// DiffOptions configures Diff.
+type DiffOptions struct {
+	// TargetClient lists the target, e.g. another cluster, instead
+	// of the client the diff is made with.
+	TargetClient *Client
+
+	// SizeOnly compares the objects by size only. ETags of objects
+	// uploaded with different part sizes or encryptions differ even
+	// if their contents are the same.
+	SizeOnly bool
+
+	// CompareChecksums compares the objects of the same size by the
+	// checksums fetched with StatObject, which costs a request per
+	// object on each side. The ETags are compared if the objects have
+	// no checksum of the same algorithm.
+	CompareChecksums bool
+}
+
+// Diff compares the objects below src with the objects below dst and
+// returns the differences on a channel, in key order. Objects present
+// on both sides with the same content are not returned. Errors are
+// returned in the Err field and end the diff.
+func (c *Client) Diff(ctx context.Context, src, dst BucketPrefix, opts DiffOptions) <-chan DiffResult {
+	target := opts.TargetClient
+	if target == nil {
+		target = c
+	}
+	resultCh := make(chan DiffResult, 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	srcCh := c.ListObjects(ctx, src.Bucket, ListObjectsOptions{Prefix: src.Prefix, Recursive: true})
+	dstCh := target.ListObjects(ctx, dst.Bucket, ListObjectsOptions{Prefix: dst.Prefix, Recursive: true})
+
+	go func() {
+		defer close(resultCh)
+		defer func() {
+			cancel()
+			for range srcCh {
+			}
+			for range dstCh {
+			}
+		}()
+		send := func(res DiffResult) bool {
+			select {
+			case resultCh <- res:
+				return res.Err == nil
+			case <-ctx.Done():
+				return false
+			}
+		}
+		next := func(ch <-chan ObjectInfo, prefix string) (ObjectInfo, string, bool) {
+			info, ok := <-ch
+			if !ok {
+				return ObjectInfo{}, "", false
+			}
+			return info, strings.TrimPrefix(info.Key, prefix), true
+		}
+
+		srcInfo, srcKey, srcOK := next(srcCh, src.Prefix)
+		dstInfo, dstKey, dstOK := next(dstCh, dst.Prefix)
+		for srcOK || dstOK {
+			if srcOK && srcInfo.Err != nil {
+				send(DiffResult{Err: srcInfo.Err})
+				return
+			}
+			if dstOK && dstInfo.Err != nil {
+				send(DiffResult{Err: dstInfo.Err})
+				return
+			}
+			switch {
+			case !dstOK || (srcOK && srcKey < dstKey):
+				if !send(DiffResult{Key: srcKey, Type: DiffOnlyInSource, Source: srcInfo}) {
+					return
+				}
+				srcInfo, srcKey, srcOK = next(srcCh, src.Prefix)
+			case !srcOK || dstKey < srcKey:
+				if !send(DiffResult{Key: dstKey, Type: DiffOnlyInTarget, Target: dstInfo}) {
+					return
+				}
+				dstInfo, dstKey, dstOK = next(dstCh, dst.Prefix)
+			default:
+				same, err := c.sameContent(ctx, target, src.Bucket, dst.Bucket, srcInfo, dstInfo, opts)
+				if err != nil {
+					send(DiffResult{Key: srcKey, Source: srcInfo, Target: dstInfo, Err: err})
+					return
+				}
+				if !same && !send(DiffResult{Key: srcKey, Type: DiffContentDiffers, Source: srcInfo, Target: dstInfo}) {
+					return
+				}
+				srcInfo, srcKey, srcOK = next(srcCh, src.Prefix)
+				dstInfo, dstKey, dstOK = next(dstCh, dst.Prefix)
+			}
+		}
+	}()
+	return resultCh
+}
+
+// sameContent compares a source and a target object as set by opts.
+func (c *Client) sameContent(ctx context.Context, target *Client, srcBucket, dstBucket string, src, dst ObjectInfo, opts DiffOptions) (bool, error) {
+	if src.Size != dst.Size {
+		return false, nil
+	}
+	if opts.SizeOnly {
+		return true, nil
+	}
+	if opts.CompareChecksums {
+		srcStat, err := c.StatObject(ctx, srcBucket, src.Key, StatObjectOptions{Checksum: true})
+		if err != nil {
+			return false, err
+		}
+		dstStat, err := target.StatObject(ctx, dstBucket, dst.Key, StatObjectOptions{Checksum: true})
+		if err != nil {
+			return false, err
+		}
+		for _, pair := range [][2]string{
+			{srcStat.ChecksumCRC64NVME, dstStat.ChecksumCRC64NVME},
+			{srcStat.ChecksumSHA256, dstStat.ChecksumSHA256},
+			{srcStat.ChecksumSHA1, dstStat.ChecksumSHA1},
+			{srcStat.ChecksumCRC32C, dstStat.ChecksumCRC32C},
+			{srcStat.ChecksumCRC32, dstStat.ChecksumCRC32},
+		} {
+			// Checksums of multipart objects are only comparable in
+			// the same mode, i.e. with the same part suffix.
+			if pair[0] != "" && pair[1] != "" && srcStat.ChecksumMode == dstStat.ChecksumMode {
+				return pair[0] == pair[1], nil
+			}
+		}
+	}
+	return src.ETag == dst.ETag, nil
+}
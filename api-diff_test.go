@@ -0,0 +1,82 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{
+		"src/a":     "same",
+		"src/b":     "only source",
+		"src/c":     "size",
+		"src/d/e":   "etag",
+		"dst/a":     "same",
+		"dst/c":     "larger",
+		"dst/d/e":   "ETAG",
+		"dst/f":     "only target",
+		"src-other": "not below src/",
+	})
+
+	diff := func(src, dst BucketPrefix, opts DiffOptions) string {
+		t.Helper()
+		var results []string
+		for res := range clnt.Diff(ctx, src, dst, opts) {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			results = append(results, fmt.Sprintf("%s:%s", res.Key, res.Type))
+		}
+		return strings.Join(results, ",")
+	}
+
+	src, dst := BucketPrefix{"bucket", "src/"}, BucketPrefix{"bucket", "dst/"}
+	want := "b:only-in-source,c:content-differs,d/e:content-differs,f:only-in-target"
+	if got := diff(src, dst, DiffOptions{}); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	want = "b:only-in-source,c:content-differs,f:only-in-target"
+	if got := diff(src, dst, DiffOptions{SizeOnly: true}); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	// Against another server.
+	_, other := newTestServerClient(t)
+	putTestObjects(t, other, map[string]string{"a": "same", "b": "only source"})
+	want = "c:only-in-source,d/e:only-in-source"
+	if got := diff(src, BucketPrefix{Bucket: "bucket"}, DiffOptions{TargetClient: other}); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	var errs int
+	for res := range clnt.Diff(ctx, src, BucketPrefix{Bucket: "missing"}, DiffOptions{}) {
+		if ToErrorResponse(res.Err).Code != "NoSuchBucket" {
+			t.Fatalf("expected NoSuchBucket, got %v", res.Err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Fatalf("expected an error, got %d", errs)
+	}
+}
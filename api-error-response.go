@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 /* **** SAMPLE ERROR RESPONSE ****
@@ -58,6 +60,11 @@ type ErrorResponse struct {
 	// Captures the server string returned in response header.
 	Server string
 
+	// RetryAfter is the delay after which the server asks to retry the
+	// request, from the Retry-After or x-amz-retry-after headers of
+	// throttled or unavailable responses, 0 if none.
+	RetryAfter time.Duration `xml:"-" json:"-"`
+
 	// Underlying HTTP status code for the returned error
 	StatusCode int `xml:"-" json:"-"`
 }
@@ -79,6 +86,14 @@ func ToErrorResponse(err error) ErrorResponse {
 	switch err := err.(type) {
 	case ErrorResponse:
 		return err
+	case ErrObjectArchived:
+		return err.ErrorResponse
+	case ErrObjectChanged:
+		return err.ErrorResponse
+	case ObjectNotModified:
+		return err.ErrorResponse
+	case ErrIncompleteUpload:
+		return ToErrorResponse(err.Err)
 	default:
 		return ErrorResponse{}
 	}
@@ -149,6 +164,14 @@ func httpRespToErrorResponse(resp *http.Response, bucketName, objectName string)
 					Key:        objectName,
 				}
 			}
+		case http.StatusMovedPermanently:
+			errResp = ErrorResponse{
+				StatusCode: resp.StatusCode,
+				Code:       "PermanentRedirect",
+				Message:    "The bucket you are attempting to access must be addressed using the specified endpoint.",
+				BucketName: bucketName,
+				Key:        objectName,
+			}
 		case http.StatusForbidden:
 			errResp = ErrorResponse{
 				StatusCode: resp.StatusCode,
@@ -209,6 +232,7 @@ func httpRespToErrorResponse(resp *http.Response, bucketName, objectName string)
 	if errResp.Region == "" {
 		errResp.Region = resp.Header.Get("x-amz-bucket-region")
 	}
+	errResp.RetryAfter = parseRetryAfter(resp.Header)
 	if errResp.Code == "InvalidRegion" && errResp.Region != "" {
 		errResp.Message = fmt.Sprintf("Region does not match, expecting region ‘%s’.", errResp.Region)
 	}
@@ -282,3 +306,38 @@ func errAPINotSupported(message string) error {
 		RequestID:  "minio",
 	}
 }
+
+// Throttled tells whether the request was throttled, i.e. rejected to
+// reduce the request rate with 429 Too Many Requests or an error code
+// like SlowDown.
+func (e ErrorResponse) Throttled() bool {
+	if e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	_, ok := throttlingS3Codes[e.Code]
+	return ok
+}
+
+// parseRetryAfter returns the delay of the Retry-After header, in
+// seconds or as an HTTP date, or of x-amz-retry-after.
+func parseRetryAfter(h http.Header) time.Duration {
+	for _, k := range []string{"Retry-After", "X-Amz-Retry-After"} {
+		v := strings.TrimSpace(h.Get(k))
+		if v == "" {
+			continue
+		}
+		if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if secs > 0 {
+				return time.Duration(secs) * time.Second
+			}
+			return 0
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d.Round(time.Second)
+			}
+			return 0
+		}
+	}
+	return 0
+}
@@ -0,0 +1,184 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FindOptions selects the objects returned by Find, objects must match
+// all of the set criteria.
+type FindOptions struct {
+	// Prefix only searches the objects with this prefix.
+	Prefix string
+
+	// Name matches the base name of the objects, the part of the key
+	// after the last '/', against a shell pattern, see path.Match.
+	Name string
+
+	// Regex matches the keys of the objects. If the expression is
+	// anchored with '^' its literal prefix narrows the listing.
+	Regex *regexp.Regexp
+
+	// OlderThan and NewerThan match the objects last modified more,
+	// respectively less, than this long ago.
+	OlderThan time.Duration
+	NewerThan time.Duration
+
+	// LargerThan and SmallerThan match the objects larger, respectively
+	// smaller, than this many bytes, if set.
+	LargerThan  int64
+	SmallerThan int64
+
+	// Tags matches the objects which have all of these tags.
+	Tags map[string]string
+
+	// Metadata matches the objects which have all of these user
+	// metadata values, keys are given without the "X-Amz-Meta-" prefix
+	// and are case insensitive. MinIO servers return the metadata with
+	// the listing, for other servers it is fetched for each candidate.
+	Metadata map[string]string
+
+	// Limit stops the search after this many matches.
+	Limit int
+}
+
+// findPrefix returns the prefix to list for opts, ok is false if the
+// prefix and the literal prefix of the regular expression exclude
+// each other and no object can match.
+func (opts FindOptions) findPrefix() (prefix string, ok bool) {
+	prefix = opts.Prefix
+	if opts.Regex == nil || !strings.HasPrefix(opts.Regex.String(), "^") {
+		return prefix, true
+	}
+	re, err := regexp.Compile(strings.TrimPrefix(opts.Regex.String(), "^"))
+	if err != nil {
+		return prefix, true
+	}
+	literal, _ := re.LiteralPrefix()
+	switch {
+	case strings.HasPrefix(literal, prefix):
+		return literal, true
+	case strings.HasPrefix(prefix, literal):
+		return prefix, true
+	}
+	return "", false
+}
+
+// matchFound applies the criteria of opts which are not listing filters.
+func (opts FindOptions) matchFound(info ObjectInfo) (bool, error) {
+	// A MaxSize of zero does not filter, empty objects are matched here.
+	if opts.SmallerThan > 0 && info.Size >= opts.SmallerThan {
+		return false, nil
+	}
+	if opts.Name != "" {
+		match, err := path.Match(opts.Name, path.Base(info.Key))
+		if err != nil || !match {
+			return false, err
+		}
+	}
+	for k, v := range opts.Metadata {
+		got := info.Metadata.Get("X-Amz-Meta-" + k)
+		if got == "" {
+			got = info.Metadata.Get(k)
+		}
+		if got != v {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Find searches a bucket for the objects matching opts, like 'mc find',
+// and returns them on a channel closed at the end of the search. The
+// search is a recursive listing narrowed to the longest known prefix,
+// errors are returned in the Err field of the entries.
+func (c *Client) Find(ctx context.Context, bucketName string, opts FindOptions) <-chan ObjectInfo {
+	resultCh := make(chan ObjectInfo, 1)
+	if opts.Name != "" {
+		if _, err := path.Match(opts.Name, ""); err != nil {
+			resultCh <- ObjectInfo{Err: errInvalidArgument("invalid Name pattern: " + err.Error())}
+			close(resultCh)
+			return resultCh
+		}
+	}
+	prefix, ok := opts.findPrefix()
+	if !ok {
+		close(resultCh)
+		return resultCh
+	}
+
+	now := time.Now()
+	listOpts := ListObjectsOptions{
+		Prefix:      prefix,
+		Recursive:   true,
+		MatchRegexp: opts.Regex,
+		MatchTags:   opts.Tags,
+	}
+	if opts.OlderThan > 0 {
+		listOpts.ModifiedBefore = now.Add(-opts.OlderThan)
+	}
+	if opts.NewerThan > 0 {
+		listOpts.ModifiedAfter = now.Add(-opts.NewerThan)
+	}
+	if opts.LargerThan > 0 {
+		listOpts.MinSize = opts.LargerThan + 1
+	}
+	if opts.SmallerThan > 1 {
+		listOpts.MaxSize = opts.SmallerThan - 1
+	}
+	if len(opts.Metadata) > 0 {
+		listOpts.WithMetadata = true
+		listOpts.StatMissingMetadata = true
+	}
+
+	listCtx, cancel := context.WithCancel(ctx)
+	listCh := c.ListObjects(listCtx, bucketName, listOpts)
+	go func() {
+		defer close(resultCh)
+		defer cancel()
+		sent := 0
+		for info := range listCh {
+			if info.Err == nil {
+				match, err := opts.matchFound(info)
+				if err != nil {
+					info = ObjectInfo{Err: err}
+				} else if !match {
+					continue
+				}
+			}
+			select {
+			case resultCh <- info:
+			case <-ctx.Done():
+				// Keep draining listCh, it ends with the context error.
+				continue
+			}
+			if sent++; opts.Limit > 0 && sent >= opts.Limit {
+				cancel()
+				for range listCh {
+				}
+				return
+			}
+		}
+	}()
+	return resultCh
+}
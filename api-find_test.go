@@ -0,0 +1,84 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFind(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{
+		"photos/a.jpg":     "aaaa",
+		"photos/b.png":     "bb",
+		"photos/old/c.jpg": "",
+		"docs/d.txt":       "dddddddd",
+	})
+	_, err := clnt.PutObject(ctx, "bucket", "docs/e.txt", strings.NewReader("e"), 1, PutObjectOptions{
+		UserMetadata: map[string]string{"Owner": "alice"},
+		UserTags:     map[string]string{"team": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	find := func(opts FindOptions) string {
+		t.Helper()
+		var keys []string
+		for info := range clnt.Find(ctx, "bucket", opts) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			keys = append(keys, info.Key)
+		}
+		return strings.Join(keys, ",")
+	}
+
+	for _, tc := range []struct {
+		opts FindOptions
+		want string
+	}{
+		{FindOptions{Name: "*.jpg"}, "photos/a.jpg,photos/old/c.jpg"},
+		{FindOptions{Name: "*.jpg", Prefix: "photos/old/"}, "photos/old/c.jpg"},
+		{FindOptions{Regex: regexp.MustCompile(`^photos/[ab]\.`)}, "photos/a.jpg,photos/b.png"},
+		{FindOptions{Regex: regexp.MustCompile(`^docs/`), Prefix: "photos/"}, ""},
+		{FindOptions{LargerThan: 2}, "docs/d.txt,photos/a.jpg"},
+		{FindOptions{SmallerThan: 2}, "docs/e.txt,photos/old/c.jpg"},
+		{FindOptions{SmallerThan: 1}, "photos/old/c.jpg"},
+		{FindOptions{NewerThan: time.Hour}, "docs/d.txt,docs/e.txt,photos/a.jpg,photos/b.png,photos/old/c.jpg"},
+		{FindOptions{OlderThan: time.Hour}, ""},
+		{FindOptions{Tags: map[string]string{"team": "red"}}, "docs/e.txt"},
+		{FindOptions{Metadata: map[string]string{"owner": "alice"}}, "docs/e.txt"},
+		{FindOptions{Name: "*.txt", Limit: 1}, "docs/d.txt"},
+	} {
+		if got := find(tc.opts); got != tc.want {
+			t.Errorf("%+v: expected %q, got %q", tc.opts, tc.want, got)
+		}
+	}
+
+	for info := range clnt.Find(ctx, "bucket", FindOptions{Name: "["}) {
+		if info.Err == nil {
+			t.Fatal("expected an invalid pattern error")
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,10 +89,14 @@ type ObjectAttributesResponse struct {
 	StorageClass string
 	ObjectSize   int
 	Checksum     struct {
-		ChecksumCRC32  string `xml:",omitempty"`
-		ChecksumCRC32C string `xml:",omitempty"`
-		ChecksumSHA1   string `xml:",omitempty"`
-		ChecksumSHA256 string `xml:",omitempty"`
+		ChecksumCRC32     string `xml:",omitempty"`
+		ChecksumCRC32C    string `xml:",omitempty"`
+		ChecksumSHA1      string `xml:",omitempty"`
+		ChecksumSHA256    string `xml:",omitempty"`
+		ChecksumCRC64NVME string `xml:",omitempty"`
+		// ChecksumType is FULL_OBJECT or COMPOSITE, the latter
+		// for checksums of the part checksums.
+		ChecksumType string `xml:",omitempty"`
 	}
 	ObjectParts struct {
 		PartsCount           int
@@ -105,12 +110,13 @@ type ObjectAttributesResponse struct {
 
 // ObjectAttributePart is used by ObjectAttributesResponse to describe an object part
 type ObjectAttributePart struct {
-	ChecksumCRC32  string `xml:",omitempty"`
-	ChecksumCRC32C string `xml:",omitempty"`
-	ChecksumSHA1   string `xml:",omitempty"`
-	ChecksumSHA256 string `xml:",omitempty"`
-	PartNumber     int
-	Size           int
+	ChecksumCRC32     string `xml:",omitempty"`
+	ChecksumCRC32C    string `xml:",omitempty"`
+	ChecksumSHA1      string `xml:",omitempty"`
+	ChecksumSHA256    string `xml:",omitempty"`
+	ChecksumCRC64NVME string `xml:",omitempty"`
+	PartNumber        int
+	Size              int
 }
 
 func (o *ObjectAttributes) parseResponse(resp *http.Response) (err error) {
@@ -199,3 +205,30 @@ func (c *Client) GetObjectAttributes(ctx context.Context, bucketName, objectName
 
 	return OA, nil
 }
+
+// ObjectAttributePartsPaginator returns a paginator over the parts of a
+// multipart object, requesting opts.MaxParts of them per page starting
+// after opts.PartNumberMarker. Objects uploaded in a single part have
+// no parts.
+func (c *Client) ObjectAttributePartsPaginator(bucketName, objectName string, opts ObjectAttributesOptions) *Paginator[ObjectAttributePart] {
+	marker := opts.PartNumberMarker
+	return newPaginator(func(ctx context.Context) ([]ObjectAttributePart, bool, error) {
+		pageOpts := opts
+		pageOpts.PartNumberMarker = marker
+		attrs, err := c.GetObjectAttributes(ctx, bucketName, objectName, pageOpts)
+		if err != nil {
+			return nil, true, err
+		}
+		parts := attrs.ObjectParts
+		page := make([]ObjectAttributePart, 0, len(parts.Parts))
+		for _, part := range parts.Parts {
+			page = append(page, *part)
+		}
+		// Catch servers which would make the listing loop forever.
+		if parts.IsTruncated && parts.NextPartNumberMarker <= marker {
+			return nil, true, fmt.Errorf("getObjectAttributes is truncated without progress, %s S3 server is incompatible with S3 API", c.endpointURL)
+		}
+		marker = parts.NextPartNumberMarker
+		return page, parts.IsTruncated, nil
+	})
+}
@@ -0,0 +1,78 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestGetObjectAttributes(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	// Part checksums are sent as trailers.
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:           credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:          srv.Region,
+		TrailingHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	const partSize = 5 << 20
+	data := bytes.Repeat([]byte("a"), 2*partSize+1)
+	info, err := clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		PartSize: partSize,
+		Checksum: ChecksumCRC32C,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := clnt.GetObjectAttributes(ctx, "bucket", "object", ObjectAttributesOptions{MaxParts: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs.ETag != info.ETag || attrs.ObjectSize != len(data) || attrs.StorageClass != "STANDARD" {
+		t.Fatalf("unexpected attributes %+v", attrs.ObjectAttributesResponse)
+	}
+	if attrs.ObjectParts.PartsCount != 3 || !attrs.ObjectParts.IsTruncated || len(attrs.ObjectParts.Parts) != 2 {
+		t.Fatalf("unexpected parts %+v", attrs.ObjectParts)
+	}
+	if attrs.ObjectParts.Parts[0].ChecksumCRC32C == "" {
+		t.Fatal("expected the part checksums")
+	}
+
+	partKey := func(p ObjectAttributePart) string {
+		return strconv.Itoa(p.PartNumber) + ":" + strconv.Itoa(p.Size)
+	}
+	got := strings.Join(collectPages(t, clnt.ObjectAttributePartsPaginator("bucket", "object", ObjectAttributesOptions{MaxParts: 2}), partKey), "|")
+	if want := "1:5242880,2:5242880|3:1"; got != want {
+		t.Fatalf("expected pages %s, got %s", want, got)
+	}
+
+	if _, err = clnt.GetObjectAttributes(ctx, "bucket", "missing", ObjectAttributesOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
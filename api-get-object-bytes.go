@@ -0,0 +1,112 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+)
+
+// DefaultMaxObjectBytes is the default size limit of GetObjectBytes.
+const DefaultMaxObjectBytes = 64 << 20
+
+// GetObjectBytes reads an object, or the range set in opts, in memory
+// and returns it with its info. Objects larger than opts.MaxSize, or
+// DefaultMaxObjectBytes if not set, are not read and fail with an
+// EntityTooLarge error.
+func (c *Client) GetObjectBytes(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) ([]byte, ObjectInfo, error) {
+	maxSize := opts.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxObjectBytes
+	}
+
+	body, objectInfo, _, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	defer body.Close()
+
+	if objectInfo.Size > maxSize {
+		return nil, ObjectInfo{}, errEntityTooLarge(objectInfo.Size, maxSize, bucketName, objectName)
+	}
+
+	r := newProgressReader(body, opts.OnProgress, objectInfo.Size)
+	if objectInfo.Size < 0 {
+		// Unknown size, read up to the limit.
+		buf, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+		if err != nil {
+			return nil, ObjectInfo{}, err
+		}
+		if int64(len(buf)) > maxSize {
+			return nil, ObjectInfo{}, errEntityTooLarge(int64(len(buf)), maxSize, bucketName, objectName)
+		}
+		return buf, objectInfo, nil
+	}
+
+	buf := make([]byte, objectInfo.Size)
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF || err == io.EOF {
+			return nil, ObjectInfo{}, errUnexpectedEOF(int64(n), objectInfo.Size, bucketName, objectName)
+		}
+		return nil, ObjectInfo{}, err
+	}
+	return buf, objectInfo, nil
+}
+
+// GetObjectInto copies an object, or the range set in opts, to w and
+// returns its info. An error is returned if fewer bytes than the size
+// of the object, when known, could be read.
+func (c *Client) GetObjectInto(ctx context.Context, w io.Writer, bucketName, objectName string, opts GetObjectOptions) (ObjectInfo, error) {
+	body, objectInfo, _, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	defer body.Close()
+
+	n, err := io.Copy(w, newProgressReader(body, opts.OnProgress, objectInfo.Size))
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if objectInfo.Size >= 0 && n != objectInfo.Size {
+		return ObjectInfo{}, errUnexpectedEOF(n, objectInfo.Size, bucketName, objectName)
+	}
+	return objectInfo, nil
+}
+
+// progressReader reports the bytes read from a reader to a progress
+// tracker.
+type progressReader struct {
+	io.Reader
+	progress *progressTracker
+}
+
+// newProgressReader returns r reporting its reads to fn, or r itself if
+// fn is nil.
+func newProgressReader(r io.Reader, fn ProgressFunc, total int64) io.Reader {
+	if fn == nil {
+		return r
+	}
+	return &progressReader{Reader: r, progress: newProgressTracker(fn, total)}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	p.progress.add(int64(n))
+	return n, err
+}
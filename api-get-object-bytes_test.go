@@ -0,0 +1,109 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestGetObjectBytes(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "hello world"})
+	ctx := context.Background()
+
+	var transferred int64
+	data, info, err := clnt.GetObjectBytes(ctx, "bucket", "object", GetObjectOptions{
+		OnProgress: func(n, _ int64) { transferred = n },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello world" || info.Size != 11 || transferred != 11 {
+		t.Fatalf("unexpected object %q, size %d, progress %d", data, info.Size, transferred)
+	}
+
+	opts := GetObjectOptions{}
+	if err = opts.SetRange(6, 10); err != nil {
+		t.Fatal(err)
+	}
+	if data, _, err = clnt.GetObjectBytes(ctx, "bucket", "object", opts); err != nil || string(data) != "world" {
+		t.Fatalf("unexpected range %q: %v", data, err)
+	}
+
+	_, _, err = clnt.GetObjectBytes(ctx, "bucket", "object", GetObjectOptions{MaxSize: 10})
+	if ToErrorResponse(err).Code != "EntityTooLarge" {
+		t.Fatalf("expected EntityTooLarge, got %v", err)
+	}
+	if _, _, err = clnt.GetObjectBytes(ctx, "bucket", "missing", GetObjectOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
+
+func TestGetObjectInto(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "hello world"})
+
+	var buf bytes.Buffer
+	info, err := clnt.GetObjectInto(context.Background(), &buf, "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "hello world" || info.Key != "object" {
+		t.Fatalf("unexpected object %q, info %+v", buf.String(), info)
+	}
+}
+
+func TestGetObjectIntoUnknownSize(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "hello world"})
+
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:     credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:    srv.Region,
+		Transport: noContentLengthTransport{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	info, err := clnt.GetObjectInto(context.Background(), &buf, "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "hello world" || info.Size != -1 {
+		t.Fatalf("unexpected object %q, size %d", buf.String(), info.Size)
+	}
+}
+
+// noContentLengthTransport drops the length of object downloads, as
+// servers sending them chunked do.
+type noContentLengthTransport struct{}
+
+func (noContentLengthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	if err == nil && req.Method == http.MethodGet {
+		res.Header.Del("Content-Length")
+		res.ContentLength = -1
+	}
+	return res, err
+}
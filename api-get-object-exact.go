@@ -0,0 +1,146 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+)
+
+// ObjectHandle pins the object version written by an upload, by its
+// version ID on versioned buckets and by its ETag.
+type ObjectHandle struct {
+	Bucket    string
+	Key       string
+	VersionID string
+	ETag      string
+}
+
+// Handle returns the handle of the object version written by the
+// upload, to read it back with GetObjectExact.
+func (info UploadInfo) Handle() ObjectHandle {
+	return ObjectHandle{
+		Bucket:    info.Bucket,
+		Key:       info.Key,
+		VersionID: info.VersionID,
+		ETag:      info.ETag,
+	}
+}
+
+// ErrObjectChanged is returned by GetObjectExact and StatObjectExact if
+// the pinned object version no longer exists, or the object was
+// overwritten on an unversioned bucket.
+type ErrObjectChanged struct {
+	ErrorResponse
+	Handle ObjectHandle
+}
+
+// Error returns the error message naming the pinned version.
+func (e ErrObjectChanged) Error() string {
+	if e.Handle.VersionID != "" {
+		return fmt.Sprintf("Version %s of object %s/%s no longer exists", e.Handle.VersionID, e.Handle.Bucket, e.Handle.Key)
+	}
+	return fmt.Sprintf("Object %s/%s with ETag %s no longer exists", e.Handle.Bucket, e.Handle.Key, e.Handle.ETag)
+}
+
+// Unwrap returns the underlying error response.
+func (e ErrObjectChanged) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// exactOptions pins opts to the version and ETag of h.
+func (h ObjectHandle) exactOptions(opts GetObjectOptions) (GetObjectOptions, error) {
+	if h.VersionID == "" && h.ETag == "" {
+		return opts, errInvalidArgument("Object handle must have a version ID or an ETag.")
+	}
+	opts.VersionID = h.VersionID
+	if h.ETag != "" {
+		// Do not modify the headers of the caller.
+		opts.headers = maps.Clone(opts.headers)
+		if err := opts.SetMatchETag(h.ETag); err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
+
+// checkExact converts errors of missing or overwritten objects to
+// ErrObjectChanged and verifies info of servers which ignore If-Match.
+func (h ObjectHandle) checkExact(info ObjectInfo, err error) error {
+	if err != nil {
+		errResp := ToErrorResponse(err)
+		switch errResp.Code {
+		case "PreconditionFailed", "NoSuchKey", "NoSuchVersion":
+			return ErrObjectChanged{ErrorResponse: errResp, Handle: h}
+		}
+		if errResp.StatusCode == http.StatusNotFound && errResp.Code != "NoSuchBucket" {
+			return ErrObjectChanged{ErrorResponse: errResp, Handle: h}
+		}
+		return err
+	}
+	if (h.VersionID != "" && info.VersionID != h.VersionID) || (h.ETag != "" && info.ETag != h.ETag) {
+		return ErrObjectChanged{
+			ErrorResponse: ErrorResponse{
+				StatusCode: http.StatusPreconditionFailed,
+				Code:       "PreconditionFailed",
+				Message:    s3ErrorResponseMap["PreconditionFailed"],
+				BucketName: h.Bucket,
+				Key:        h.Key,
+			},
+			Handle: h,
+		}
+	}
+	return nil
+}
+
+// StatObjectExact returns the info of the object version pinned by h,
+// it fails with ErrObjectChanged if the version is gone.
+func (c *Client) StatObjectExact(ctx context.Context, h ObjectHandle, opts StatObjectOptions) (ObjectInfo, error) {
+	opts, err := h.exactOptions(opts)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	info, err := c.StatObject(ctx, h.Bucket, h.Key, opts)
+	if err = h.checkExact(info, err); err != nil {
+		return ObjectInfo{}, err
+	}
+	return info, nil
+}
+
+// GetObjectExact returns the object version pinned by h. Unlike
+// GetObject the first request is made right away, so that it fails
+// fast with ErrObjectChanged if the version is gone rather than when
+// reading. Later requests, e.g. after seeking, are pinned as well.
+func (c *Client) GetObjectExact(ctx context.Context, h ObjectHandle, opts GetObjectOptions) (*Object, error) {
+	opts, err := h.exactOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := c.GetObject(ctx, h.Bucket, h.Key, opts)
+	if err != nil {
+		return nil, err
+	}
+	info, err := obj.Stat()
+	if err = h.checkExact(info, err); err != nil {
+		obj.Close()
+		return nil, err
+	}
+	return obj, nil
+}
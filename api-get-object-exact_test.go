@@ -0,0 +1,85 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestGetObjectExact(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	put := func(data string) ObjectHandle {
+		t.Helper()
+		info, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader(data), int64(len(data)), PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.Handle()
+	}
+
+	// Unversioned buckets are pinned by ETag.
+	h := put("first")
+	obj, err := clnt.GetObjectExact(ctx, h, GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || string(data) != "first" {
+		t.Fatalf("unexpected data %q, %v", data, err)
+	}
+	put("second")
+	var changed ErrObjectChanged
+	if _, err = clnt.GetObjectExact(ctx, h, GetObjectOptions{}); !errors.As(err, &changed) || changed.Handle != h {
+		t.Fatalf("expected ErrObjectChanged, got %v", err)
+	}
+	if ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("unexpected error response %+v", ToErrorResponse(err))
+	}
+
+	// Versioned buckets are pinned by version, older versions stay
+	// readable.
+	if err = clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	v1 := put("v1")
+	put("v2")
+	info, err := clnt.StatObjectExact(ctx, v1, StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.VersionID != v1.VersionID || info.Size != 2 {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	if err = clnt.RemoveObject(ctx, "bucket", "object", RemoveObjectOptions{VersionID: v1.VersionID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObjectExact(ctx, v1, StatObjectOptions{}); !errors.As(err, &changed) {
+		t.Fatalf("expected ErrObjectChanged, got %v", err)
+	}
+
+	if _, err = clnt.GetObjectExact(ctx, ObjectHandle{Bucket: "bucket", Key: "object"}, GetObjectOptions{}); err == nil {
+		t.Fatal("expected a handle without version and ETag to fail")
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// hedgedResult is the outcome of one of the requests of a hedged GET.
+type hedgedResult struct {
+	body   io.ReadCloser
+	info   ObjectInfo
+	header http.Header
+	err    error
+	// index of the request, in the order they were sent.
+	index int
+}
+
+// cancelOnClose cancels the context of the winning request of a
+// hedged GET once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// getObjectHedged sends the GET request, and a duplicate one through
+// opts.HedgeClient if there is no response after opts.HedgeAfter or
+// the first request failed without an answer of the server. The first
+// successful response wins and the other request is canceled.
+func (c *Client) getObjectHedged(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, ObjectInfo, http.Header, error) {
+	hedge := opts.HedgeClient
+	if hedge == nil {
+		hedge = c
+	}
+	opts.HedgeAfter, opts.HedgeClient = 0, nil
+
+	results := make(chan hedgedResult, 2)
+	var cancels []context.CancelFunc
+	send := func(clnt *Client) {
+		rctx, cancel := context.WithCancel(ctx)
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			body, info, header, err := clnt.getObjectOnce(rctx, bucketName, objectName, opts)
+			results <- hedgedResult{body: body, info: info, header: header, err: err, index: index}
+		}()
+	}
+	// cancelOthers cancels all requests but the one with index.
+	cancelOthers := func(index int) {
+		for i, cancel := range cancels {
+			if i != index {
+				cancel()
+			}
+		}
+	}
+
+	send(c)
+	pending, hedged := 1, false
+	timer := time.NewTimer(opts.HedgeAfter)
+	defer timer.Stop()
+
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			if !hedged {
+				send(hedge)
+				pending, hedged = pending+1, true
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				cancelOthers(r.index)
+				go dropHedgedResults(results, pending)
+				return cancelOnClose{ReadCloser: r.body, cancel: cancels[r.index]}, r.info, r.header, nil
+			}
+			cancels[r.index]()
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			// Answers like NoSuchKey are final, failures to answer are
+			// retried right away by the hedged request.
+			statusCode := ToErrorResponse(r.err).StatusCode
+			final := statusCode != 0 && statusCode < http.StatusInternalServerError
+			if !final && !hedged && ctx.Err() == nil {
+				send(hedge)
+				pending, hedged = pending+1, true
+				continue
+			}
+			if !final && pending > 0 {
+				continue
+			}
+			cancelOthers(-1)
+			go dropHedgedResults(results, pending)
+			return nil, ObjectInfo{}, nil, firstErr
+		}
+	}
+}
+
+// dropHedgedResults closes the responses of the n canceled requests of
+// a hedged GET which are still pending.
+func dropHedgedResults(results <-chan hedgedResult, n int) {
+	for ; n > 0; n-- {
+		if r := <-results; r.body != nil {
+			r.body.Close()
+		}
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestGetObjectHedged(t *testing.T) {
+	srv, direct := newTestServerClient(t)
+	putTestObjects(t, direct, map[string]string{"object": "data"})
+
+	// Stall the first GET of every object until it is canceled.
+	var gets, canceled atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && gets.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+				canceled.Add(1)
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	read := func(opts GetObjectOptions) string {
+		t.Helper()
+		start := time.Now()
+		obj, err := clnt.GetObject(ctx, "bucket", "object", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer obj.Close()
+		data, err := io.ReadAll(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := time.Since(start); d > 2*time.Second {
+			t.Fatalf("hedged read took %v", d)
+		}
+		return string(data)
+	}
+
+	if data := read(GetObjectOptions{HedgeAfter: 10 * time.Millisecond}); data != "data" {
+		t.Fatalf("unexpected data %q", data)
+	}
+	if gets.Load() != 2 {
+		t.Fatalf("expected a hedged request, got %d requests", gets.Load())
+	}
+	for i := 0; canceled.Load() == 0 && i < 100; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if canceled.Load() != 1 {
+		t.Fatal("expected the stalled request to be canceled")
+	}
+
+	// Hedge to another endpoint.
+	gets.Store(0)
+	if data := read(GetObjectOptions{HedgeAfter: 10 * time.Millisecond, HedgeClient: direct}); data != "data" {
+		t.Fatalf("unexpected data %q", data)
+	}
+	if gets.Load() != 1 {
+		t.Fatalf("expected the hedged request to go to the other endpoint, got %d requests", gets.Load())
+	}
+
+	// Answers of the server are not hedged.
+	gets.Store(1)
+	start := time.Now()
+	obj, err := clnt.GetObject(ctx, "bucket", "missing", GetObjectOptions{HedgeAfter: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if _, err = obj.Stat(); ToErrorResponse(err).Code != "NoSuchKey" || time.Since(start) > 500*time.Millisecond {
+		t.Fatalf("expected NoSuchKey right away, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// GetObjectProxy gets an object like GetObject but returns the response
+// of the server as is, with its status, headers and body, so that
+// reverse proxies can forward it without rebuilding the headers from an
+// ObjectInfo. Conditional headers of opts, e.g. set with SetMatchETag
+// or SetModified, are sent along and 304 Not Modified and 412
+// Precondition Failed responses are returned like successful ones,
+// other failed responses as errors. The caller must close the body of
+// the response.
+//
+// The request is made once, HedgeAfter is ignored, and the body is not
+// checked against the checksums or ETag of the object.
+func (c *Client) GetObjectProxy(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*http.Response, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Code:       "InvalidBucketName",
+			Message:    err.Error(),
+		}
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Code:       "XMinioInvalidObjectName",
+			Message:    err.Error(),
+		}
+	}
+
+	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
+		bucketName:       bucketName,
+		objectName:       objectName,
+		queryValues:      opts.toQueryValues(),
+		customHeader:     opts.Header(),
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusNotModified, http.StatusPreconditionFailed:
+		return resp, nil
+	}
+	defer closeResponse(resp)
+	err = httpRespToErrorResponse(resp, bucketName, objectName)
+	if errResp := ToErrorResponse(err); errResp.Code == "InvalidObjectState" {
+		err = c.objectArchivedError(ctx, bucketName, objectName, opts, errResp)
+	}
+	return nil, err
+}
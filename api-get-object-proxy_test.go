@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestGetObjectProxy(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"obj": "proxied content"})
+	ctx := context.Background()
+
+	resp, err := clnt.GetObjectProxy(ctx, "bucket", "obj", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || resp.StatusCode != http.StatusOK || string(body) != "proxied content" {
+		t.Fatalf("unexpected response %d %q, %v", resp.StatusCode, body, err)
+	}
+	etag := resp.Header.Get("ETag")
+	if etag == "" || resp.Header.Get("Last-Modified") == "" || resp.Header.Get("Content-Length") != "15" {
+		t.Fatalf("expected object headers, got %v", resp.Header)
+	}
+
+	opts := GetObjectOptions{}
+	opts.SetRange(0, 6)
+	resp, err = clnt.GetObjectProxy(ctx, "bucket", "obj", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || string(body) != "proxied" || resp.Header.Get("Content-Range") != "bytes 0-6/15" {
+		t.Fatalf("unexpected range response %d %q %v", resp.StatusCode, body, resp.Header)
+	}
+
+	// Failed preconditions are responses, not errors.
+	opts = GetObjectOptions{}
+	opts.SetMatchETagExcept(etag)
+	resp, err = clnt.GetObjectProxy(ctx, "bucket", "obj", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Fatalf("expected 304, got %d", resp.StatusCode)
+	}
+	opts = GetObjectOptions{}
+	opts.SetMatchETag("0123456789abcdef0123456789abcdef")
+	resp, err = clnt.GetObjectProxy(ctx, "bucket", "obj", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("expected 412, got %d", resp.StatusCode)
+	}
+
+	if _, err = clnt.GetObjectProxy(ctx, "bucket", "missing", GetObjectOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
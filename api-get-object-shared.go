@@ -0,0 +1,331 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// sharedGetChunkSize is the size of the reads of the body of a shared
+// GET response.
+const sharedGetChunkSize = 32 * 1024
+
+// sharedGetWindow is how much of the body of a shared GET response may
+// be read before further callers stop joining it and send their own
+// request, so that the body is not buffered for late joiners.
+const sharedGetWindow = 1 << 20
+
+// sharedGetMaxBuffer is how far behind the others a reader of a shared
+// GET response may fall before it is moved onto its own request, so
+// that a stalled reader does not keep the whole body buffered.
+const sharedGetMaxBuffer = 8 << 20
+
+// sharedGetKey returns the key identifying GET requests which can share
+// their response, i.e. requests with a key in common get the same
+// response. Only requests for an object, its version and a range can
+// share responses, conditional or encrypted requests and requests with
+// parameters can't.
+func sharedGetKey(bucketName, objectName string, opts GetObjectOptions) (string, bool) {
+	if opts.ServerSideEncryption != nil || opts.PartNumber != 0 || opts.Checksum ||
+		opts.HedgeClient != nil || opts.Internal != (AdvancedGetOptions{}) || len(opts.reqParams) > 0 {
+		return "", false
+	}
+	for k := range opts.headers {
+		if k != "Range" {
+			return "", false
+		}
+	}
+	return bucketName + "\x00" + objectName + "\x00" + opts.VersionID + "\x00" + opts.headers["Range"], true
+}
+
+// sharedGets deduplicates the concurrent identical GET requests of a
+// client, see Options.ShareConcurrentGets. The first request of a key
+// is sent, and requests of the same key made until the first
+// sharedGetWindow bytes of its response are read get it too.
+type sharedGets struct {
+	sync.Mutex
+	flights map[string]*sharedGet
+}
+
+func newSharedGets(enabled bool) *sharedGets {
+	if !enabled {
+		return nil
+	}
+	return &sharedGets{flights: make(map[string]*sharedGet)}
+}
+
+// sharedGet is a GET request shared by several callers, whose response
+// body is buffered until all of them have read it, or fell behind.
+type sharedGet struct {
+	group  *sharedGets
+	key    string
+	resume func(ctx context.Context, info ObjectInfo, header http.Header, offset int64) (io.ReadCloser, error)
+
+	done   chan struct{} // Closed once the response is received.
+	info   ObjectInfo
+	header http.Header
+	err    error
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	body    io.ReadCloser
+	buf     []byte // Body read but not yet by all readers.
+	base    int    // Offset of buf in the body.
+	readErr error  // Error of the last read of body, io.EOF at the end.
+	reading bool   // Whether a reader is reading body.
+	readers int
+	open    map[*sharedGetReader]struct{}
+	dropped bool // Whether all readers are closed.
+	cancel  context.CancelFunc
+}
+
+// get returns the response of the GET request of key, sending it with
+// fetch unless a request of key is in flight. Readers falling behind
+// the others read the rest of the body with resume.
+func (g *sharedGets) get(ctx context.Context, key string,
+	fetch func(context.Context) (io.ReadCloser, ObjectInfo, http.Header, error),
+	resume func(ctx context.Context, info ObjectInfo, header http.Header, offset int64) (io.ReadCloser, error),
+) (io.ReadCloser, ObjectInfo, http.Header, error) {
+	g.Lock()
+	f, ok := g.flights[key]
+	var r *sharedGetReader
+	if ok {
+		f.mu.Lock()
+		// The response of a request whose callers are all gone is
+		// dropped, and the start of the body must still be buffered
+		// to share it.
+		if ok = !f.dropped && f.base == 0 && len(f.buf) <= sharedGetWindow; ok {
+			r = f.join(ctx)
+		}
+		f.mu.Unlock()
+	}
+	var fctx context.Context
+	if !ok {
+		f = &sharedGet{group: g, key: key, resume: resume, done: make(chan struct{}), open: make(map[*sharedGetReader]struct{})}
+		f.cond = sync.NewCond(&f.mu)
+		r = f.join(ctx)
+		// The request is not canceled with the context of the caller
+		// sending it, only once all callers are gone.
+		fctx, f.cancel = context.WithCancel(context.WithoutCancel(ctx))
+		g.flights[key] = f
+	}
+	g.Unlock()
+
+	if !ok {
+		go f.fetch(fctx, fetch)
+	}
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		r.Close()
+		return nil, ObjectInfo{}, nil, ctx.Err()
+	}
+	if f.err != nil {
+		r.Close()
+		return nil, ObjectInfo{}, nil, f.err
+	}
+	return r, f.info, f.header.Clone(), nil
+}
+
+// fetch sends the request.
+func (f *sharedGet) fetch(ctx context.Context, fetch func(context.Context) (io.ReadCloser, ObjectInfo, http.Header, error)) {
+	body, info, header, err := fetch(ctx)
+	f.mu.Lock()
+	f.body, f.info, f.header, f.err = body, info, header, err
+	gone := f.dropped
+	f.mu.Unlock()
+	close(f.done)
+	if err != nil {
+		f.finish()
+	}
+	if gone && body != nil {
+		body.Close()
+	}
+}
+
+// join adds a reader of the body from its start, f.mu must be held.
+func (f *sharedGet) join(ctx context.Context) *sharedGetReader {
+	r := &sharedGetReader{flight: f, ctx: ctx}
+	f.open[r] = struct{}{}
+	f.readers++
+	return r
+}
+
+// trim drops the start of the buffered body read by all readers, f.mu
+// must be held. Readers more than sharedGetMaxBuffer behind the end of
+// the buffered body stop sharing it. The reader which read it last is
+// never that far behind, and keeps the response.
+func (f *sharedGet) trim() {
+	end := f.base + len(f.buf)
+	for r := range f.open {
+		if f.base+len(f.buf)-r.offset > sharedGetMaxBuffer {
+			r.behind = true
+			delete(f.open, r)
+			f.readers--
+			continue
+		}
+		end = min(end, r.offset)
+	}
+	if n := end - f.base; n == len(f.buf) || n >= sharedGetChunkSize {
+		// Copy the rest so that the dropped start is released.
+		f.buf = append([]byte(nil), f.buf[n:]...)
+		f.base = end
+	}
+}
+
+// finish stops sharing the response with further callers.
+func (f *sharedGet) finish() {
+	f.group.Lock()
+	if f.group.flights[f.key] == f {
+		delete(f.group.flights, f.key)
+	}
+	f.group.Unlock()
+}
+
+// sharedGetReader reads the body of a shared GET response.
+type sharedGetReader struct {
+	flight *sharedGet
+	ctx    context.Context
+	offset int // Offset in the body.
+	closed bool
+	behind bool          // Whether the reader fell behind the others.
+	own    io.ReadCloser // Rest of the body, for readers behind.
+}
+
+func (r *sharedGetReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	f := r.flight
+	f.mu.Lock()
+	if r.closed {
+		f.mu.Unlock()
+		return 0, io.ErrClosedPipe
+	}
+	if r.behind {
+		f.mu.Unlock()
+		return r.readOwn(p)
+	}
+	defer f.mu.Unlock()
+	for r.offset >= f.base+len(f.buf) && f.readErr == nil {
+		if f.reading {
+			f.cond.Wait()
+			continue
+		}
+		// Read the next chunk of the body for all readers.
+		f.reading = true
+		f.mu.Unlock()
+		chunk := make([]byte, sharedGetChunkSize)
+		n, err := io.ReadFull(f.body, chunk)
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		f.mu.Lock()
+		f.buf = append(f.buf, chunk[:n]...)
+		f.readErr = err
+		f.reading = false
+		f.cond.Broadcast()
+		if err != nil || f.base+len(f.buf) > sharedGetWindow {
+			go f.finish()
+		}
+	}
+	if r.offset < f.base+len(f.buf) {
+		n := copy(p, f.buf[r.offset-f.base:])
+		r.offset += n
+		f.trim()
+		return n, nil
+	}
+	return 0, f.readErr
+}
+
+// readOwn reads the rest of the body with its own request.
+func (r *sharedGetReader) readOwn(p []byte) (int, error) {
+	if r.own == nil {
+		f := r.flight
+		body, err := f.resume(r.ctx, f.info, f.header, int64(r.offset))
+		if err != nil {
+			return 0, err
+		}
+		r.own = body
+	}
+	n, err := r.own.Read(p)
+	r.offset += n
+	return n, err
+}
+
+func (r *sharedGetReader) Close() error {
+	f := r.flight
+	f.mu.Lock()
+	if r.closed {
+		f.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	if r.behind {
+		f.mu.Unlock()
+		if r.own != nil {
+			return r.own.Close()
+		}
+		return nil
+	}
+	f.readers--
+	delete(f.open, r)
+	f.trim()
+	dropped := f.readers == 0
+	f.dropped = dropped
+	body := f.body
+	f.mu.Unlock()
+	if !dropped {
+		return nil
+	}
+	f.finish()
+	f.cancel()
+	if body != nil {
+		return body.Close()
+	}
+	return nil
+}
+
+// resumeSharedGet sends the GET request of a shared response again, for
+// a reader which fell behind the others, from offset in the body of the
+// response. The request fails if the object changed since.
+func (c *Client) resumeSharedGet(ctx context.Context, bucketName, objectName string, opts GetObjectOptions, info ObjectInfo, header http.Header, offset int64) (io.ReadCloser, error) {
+	start, end := int64(0), info.Size-1
+	if contentRange := header.Get("Content-Range"); contentRange != "" {
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/", &start, &end); err != nil {
+			return nil, err
+		}
+	}
+	opts.headers = nil
+	if err := opts.SetMatchETag(info.ETag); err != nil {
+		return nil, err
+	}
+	switch {
+	case end >= 0:
+		opts.SetRange(start+offset, end)
+	case start+offset > 0:
+		opts.SetRange(start+offset, 0)
+	}
+	body, _, _, err := c.getObjectUnshared(ctx, bucketName, objectName, opts)
+	return body, err
+}
@@ -0,0 +1,315 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestShareConcurrentGets(t *testing.T) {
+	ctx := context.Background()
+	srv := miniotest.NewServer(t)
+
+	var gets int32
+	release := make(chan struct{})
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/object") {
+			atomic.AddInt32(&gets, 1)
+			<-release
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(strings.TrimPrefix(proxy.URL, "http://"), &Options{
+		Creds:               credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:              srv.Region,
+		ShareConcurrentGets: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	if _, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(content), int64(len(content)), PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, _, err := clnt.GetObjectBytes(ctx, "bucket", "object", GetObjectOptions{})
+			if err == nil && !bytes.Equal(data, content) {
+				err = errors.New("unexpected content")
+			}
+			errs <- err
+		}()
+	}
+	// Wait for all callers to share the request before answering it.
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		clnt.sharedGets.Lock()
+		f := clnt.sharedGets.flights["bucket\x00object\x00\x00"]
+		clnt.sharedGets.Unlock()
+		if f != nil {
+			f.mu.Lock()
+			readers := f.readers
+			f.mu.Unlock()
+			if readers == callers {
+				break
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("callers did not share the request")
+		}
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt32(&gets); n != 1 {
+		t.Fatalf("expected a single GET request, got %d", n)
+	}
+
+	// Requests after the shared one are sent again.
+	if _, _, err = clnt.GetObjectBytes(ctx, "bucket", "object", GetObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&gets); n != 2 {
+		t.Fatalf("expected a second GET request, got %d", n)
+	}
+	if len(clnt.sharedGets.flights) != 0 {
+		t.Fatalf("expected no shared requests left, got %d", len(clnt.sharedGets.flights))
+	}
+}
+
+func TestSharedGetBuffer(t *testing.T) {
+	ctx := context.Background()
+	g := newSharedGets(true)
+	const size = 64 << 20
+	var fetches int32
+	fetch := func(context.Context) (io.ReadCloser, ObjectInfo, http.Header, error) {
+		atomic.AddInt32(&fetches, 1)
+		return io.NopCloser(io.LimitReader(zeroReader{}, size)), ObjectInfo{Size: size}, http.Header{}, nil
+	}
+
+	rc, _, _, err := g.get(ctx, "key", fetch, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := rc.(*sharedGetReader).flight
+	var read, maxBuf int
+	for buf := make([]byte, 4096); ; {
+		n, err := rc.Read(buf)
+		read += n
+		f.mu.Lock()
+		maxBuf = max(maxBuf, len(f.buf))
+		f.mu.Unlock()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if read == 2*sharedGetWindow {
+			// Late callers send their own request.
+			late, _, _, err := g.get(ctx, "key", fetch, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if late.(*sharedGetReader).flight == f {
+				t.Fatal("expected a late caller not to share the request")
+			}
+			late.Close()
+		}
+	}
+	if read != size {
+		t.Fatalf("expected %d bytes, got %d", size, read)
+	}
+	if maxBuf > sharedGetChunkSize {
+		t.Fatalf("expected the read body to be released, buffered up to %d bytes", maxBuf)
+	}
+	if n := atomic.LoadInt32(&fetches); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+	rc.Close()
+}
+
+func TestSharedGetSlowReader(t *testing.T) {
+	ctx := context.Background()
+	g := newSharedGets(true)
+	const size = 64 << 20
+	fetch := func(context.Context) (io.ReadCloser, ObjectInfo, http.Header, error) {
+		return io.NopCloser(&patternReader{size: size}), ObjectInfo{Size: size}, http.Header{}, nil
+	}
+	var resumed []int64
+	resume := func(_ context.Context, _ ObjectInfo, _ http.Header, offset int64) (io.ReadCloser, error) {
+		resumed = append(resumed, offset)
+		return io.NopCloser(&patternReader{off: offset, size: size}), nil
+	}
+
+	fast, _, _, err := g.get(ctx, "key", fetch, resume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slow, _, _, err := g.get(ctx, "key", fetch, resume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := fast.(*sharedGetReader).flight
+	if slow.(*sharedGetReader).flight != f {
+		t.Fatal("expected the callers to share the request")
+	}
+	start := make([]byte, 100)
+	if _, err = io.ReadFull(slow, start); err != nil {
+		t.Fatal(err)
+	}
+
+	// The slow reader stalls while the fast one reads the body.
+	var maxBuf int
+	for buf := make([]byte, 4096); ; {
+		_, err := fast.Read(buf)
+		f.mu.Lock()
+		maxBuf = max(maxBuf, len(f.buf))
+		f.mu.Unlock()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if maxBuf > sharedGetMaxBuffer+sharedGetChunkSize {
+		t.Fatalf("expected the buffered body to be capped, buffered up to %d bytes", maxBuf)
+	}
+	fast.Close()
+
+	rest, err := io.ReadAll(slow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(start, rest...)
+	if !bytes.Equal(data, readAll(t, &patternReader{size: size})) {
+		t.Fatalf("unexpected content of %d bytes", len(data))
+	}
+	if len(resumed) != 1 || resumed[0] != int64(len(start)) {
+		t.Fatalf("expected the slow reader to resume at %d, got %v", len(start), resumed)
+	}
+	slow.Close()
+}
+
+func TestResumeSharedGet(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "0123456789"})
+	ctx := context.Background()
+
+	var opts GetObjectOptions
+	if err := opts.SetRange(2, 7); err != nil {
+		t.Fatal(err)
+	}
+	body, info, header, err := clnt.getObjectUnshared(ctx, "bucket", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	rest, err := clnt.resumeSharedGet(ctx, "bucket", "object", opts, info, header, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readAll(t, rest); string(data) != "567" {
+		t.Fatalf("unexpected rest %q", data)
+	}
+
+	// The rest of a changed object is not read.
+	putTestObjects(t, clnt, map[string]string{"object": "changed!!!"})
+	if _, err = clnt.resumeSharedGet(ctx, "bucket", "object", opts, info, header, 3); ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed, got %v", err)
+	}
+}
+
+func readAll(t *testing.T, r io.Reader) []byte {
+	t.Helper()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// patternReader reads size bytes of a repeating pattern from off.
+type patternReader struct {
+	off, size int64
+}
+
+func (r *patternReader) Read(p []byte) (int, error) {
+	if r.off >= r.size {
+		return 0, io.EOF
+	}
+	p = p[:min(int64(len(p)), r.size-r.off)]
+	for i := range p {
+		p[i] = byte((r.off + int64(i)) % 251)
+	}
+	r.off += int64(len(p))
+	return len(p), nil
+}
+
+// zeroReader reads zeros.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestSharedGetKey(t *testing.T) {
+	var opts GetObjectOptions
+	if _, ok := sharedGetKey("bucket", "object", opts); !ok {
+		t.Fatal("expected plain GET to be shared")
+	}
+	opts.SetRange(0, 9)
+	opts.VersionID = "v1"
+	if key, ok := sharedGetKey("bucket", "object", opts); !ok || key != "bucket\x00object\x00v1\x00bytes=0-9" {
+		t.Fatalf("unexpected key %q, %v", key, ok)
+	}
+	opts.SetMatchETag("etag")
+	if _, ok := sharedGetKey("bucket", "object", opts); ok {
+		t.Fatal("expected conditional GET not to be shared")
+	}
+}
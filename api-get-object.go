@@ -247,7 +247,11 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 	}()
 
 	// Create a newObject through the information sent back by reqCh.
-	return newObject(gctx, cancel, reqCh, resCh), nil
+	obj := newObject(gctx, cancel, reqCh, resCh)
+	if opts.OnProgress != nil {
+		obj.progress = newProgressTracker(opts.OnProgress, -1)
+	}
+	return obj, nil
 }
 
 // get request message container to communicate with internal
@@ -302,6 +306,20 @@ type Object struct {
 
 	// Keeps track of if objectInfo has been set yet.
 	objectInfoSet bool
+
+	// Reports bytes read if GetObjectOptions.OnProgress is set.
+	progress *progressTracker
+}
+
+// reportProgress reports n bytes handed out to the caller.
+func (o *Object) reportProgress(n int) {
+	if o.progress == nil {
+		return
+	}
+	if o.objectInfoSet {
+		o.progress.setTotal(o.objectInfo.Size)
+	}
+	o.progress.add(int64(n))
 }
 
 // doGetRequest - sends and blocks on the firstReqCh and reqCh of an object.
@@ -398,6 +416,7 @@ func (o *Object) Read(b []byte) (n int, err error) {
 
 	// Bytes read.
 	bytesRead := int64(response.Size)
+	o.reportProgress(response.Size)
 
 	// Set the new offset.
 	oerr := o.setOffset(bytesRead)
@@ -494,6 +513,7 @@ func (o *Object) ReadAt(b []byte, offset int64) (n int, err error) {
 	}
 	// Bytes read.
 	bytesRead := int64(response.Size)
+	o.reportProgress(response.Size)
 	// There is no valid objectInfo yet
 	// 	to compare against for EOF.
 	if !o.objectInfoSet {
@@ -655,6 +675,28 @@ func newObject(ctx context.Context, cancel context.CancelFunc, reqCh chan<- getR
 // For more information about the HTTP Range header.
 // go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
 func (c *Client) getObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, ObjectInfo, http.Header, error) {
+	if c.sharedGets != nil {
+		if key, ok := sharedGetKey(bucketName, objectName, opts); ok {
+			return c.sharedGets.get(ctx, key, func(ctx context.Context) (io.ReadCloser, ObjectInfo, http.Header, error) {
+				return c.getObjectUnshared(ctx, bucketName, objectName, opts)
+			}, func(ctx context.Context, info ObjectInfo, header http.Header, offset int64) (io.ReadCloser, error) {
+				return c.resumeSharedGet(ctx, bucketName, objectName, opts, info, header, offset)
+			})
+		}
+	}
+	return c.getObjectUnshared(ctx, bucketName, objectName, opts)
+}
+
+// getObjectUnshared - retrieve object, hedging the request if requested.
+func (c *Client) getObjectUnshared(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, ObjectInfo, http.Header, error) {
+	if opts.HedgeAfter > 0 {
+		return c.getObjectHedged(ctx, bucketName, objectName, opts)
+	}
+	return c.getObjectOnce(ctx, bucketName, objectName, opts)
+}
+
+// getObjectOnce - retrieve object from Object Storage with a single request.
+func (c *Client) getObjectOnce(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, ObjectInfo, http.Header, error) {
 	// Validate input arguments.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, ObjectInfo{}, nil, ErrorResponse{
@@ -684,7 +726,11 @@ func (c *Client) getObject(ctx context.Context, bucketName, objectName string, o
 	}
 	if resp != nil {
 		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
-			return nil, ObjectInfo{}, nil, httpRespToErrorResponse(resp, bucketName, objectName)
+			err = httpRespToErrorResponse(resp, bucketName, objectName)
+			if errResp := ToErrorResponse(err); errResp.Code == "InvalidObjectState" {
+				err = c.objectArchivedError(ctx, bucketName, objectName, opts, errResp)
+			}
+			return nil, ObjectInfo{}, nil, err
 		}
 	}
 
@@ -0,0 +1,238 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ArchiveFormat is the container format produced by GetObjectsArchive.
+type ArchiveFormat string
+
+// Supported archive formats.
+const (
+	ArchiveZip ArchiveFormat = "zip"
+	ArchiveTar ArchiveFormat = "tar"
+)
+
+// GetObjectsArchiveOptions selects the objects to archive.
+type GetObjectsArchiveOptions struct {
+	// Keys to include in this order. If empty all objects
+	// below Prefix are included, recursively.
+	Keys   []string
+	Prefix string
+
+	// TrimPrefix removes Prefix from the entry names.
+	TrimPrefix bool
+
+	// Format of the archive, defaults to ArchiveZip.
+	Format ArchiveFormat
+
+	// NumThreads is the number of objects fetched ahead
+	// of the one being written, defaults to 4.
+	NumThreads int
+
+	// Options for each GET, e.g. server side encryption.
+	GetOptions GetObjectOptions
+}
+
+// archiveEntry is an object opened for archiving.
+type archiveEntry struct {
+	key  string
+	obj  *Object
+	info ObjectInfo
+	err  error
+}
+
+// GetObjectsArchive streams a zip or tar archive of many objects.
+// Objects are fetched concurrently and written sequentially, in
+// the order of opts.Keys or in lexical order when listing by prefix.
+// Closing the returned reader aborts the download.
+func (c *Client) GetObjectsArchive(ctx context.Context, bucketName string, opts GetObjectsArchiveOptions) (io.ReadCloser, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	switch opts.Format {
+	case "":
+		opts.Format = ArchiveZip
+	case ArchiveZip, ArchiveTar:
+	default:
+		return nil, errInvalidArgument("Unsupported archive format " + string(opts.Format))
+	}
+	if opts.NumThreads <= 0 {
+		opts.NumThreads = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+
+	// Each key gets a slot, the slots channel bounds the number of
+	// objects in flight and preserves the order.
+	slots := make(chan chan archiveEntry, opts.NumThreads)
+	go func() {
+		defer close(slots)
+		open := func(key string) bool {
+			slot := make(chan archiveEntry, 1)
+			select {
+			case slots <- slot:
+			case <-ctx.Done():
+				return false
+			}
+			go func() {
+				e := archiveEntry{key: key}
+				e.obj, e.err = c.GetObject(ctx, bucketName, key, opts.GetOptions)
+				if e.err == nil {
+					e.info, e.err = e.obj.Stat()
+				}
+				slot <- e
+			}()
+			return true
+		}
+		if len(opts.Keys) > 0 {
+			for _, key := range opts.Keys {
+				if !open(key) {
+					return
+				}
+			}
+			return
+		}
+		listCh := c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: opts.Prefix, Recursive: true})
+		defer func() {
+			// Drain the channel so the listing goroutine can exit.
+			for range listCh {
+			}
+		}()
+		for info := range listCh {
+			if info.Err != nil {
+				slot := make(chan archiveEntry, 1)
+				slot <- archiveEntry{err: info.Err}
+				select {
+				case slots <- slot:
+				case <-ctx.Done():
+				}
+				return
+			}
+			if !open(info.Key) {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		err := writeObjectsArchive(pw, slots, opts)
+		cancel()
+		// Release objects which were fetched ahead.
+		for slot := range slots {
+			if e := <-slot; e.obj != nil {
+				e.obj.Close()
+			}
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return &archiveReader{PipeReader: pr, cancel: cancel}, nil
+}
+
+// archiveReader cancels the pending requests on Close.
+type archiveReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *archiveReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+func writeObjectsArchive(w io.Writer, slots <-chan chan archiveEntry, opts GetObjectsArchiveOptions) error {
+	var (
+		zw *zip.Writer
+		tw *tar.Writer
+	)
+	if opts.Format == ArchiveTar {
+		tw = tar.NewWriter(w)
+	} else {
+		zw = zip.NewWriter(w)
+	}
+
+	for slot := range slots {
+		e := <-slot
+		if e.err != nil {
+			if e.obj != nil {
+				e.obj.Close()
+			}
+			return e.err
+		}
+		name := e.key
+		if opts.TrimPrefix {
+			name = strings.TrimPrefix(name, opts.Prefix)
+			if name == "" {
+				e.obj.Close()
+				continue
+			}
+		}
+		isDir := strings.HasSuffix(name, "/") && e.info.Size == 0
+
+		var (
+			dst io.Writer
+			err error
+		)
+		if tw != nil {
+			hdr := &tar.Header{
+				Name:     name,
+				Size:     e.info.Size,
+				Mode:     0o644,
+				ModTime:  e.info.LastModified,
+				Typeflag: tar.TypeReg,
+			}
+			if isDir {
+				hdr.Mode, hdr.Typeflag = 0o755, tar.TypeDir
+			}
+			err = tw.WriteHeader(hdr)
+			dst = tw
+		} else {
+			hdr := &zip.FileHeader{
+				Name:     name,
+				Method:   zip.Deflate,
+				Modified: e.info.LastModified,
+			}
+			if isDir {
+				hdr.Method = zip.Store
+			}
+			dst, err = zw.CreateHeader(hdr)
+		}
+		if err == nil && !isDir {
+			_, err = io.Copy(dst, e.obj)
+		}
+		e.obj.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if tw != nil {
+		return tw.Close()
+	}
+	return zw.Close()
+}
@@ -0,0 +1,131 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+// newTestServerClient returns a client connected to a new in-memory
+// server with an empty bucket named "bucket".
+func newTestServerClient(t *testing.T) (*miniotest.Server, *Client) {
+	t.Helper()
+	srv := miniotest.NewServer(t)
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.MakeBucket(context.Background(), "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	return srv, clnt
+}
+
+func putTestObjects(t *testing.T, clnt *Client, objects map[string]string) {
+	t.Helper()
+	for k, v := range objects {
+		if _, err := clnt.PutObject(context.Background(), "bucket", k, strings.NewReader(v), int64(len(v)), PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGetObjectsArchive(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{
+		"dir/a.txt":     "aaa",
+		"dir/sub/b.txt": "bbbb",
+		"dir/c.txt":     strings.Repeat("c", 100000),
+		"other.txt":     "other",
+	})
+	ctx := context.Background()
+
+	rd, err := clnt.GetObjectsArchive(ctx, "bucket", GetObjectsArchiveOptions{Prefix: "dir/", TrimPrefix: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd.Close()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	if got := strings.Join(names, ","); got != "a.txt,c.txt,sub/b.txt" {
+		t.Fatalf("unexpected zip entries %s", got)
+	}
+	f, err := zr.File[1].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := io.ReadAll(f)
+	if len(content) != 100000 {
+		t.Fatalf("unexpected content length %d", len(content))
+	}
+
+	rd, err = clnt.GetObjectsArchive(ctx, "bucket", GetObjectsArchiveOptions{
+		Keys:   []string{"other.txt", "dir/a.txt"},
+		Format: ArchiveTar,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rd.Close()
+	tr := tar.NewReader(rd)
+	var got []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(tr)
+		got = append(got, hdr.Name+"="+string(content))
+	}
+	if s := strings.Join(got, ","); s != "other.txt=other,dir/a.txt=aaa" {
+		t.Fatalf("unexpected tar entries %s", s)
+	}
+
+	rd, err = clnt.GetObjectsArchive(ctx, "bucket", GetObjectsArchiveOptions{Keys: []string{"missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadAll(rd); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
@@ -19,6 +19,7 @@ package minio
 
 import (
 	"fmt"
+	"mime"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -48,6 +49,25 @@ type GetObjectOptions struct {
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
 	Checksum bool
 
+	// OnProgress is called as data is read from the returned Object,
+	// see ProgressFunc.
+	OnProgress ProgressFunc
+
+	// HedgeAfter sends a duplicate GET request if the first one did
+	// not respond within HedgeAfter, the first response is used and
+	// the other request is canceled. This cuts the tail latency of
+	// reads, at the cost of extra requests. Disabled if zero.
+	HedgeAfter time.Duration
+
+	// HedgeClient sends the duplicate GET requests of HedgeAfter, e.g.
+	// to another node of the same cluster, instead of the client
+	// making the request.
+	HedgeClient *Client
+
+	// MaxSize limits the size of the objects read in memory by
+	// GetObjectBytes, DefaultMaxObjectBytes if zero.
+	MaxSize int64
+
 	// To be not used by external applications
 	Internal AdvancedGetOptions
 }
@@ -181,6 +201,55 @@ func (o *GetObjectOptions) SetRange(start, end int64) error {
 	return nil
 }
 
+// SetResponseContentType - set the Content-Type header of the response.
+func (o *GetObjectOptions) SetResponseContentType(contentType string) {
+	o.SetReqParam("response-content-type", contentType)
+}
+
+// SetResponseContentDisposition - set the Content-Disposition header of the response.
+func (o *GetObjectOptions) SetResponseContentDisposition(contentDisposition string) {
+	o.SetReqParam("response-content-disposition", contentDisposition)
+}
+
+// SetResponseAttachment - set the Content-Disposition header of the
+// response to download the object as a file named filename, which
+// may contain non-ASCII characters.
+func (o *GetObjectOptions) SetResponseAttachment(filename string) error {
+	if filename == "" {
+		return errInvalidArgument("Filename cannot be empty.")
+	}
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+	if disposition == "" {
+		return errInvalidArgument("Invalid filename " + filename)
+	}
+	o.SetResponseContentDisposition(disposition)
+	return nil
+}
+
+// SetResponseContentEncoding - set the Content-Encoding header of the response.
+func (o *GetObjectOptions) SetResponseContentEncoding(contentEncoding string) {
+	o.SetReqParam("response-content-encoding", contentEncoding)
+}
+
+// SetResponseCacheControl - set the Cache-Control header of the response.
+func (o *GetObjectOptions) SetResponseCacheControl(cacheControl string) {
+	o.SetReqParam("response-cache-control", cacheControl)
+}
+
+// SetResponseExpires - set the Expires header of the response.
+func (o *GetObjectOptions) SetResponseExpires(expires time.Time) error {
+	if expires.IsZero() {
+		return errInvalidArgument("Expires cannot be empty.")
+	}
+	o.SetReqParam("response-expires", expires.UTC().Format(http.TimeFormat))
+	return nil
+}
+
+// SetResponseContentLanguage - set the Content-Language header of the response.
+func (o *GetObjectOptions) SetResponseContentLanguage(contentLanguage string) {
+	o.SetReqParam("response-content-language", contentLanguage)
+}
+
 // toQueryValues - Convert the versionId, partNumber, and reqParams in Options to query string parameters.
 func (o *GetObjectOptions) toQueryValues() url.Values {
 	urlValues := make(url.Values)
@@ -0,0 +1,175 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ListBucketsOptions filters and enriches the buckets returned by
+// ListBucketsWithOptions.
+type ListBucketsOptions struct {
+	// Only return buckets whose name starts with Prefix.
+	Prefix string
+	// Only return buckets created after CreatedAfter and
+	// before CreatedBefore, if set.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// WithRegion resolves the region of each bucket, unless
+	// the server already returned it.
+	WithRegion bool
+	// WithTags fetches the tags of each bucket.
+	WithTags bool
+	// Only return buckets which have all of these tags,
+	// implies WithTags.
+	MatchTags map[string]string
+
+	// NumThreads is the number of buckets resolved
+	// concurrently, defaults to 4.
+	NumThreads int
+}
+
+func (o ListBucketsOptions) match(b BucketInfo) bool {
+	if !strings.HasPrefix(b.Name, o.Prefix) {
+		return false
+	}
+	if !o.CreatedAfter.IsZero() && !b.CreationDate.After(o.CreatedAfter) {
+		return false
+	}
+	return o.CreatedBefore.IsZero() || b.CreationDate.Before(o.CreatedBefore)
+}
+
+// ListBucketsWithOptions lists the buckets owned by the authenticated
+// user like ListBuckets, filtered and optionally enriched with their
+// region and tags, which are resolved concurrently.
+func (c *Client) ListBucketsWithOptions(ctx context.Context, opts ListBucketsOptions) ([]BucketInfo, error) {
+	buckets, err := c.ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := buckets[:0]
+	for _, b := range buckets {
+		if opts.match(b) {
+			filtered = append(filtered, b)
+		}
+	}
+	buckets = filtered
+
+	withTags := opts.WithTags || len(opts.MatchTags) > 0
+	if !opts.WithRegion && !withTags {
+		return buckets, nil
+	}
+	if opts.NumThreads <= 0 {
+		opts.NumThreads = totalWorkers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	indexCh := make(chan int)
+	for i := 0; i < opts.NumThreads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexCh {
+				if err := c.resolveBucketInfo(ctx, &buckets[idx], opts.WithRegion, withTags); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for i := range buckets {
+		select {
+		case indexCh <- i:
+		case <-ctx.Done():
+		}
+	}
+	close(indexCh)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(opts.MatchTags) == 0 {
+		return buckets, nil
+	}
+	filtered = buckets[:0]
+	for _, b := range buckets {
+		if matchAllTags(b.Tags(), opts.MatchTags) {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered, nil
+}
+
+// ListBucketsByTag returns the buckets which have all of the given tags.
+func (c *Client) ListBucketsByTag(ctx context.Context, tags map[string]string) ([]BucketInfo, error) {
+	if len(tags) == 0 {
+		return nil, errInvalidArgument("Tags cannot be empty.")
+	}
+	return c.ListBucketsWithOptions(ctx, ListBucketsOptions{MatchTags: tags})
+}
+
+// resolveBucketInfo sets the region and the tags of b.
+func (c *Client) resolveBucketInfo(ctx context.Context, b *BucketInfo, withRegion, withTags bool) error {
+	if withRegion && b.Region == "" {
+		region, err := c.GetBucketLocation(ctx, b.Name)
+		if err != nil {
+			return err
+		}
+		b.Region = region
+	}
+	if withTags {
+		t, err := c.GetBucketTagging(ctx, b.Name)
+		if err != nil {
+			if ToErrorResponse(err).Code != "NoSuchTagSet" {
+				return err
+			}
+			b.tags = &map[string]string{}
+			return nil
+		}
+		m := t.ToMap()
+		b.tags = &m
+	}
+	return nil
+}
+
+// matchAllTags returns true if tags contains all of want.
+func matchAllTags(tags, want map[string]string) bool {
+	for k, v := range want {
+		if t, ok := tags[k]; !ok || t != v {
+			return false
+		}
+	}
+	return true
+}
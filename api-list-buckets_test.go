@@ -0,0 +1,96 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/tags"
+)
+
+func TestListBucketsWithOptions(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	for _, name := range []string{"logs-a", "logs-b", "data"} {
+		if err := clnt.MakeBucket(ctx, name, MakeBucketOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, team := range map[string]string{"logs-a": "blue", "data": "red"} {
+		bt, err := tags.MapToBucketTags(map[string]string{"team": team, "env": "prod"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = clnt.SetBucketTagging(ctx, name, bt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := func(buckets []BucketInfo, err error) string {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s []string
+		for _, b := range buckets {
+			s = append(s, b.Name)
+		}
+		return strings.Join(s, ",")
+	}
+
+	testCases := []struct {
+		opts ListBucketsOptions
+		want string
+	}{
+		{ListBucketsOptions{}, "bucket,data,logs-a,logs-b"},
+		{ListBucketsOptions{Prefix: "logs-"}, "logs-a,logs-b"},
+		{ListBucketsOptions{CreatedBefore: time.Now().Add(-time.Hour)}, ""},
+		{ListBucketsOptions{CreatedAfter: time.Now().Add(-time.Hour), Prefix: "d"}, "data"},
+		{ListBucketsOptions{MatchTags: map[string]string{"env": "prod"}}, "data,logs-a"},
+		{ListBucketsOptions{MatchTags: map[string]string{"env": "prod", "team": "blue"}, NumThreads: 1}, "logs-a"},
+	}
+	for i, tc := range testCases {
+		if got := names(clnt.ListBucketsWithOptions(ctx, tc.opts)); got != tc.want {
+			t.Errorf("Test %d: expected %q, got %q", i+1, tc.want, got)
+		}
+	}
+
+	buckets, err := clnt.ListBucketsWithOptions(ctx, ListBucketsOptions{Prefix: "logs-", WithRegion: true, WithTags: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range buckets {
+		if b.Region != srv.Region {
+			t.Errorf("expected region %s for %s, got %s", srv.Region, b.Name, b.Region)
+		}
+	}
+	if len(buckets) != 2 || buckets[0].Tags()["team"] != "blue" || buckets[1].Tags() == nil || len(buckets[1].Tags()) != 0 {
+		t.Fatalf("unexpected tags %+v", buckets)
+	}
+	// BucketInfo stays comparable.
+	if buckets[0] == (BucketInfo{}) {
+		t.Fatal("expected a bucket")
+	}
+
+	if got := names(clnt.ListBucketsByTag(ctx, map[string]string{"team": "red"})); got != "data" {
+		t.Fatalf("expected data, got %s", got)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+)
+
+// hasFilters returns true if any of the client side filters is set.
+func (o ListObjectsOptions) hasFilters() bool {
+	return o.MinSize > 0 || o.MaxSize > 0 ||
+		!o.ModifiedAfter.IsZero() || !o.ModifiedBefore.IsZero() ||
+		o.Suffix != "" || o.MatchRegexp != nil || len(o.MatchTags) > 0
+}
+
+// matchObject applies all filters except MatchTags.
+func (o ListObjectsOptions) matchObject(info ObjectInfo) bool {
+	if info.Size < o.MinSize || (o.MaxSize > 0 && info.Size > o.MaxSize) {
+		return false
+	}
+	if !o.ModifiedAfter.IsZero() && !info.LastModified.After(o.ModifiedAfter) {
+		return false
+	}
+	if !o.ModifiedBefore.IsZero() && !info.LastModified.Before(o.ModifiedBefore) {
+		return false
+	}
+	if !strings.HasSuffix(info.Key, o.Suffix) {
+		return false
+	}
+	return o.MatchRegexp == nil || o.MatchRegexp.MatchString(info.Key)
+}
+
+// filterObjects lists the objects selected by opts and drops the ones
+// not matching its filters.
+func (c *Client) filterObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	if len(opts.MatchTags) > 0 {
+		// MinIO servers include metadata and tags in the listing.
+		opts.WithMetadata = true
+	}
+	// The limit applies to the filtered entries.
+	listOpts := opts
+	listOpts.Limit = 0
+	listCtx, cancel := context.WithCancel(ctx)
+	listCh := c.listObjectsUnfiltered(listCtx, bucketName, listOpts)
+
+	objectStatCh := make(chan ObjectInfo, 1)
+	go func() {
+		defer close(objectStatCh)
+		defer cancel()
+		sent := 0
+		for info := range listCh {
+			if info.Err != nil {
+				// Errors are always delivered, including the
+				// context error sent when the caller cancels.
+				objectStatCh <- info
+				continue
+			}
+			// Common prefixes carry neither ETag nor modification time.
+			if info.ETag != "" || !info.LastModified.IsZero() {
+				if !opts.matchObject(info) {
+					continue
+				}
+				if len(opts.MatchTags) > 0 {
+					if info.IsDeleteMarker {
+						continue
+					}
+					match, err := c.matchObjectTags(ctx, bucketName, opts, info)
+					if err != nil {
+						info = ObjectInfo{Err: err}
+					} else if !match {
+						continue
+					}
+				}
+			}
+			select {
+			case objectStatCh <- info:
+			case <-ctx.Done():
+				// Keep draining listCh, it ends with the context error.
+				continue
+			}
+			if sent++; opts.limitReached(sent) {
+				cancel()
+				for range listCh {
+				}
+				return
+			}
+		}
+	}()
+	return objectStatCh
+}
+
+// matchObjectTags matches the tags of info against opts.MatchTags. The
+// tags are only fetched if the server did not return the metadata of
+// the object with the listing, or only returned their count.
+func (c *Client) matchObjectTags(ctx context.Context, bucketName string, opts ListObjectsOptions, info ObjectInfo) (bool, error) {
+	if info.UserMetadata != nil && (info.UserTags != nil || info.UserTagCount == 0) {
+		return matchAllTags(info.UserTags, opts.MatchTags), nil
+	}
+	t, err := c.GetObjectTagging(ctx, bucketName, info.Key, GetObjectTaggingOptions{VersionID: info.VersionID})
+	if err != nil {
+		return false, err
+	}
+	return matchAllTags(t.ToMap(), opts.MatchTags), nil
+}
@@ -0,0 +1,113 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestListObjectsFilters(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Count the tagging requests, MinIO listings include the tags.
+	var taggingRequests int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("tagging") && r.Method == http.MethodGet {
+			atomic.AddInt32(&taggingRequests, 1)
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{
+		"a.txt":     "a",
+		"b.log":     "bbbbbbbbbb",
+		"c.txt":     "ccccc",
+		"dir/d.txt": "d",
+	})
+	for key, team := range map[string]string{"e.txt": "blue", "f.txt": "red"} {
+		_, err = clnt.PutObject(ctx, "bucket", key, strings.NewReader("tagged"), 6, PutObjectOptions{
+			UserTags: map[string]string{"team": team},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list := func(opts ListObjectsOptions) string {
+		t.Helper()
+		var keys []string
+		for info := range clnt.ListObjects(ctx, "bucket", opts) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			keys = append(keys, info.Key)
+		}
+		return strings.Join(keys, ",")
+	}
+
+	testCases := []struct {
+		opts ListObjectsOptions
+		want string
+	}{
+		{ListObjectsOptions{MinSize: 5}, "b.log,c.txt,e.txt,f.txt,dir/"},
+		{ListObjectsOptions{MinSize: 2, MaxSize: 5, Recursive: true}, "c.txt"},
+		{ListObjectsOptions{Suffix: ".txt", Recursive: true}, "a.txt,c.txt,dir/d.txt,e.txt,f.txt"},
+		{ListObjectsOptions{MatchRegexp: regexp.MustCompile(`^[a-c]\.`)}, "a.txt,b.log,c.txt,dir/"},
+		{ListObjectsOptions{ModifiedBefore: time.Now().Add(-time.Hour)}, "dir/"},
+		{ListObjectsOptions{ModifiedAfter: time.Now().Add(-time.Hour), Suffix: ".log"}, "b.log,dir/"},
+		{ListObjectsOptions{MatchTags: map[string]string{"team": "red"}, Recursive: true}, "f.txt"},
+	}
+	for i, tc := range testCases {
+		if got := list(tc.opts); got != tc.want {
+			t.Errorf("Test %d: expected %s, got %s", i+1, tc.want, got)
+		}
+	}
+	if n := atomic.LoadInt32(&taggingRequests); n != 0 {
+		t.Fatalf("expected the tags to be listed, got %d tagging requests", n)
+	}
+
+	// The V1 listing has no metadata, the tags are fetched per object.
+	if got := list(ListObjectsOptions{MatchTags: map[string]string{"team": "blue"}, Recursive: true, UseV1: true}); got != "e.txt" {
+		t.Fatalf("expected e.txt, got %s", got)
+	}
+	if n := atomic.LoadInt32(&taggingRequests); n != 6 {
+		t.Fatalf("expected 6 tagging requests, got %d", n)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultAggregateConcurrency is the number of uploads whose parts are
+// listed at a time by ListIncompleteUploadsWithOptions.
+const defaultAggregateConcurrency = 4
+
+// ListIncompleteUploadsOptions holds the options of
+// ListIncompleteUploadsWithOptions.
+type ListIncompleteUploadsOptions struct {
+	// Only list uploads of objects with this prefix.
+	Prefix string
+
+	// Recursive lists all uploads below Prefix, else only the ones of
+	// its "directory", the uploads of subdirectories being returned as
+	// common prefixes.
+	Recursive bool
+
+	// InitiatedAfter and InitiatedBefore, if not zero, only list the
+	// uploads initiated after, or before, these times.
+	InitiatedAfter  time.Time
+	InitiatedBefore time.Time
+
+	// AggregateSizes sets the Size of the uploads to the total size of
+	// their uploaded parts, listing the parts of Concurrency uploads at
+	// a time, 4 by default. It is off by default as it lists the parts
+	// of every upload.
+	AggregateSizes bool
+	Concurrency    int
+}
+
+// ListIncompleteUploadsResult is the outcome of
+// ListIncompleteUploadsWithOptions.
+type ListIncompleteUploadsResult struct {
+	// Uploads lists the uploads in listing order.
+	Uploads []ObjectMultipartInfo
+
+	// Prefixes lists the common prefixes of non recursive listings.
+	Prefixes []string
+
+	// Parts and TotalSize are the number and total size of the parts
+	// of the uploads, with AggregateSizes only.
+	Parts     int
+	TotalSize int64
+
+	// Oldest is the initiation time of the oldest upload.
+	Oldest time.Time
+}
+
+// ListIncompleteUploadsWithOptions lists the incomplete multipart
+// uploads of the bucket like ListIncompleteUploads, filtered by their
+// initiation time, along with totals e.g. for dashboards. Uploads
+// completed or aborted while their parts are listed are left out.
+func (c *Client) ListIncompleteUploadsWithOptions(ctx context.Context, bucketName string, opts ListIncompleteUploadsOptions) (ListIncompleteUploadsResult, error) {
+	var result ListIncompleteUploadsResult
+	if opts.Concurrency < 0 {
+		return result, errInvalidArgument("Concurrency cannot be negative.")
+	}
+
+	p := c.ListIncompleteUploadsPaginator(bucketName, opts.Prefix, opts.Recursive)
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return result, err
+		}
+		for _, upload := range page {
+			switch {
+			case upload.UploadID == "":
+				result.Prefixes = append(result.Prefixes, upload.Key)
+			case !opts.InitiatedAfter.IsZero() && !upload.Initiated.After(opts.InitiatedAfter):
+			case !opts.InitiatedBefore.IsZero() && !upload.Initiated.Before(opts.InitiatedBefore):
+			default:
+				result.Uploads = append(result.Uploads, upload)
+			}
+		}
+	}
+
+	if opts.AggregateSizes {
+		if err := c.aggregateUploadSizes(ctx, bucketName, &result, opts.Concurrency); err != nil {
+			return result, err
+		}
+	}
+	for _, upload := range result.Uploads {
+		if result.Oldest.IsZero() || upload.Initiated.Before(result.Oldest) {
+			result.Oldest = upload.Initiated
+		}
+	}
+	return result, nil
+}
+
+// aggregateUploadSizes sets the sizes of the uploads of the result and
+// its totals, listing the parts of concurrency uploads at a time.
+func (c *Client) aggregateUploadSizes(ctx context.Context, bucketName string, result *ListIncompleteUploadsResult, concurrency int) error {
+	if concurrency == 0 {
+		concurrency = defaultAggregateConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	parts := make([]int, len(result.Uploads))
+	gone := make([]bool, len(result.Uploads))
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	jobs := make(chan int)
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				upload := &result.Uploads[i]
+				partsInfo, err := c.listObjectParts(ctx, bucketName, upload.Key, upload.UploadID)
+				switch {
+				case err == nil:
+					upload.Size = 0
+					for _, part := range partsInfo {
+						upload.Size += part.Size
+					}
+					parts[i] = len(partsInfo)
+				case ToErrorResponse(err).Code == "NoSuchUpload":
+					gone[i] = true
+				default:
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range result.Uploads {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	uploads := result.Uploads[:0]
+	for i, upload := range result.Uploads {
+		if gone[i] {
+			continue
+		}
+		uploads = append(uploads, upload)
+		result.Parts += parts[i]
+		result.TotalSize += upload.Size
+	}
+	result.Uploads = uploads
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListIncompleteUploadsWithOptions(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	core := Core{clnt}
+	ctx := context.Background()
+	sizes := map[string][]int{"dir/a": {10, 20}, "dir/sub/b": {5}, "c": nil}
+	for _, key := range []string{"dir/a", "dir/sub/b", "c"} {
+		uploadID, err := core.NewMultipartUpload(ctx, "bucket", key, PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, size := range sizes[key] {
+			_, err = core.PutObjectPart(ctx, "bucket", key, uploadID, i+1, bytes.NewReader(make([]byte, size)), int64(size), PutObjectPartOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	result, err := clnt.ListIncompleteUploadsWithOptions(ctx, "bucket", ListIncompleteUploadsOptions{Recursive: true, AggregateSizes: true, Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Uploads) != 3 || result.Parts != 3 || result.TotalSize != 35 || result.Oldest.IsZero() || len(result.Prefixes) != 0 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	for _, upload := range result.Uploads {
+		want := int64(0)
+		for _, size := range sizes[upload.Key] {
+			want += int64(size)
+		}
+		if upload.Size != want {
+			t.Fatalf("expected size %d for %s, got %d", want, upload.Key, upload.Size)
+		}
+	}
+
+	result, err = clnt.ListIncompleteUploadsWithOptions(ctx, "bucket", ListIncompleteUploadsOptions{Prefix: "dir/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Uploads) != 1 || result.Uploads[0].Key != "dir/a" || result.TotalSize != 0 || len(result.Prefixes) != 1 || result.Prefixes[0] != "dir/sub/" {
+		t.Fatalf("unexpected non recursive result %+v", result)
+	}
+
+	for _, opts := range []ListIncompleteUploadsOptions{
+		{Recursive: true, InitiatedAfter: time.Now().Add(time.Hour)},
+		{Recursive: true, InitiatedBefore: time.Now().Add(-time.Hour)},
+	} {
+		result, err = clnt.ListIncompleteUploadsWithOptions(ctx, "bucket", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Uploads) != 0 || !result.Oldest.IsZero() {
+			t.Fatalf("expected no uploads, got %+v", result)
+		}
+	}
+	result, err = clnt.ListIncompleteUploadsWithOptions(ctx, "bucket", ListIncompleteUploadsOptions{Recursive: true, InitiatedAfter: time.Now().Add(-time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Uploads) != 3 {
+		t.Fatalf("expected all uploads, got %+v", result)
+	}
+
+	if _, err = clnt.ListIncompleteUploadsWithOptions(ctx, "bucket", ListIncompleteUploadsOptions{Concurrency: -1}); err == nil {
+		t.Fatal("expected a negative concurrency to fail")
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// applyListedMetadata fills the fields of an object listed with
+// ListObjectsOptions.WithMetadata from the metadata MinIO servers
+// return with the listing, as StatObject fills them from the response
+// headers. It returns false if the server did not return the metadata.
+func applyListedMetadata(info *ObjectInfo) bool {
+	if info.UserMetadata == nil {
+		return false
+	}
+	h := make(http.Header, len(info.UserMetadata))
+	for k, v := range info.UserMetadata {
+		h.Set(k, v)
+	}
+
+	info.Metadata = extractObjMetadata(h)
+	if contentType := strings.TrimSpace(h.Get("Content-Type")); contentType != "" {
+		info.ContentType = contentType
+	}
+	if expires := h.Get("Expires"); expires != "" {
+		if t, err := parseRFC7231Time(expires); err == nil {
+			info.Expires = t
+		}
+	}
+	info.UserTagCount = len(info.UserTags)
+	info.Encryption = encryptionInfo(h)
+
+	for _, c := range []struct {
+		value *string
+		key   string
+	}{
+		{&info.ChecksumCRC32, ChecksumCRC32.Key()},
+		{&info.ChecksumCRC32C, ChecksumCRC32C.Key()},
+		{&info.ChecksumSHA1, ChecksumSHA1.Key()},
+		{&info.ChecksumSHA256, ChecksumSHA256.Key()},
+		{&info.ChecksumCRC64NVME, ChecksumCRC64NVME.Key()},
+	} {
+		if v := h.Get(c.key); v != "" {
+			*c.value = v
+		}
+	}
+	return true
+}
+
+// statListedObjects completes the objects of a listing page the server
+// returned without metadata, see ListObjectsOptions.StatMissingMetadata.
+func (c *Client) statListedObjects(ctx context.Context, bucketName string, opts ListObjectsOptions, page []ObjectInfo) error {
+	if !opts.WithMetadata {
+		return nil
+	}
+	for i := range page {
+		info := &page[i]
+		if applyListedMetadata(info) || !opts.StatMissingMetadata {
+			continue
+		}
+		// Common prefixes carry neither ETag nor modification time.
+		if info.IsDeleteMarker || (info.ETag == "" && info.LastModified.IsZero()) {
+			continue
+		}
+		st, err := c.StatObject(ctx, bucketName, info.Key, StatObjectOptions{VersionID: info.VersionID, Checksum: true})
+		if err != nil {
+			if ToErrorResponse(err).StatusCode == http.StatusNotFound {
+				// Removed since it was listed.
+				continue
+			}
+			return err
+		}
+		info.Metadata = st.Metadata
+		info.UserMetadata = st.UserMetadata
+		info.UserTagCount = st.UserTagCount
+		info.ContentType = st.ContentType
+		info.Expires = st.Expires
+		info.Encryption = st.Encryption
+		info.ObjectLock = st.ObjectLock
+		info.ChecksumCRC32 = st.ChecksumCRC32
+		info.ChecksumCRC32C = st.ChecksumCRC32C
+		info.ChecksumSHA1 = st.ChecksumSHA1
+		info.ChecksumSHA256 = st.ChecksumSHA256
+		info.ChecksumCRC64NVME = st.ChecksumCRC64NVME
+		info.ChecksumMode = st.ChecksumMode
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import "context"
+
+// VersionedObject groups all versions and delete markers of a key.
+type VersionedObject struct {
+	Key string
+
+	// Versions holds the object versions, latest first.
+	Versions []ObjectInfo
+
+	// DeleteMarkers holds the delete markers, latest first.
+	DeleteMarkers []ObjectInfo
+
+	// IsPrefix is set for common prefixes of non-recursive listings,
+	// they have no versions.
+	IsPrefix bool
+
+	Err error
+}
+
+// Latest returns the latest version of the key, which may be a
+// delete marker. It returns false if there is none.
+func (v VersionedObject) Latest() (ObjectInfo, bool) {
+	for _, list := range [][]ObjectInfo{v.Versions, v.DeleteMarkers} {
+		for _, info := range list {
+			if info.IsLatest {
+				return info, true
+			}
+		}
+	}
+	return ObjectInfo{}, false
+}
+
+// IsDeleted returns true if the latest version of the key is a
+// delete marker.
+func (v VersionedObject) IsDeleted() bool {
+	latest, ok := v.Latest()
+	return ok && latest.IsDeleteMarker
+}
+
+// ListObjectVersionsGrouped lists all versions of the objects selected
+// by opts, grouped by key. WithVersions is implied, ReverseVersions is
+// ignored. The same rules as for ListObjects apply to the returned
+// channel, it must be drained until closed.
+func (c *Client) ListObjectVersionsGrouped(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan VersionedObject {
+	opts.WithVersions = true
+	opts.ReverseVersions = false
+
+	resultCh := make(chan VersionedObject, 1)
+	go func() {
+		defer close(resultCh)
+
+		// Common prefixes of a page are listed after its versions, they
+		// are held back to keep the results in lexical order.
+		var (
+			current  *VersionedObject
+			prefixes []string
+		)
+		flush := func() {
+			if current != nil {
+				resultCh <- *current
+				current = nil
+			}
+		}
+		flushPrefixes := func(before string, all bool) {
+			for len(prefixes) > 0 && (all || prefixes[0] < before) {
+				resultCh <- VersionedObject{Key: prefixes[0], IsPrefix: true}
+				prefixes = prefixes[1:]
+			}
+		}
+		for info := range c.listObjectVersions(ctx, bucketName, opts) {
+			if info.Err != nil {
+				flush()
+				flushPrefixes("", true)
+				resultCh <- VersionedObject{Err: info.Err}
+				continue
+			}
+			// Common prefixes carry neither version nor modification time.
+			if info.VersionID == "" && info.LastModified.IsZero() && !info.IsDeleteMarker {
+				if current == nil || info.Key < current.Key {
+					resultCh <- VersionedObject{Key: info.Key, IsPrefix: true}
+				} else {
+					prefixes = append(prefixes, info.Key)
+				}
+				continue
+			}
+			// Versions of a key are listed together, latest first.
+			if current != nil && current.Key != info.Key {
+				flush()
+			}
+			if current == nil {
+				flushPrefixes(info.Key, false)
+				current = &VersionedObject{Key: info.Key}
+			}
+			if info.IsDeleteMarker {
+				current.DeleteMarkers = append(current.DeleteMarkers, info)
+			} else {
+				current.Versions = append(current.Versions, info)
+			}
+		}
+		flush()
+		flushPrefixes("", true)
+	}()
+	return resultCh
+}
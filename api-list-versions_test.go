@@ -0,0 +1,61 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestListObjectVersionsGrouped(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"a": "1", "b": "1", "dir/c": "1"})
+	putTestObjects(t, clnt, map[string]string{"a": "2"})
+	if err := clnt.RemoveObject(ctx, "bucket", "b", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for v := range clnt.ListObjectVersionsGrouped(ctx, "bucket", ListObjectsOptions{}) {
+		if v.Err != nil {
+			t.Fatal(v.Err)
+		}
+		got = append(got, fmt.Sprintf("%s:%d:%d:%v:%v", v.Key, len(v.Versions), len(v.DeleteMarkers), v.IsDeleted(), v.IsPrefix))
+	}
+	want := "a:2:0:false:false,b:1:1:true:false,dir/:0:0:false:true"
+	if s := strings.Join(got, ","); s != want {
+		t.Fatalf("expected %s, got %s", want, s)
+	}
+
+	// Groups span listing pages.
+	for v := range clnt.ListObjectVersionsGrouped(ctx, "bucket", ListObjectsOptions{Prefix: "a", MaxKeys: 1}) {
+		if v.Err != nil {
+			t.Fatal(v.Err)
+		}
+		latest, ok := v.Latest()
+		if v.Key != "a" || len(v.Versions) != 2 || !ok || latest.VersionID != v.Versions[0].VersionID {
+			t.Fatalf("unexpected group %+v", v)
+		}
+	}
+}
@@ -22,7 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"slices"
+	"regexp"
 	"time"
 
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
@@ -57,115 +57,6 @@ func (c *Client) ListBuckets(ctx context.Context) ([]BucketInfo, error) {
 	return listAllMyBucketsResult.Buckets.Bucket, nil
 }
 
-// Bucket List Operations.
-func (c *Client) listObjectsV2(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
-	// Allocate new list objects channel.
-	objectStatCh := make(chan ObjectInfo, 1)
-	// Default listing is delimited at "/"
-	delimiter := "/"
-	if opts.Recursive {
-		// If recursive we do not delimit.
-		delimiter = ""
-	}
-
-	// Return object owner information by default
-	fetchOwner := true
-
-	sendObjectInfo := func(info ObjectInfo) {
-		select {
-		case objectStatCh <- info:
-		case <-ctx.Done():
-		}
-	}
-
-	// Validate bucket name.
-	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		defer close(objectStatCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return objectStatCh
-	}
-
-	// Validate incoming object prefix.
-	if err := s3utils.CheckValidObjectNamePrefix(opts.Prefix); err != nil {
-		defer close(objectStatCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return objectStatCh
-	}
-
-	// Initiate list objects goroutine here.
-	go func(objectStatCh chan<- ObjectInfo) {
-		defer func() {
-			if contextCanceled(ctx) {
-				objectStatCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
-			}
-			close(objectStatCh)
-		}()
-
-		// Save continuationToken for next request.
-		var continuationToken string
-		for {
-			// Get list of objects a maximum of 1000 per request.
-			result, err := c.listObjectsV2Query(ctx, bucketName, opts.Prefix, continuationToken,
-				fetchOwner, opts.WithMetadata, delimiter, opts.StartAfter, opts.MaxKeys, opts.headers)
-			if err != nil {
-				sendObjectInfo(ObjectInfo{
-					Err: err,
-				})
-				return
-			}
-
-			// If contents are available loop through and send over channel.
-			for _, object := range result.Contents {
-				object.ETag = trimEtag(object.ETag)
-				select {
-				// Send object content.
-				case objectStatCh <- object:
-				// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-
-			// Send all common prefixes if any.
-			// NOTE: prefixes are only present if the request is delimited.
-			for _, obj := range result.CommonPrefixes {
-				select {
-				// Send object prefixes.
-				case objectStatCh <- ObjectInfo{Key: obj.Prefix}:
-				// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-
-			// If continuation token present, save it for next request.
-			if result.NextContinuationToken != "" {
-				continuationToken = result.NextContinuationToken
-			}
-
-			// Listing ends result is not truncated, return right here.
-			if !result.IsTruncated {
-				return
-			}
-
-			// Add this to catch broken S3 API implementations.
-			if continuationToken == "" {
-				sendObjectInfo(ObjectInfo{
-					Err: fmt.Errorf("listObjectsV2 is truncated without continuationToken, %s S3 server is incompatible with S3 API", c.endpointURL),
-				})
-				return
-			}
-		}
-	}(objectStatCh)
-	return objectStatCh
-}
-
 // listObjectsV2Query - (List Objects V2) - List some or all (up to 1000) of the objects in a bucket.
 //
 // You can use the request parameters as selection criteria to return a subset of the objects in a bucket.
@@ -277,256 +168,9 @@ func (c *Client) listObjectsV2Query(ctx context.Context, bucketName, objectPrefi
 	return listBucketResult, nil
 }
 
-func (c *Client) listObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
-	// Allocate new list objects channel.
-	objectStatCh := make(chan ObjectInfo, 1)
-	// Default listing is delimited at "/"
-	delimiter := "/"
-	if opts.Recursive {
-		// If recursive we do not delimit.
-		delimiter = ""
-	}
-
-	sendObjectInfo := func(info ObjectInfo) {
-		select {
-		case objectStatCh <- info:
-		case <-ctx.Done():
-		}
-	}
-
-	// Validate bucket name.
-	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		defer close(objectStatCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return objectStatCh
-	}
-	// Validate incoming object prefix.
-	if err := s3utils.CheckValidObjectNamePrefix(opts.Prefix); err != nil {
-		defer close(objectStatCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return objectStatCh
-	}
-
-	// Initiate list objects goroutine here.
-	go func(objectStatCh chan<- ObjectInfo) {
-		defer func() {
-			if contextCanceled(ctx) {
-				objectStatCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
-			}
-			close(objectStatCh)
-		}()
-
-		marker := opts.StartAfter
-		for {
-			// Get list of objects a maximum of 1000 per request.
-			result, err := c.listObjectsQuery(ctx, bucketName, opts.Prefix, marker, delimiter, opts.MaxKeys, opts.headers)
-			if err != nil {
-				sendObjectInfo(ObjectInfo{
-					Err: err,
-				})
-				return
-			}
-
-			// If contents are available loop through and send over channel.
-			for _, object := range result.Contents {
-				// Save the marker.
-				marker = object.Key
-				object.ETag = trimEtag(object.ETag)
-				select {
-				// Send object content.
-				case objectStatCh <- object:
-				// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-
-			// Send all common prefixes if any.
-			// NOTE: prefixes are only present if the request is delimited.
-			for _, obj := range result.CommonPrefixes {
-				select {
-				// Send object prefixes.
-				case objectStatCh <- ObjectInfo{Key: obj.Prefix}:
-				// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-
-			// If next marker present, save it for next request.
-			if result.NextMarker != "" {
-				marker = result.NextMarker
-			}
-
-			// Listing ends result is not truncated, return right here.
-			if !result.IsTruncated {
-				return
-			}
-		}
-	}(objectStatCh)
-	return objectStatCh
-}
-
 func (c *Client) listObjectVersions(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
-	// Allocate new list objects channel.
-	resultCh := make(chan ObjectInfo, 1)
-	// Default listing is delimited at "/"
-	delimiter := "/"
-	if opts.Recursive {
-		// If recursive we do not delimit.
-		delimiter = ""
-	}
-
-	sendObjectInfo := func(info ObjectInfo) {
-		select {
-		case resultCh <- info:
-		case <-ctx.Done():
-		}
-	}
-
-	// Validate bucket name.
-	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		defer close(resultCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return resultCh
-	}
-
-	// Validate incoming object prefix.
-	if err := s3utils.CheckValidObjectNamePrefix(opts.Prefix); err != nil {
-		defer close(resultCh)
-		sendObjectInfo(ObjectInfo{
-			Err: err,
-		})
-		return resultCh
-	}
-
-	// Initiate list objects goroutine here.
-	go func(resultCh chan<- ObjectInfo) {
-		defer func() {
-			if contextCanceled(ctx) {
-				resultCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
-			}
-			close(resultCh)
-		}()
-
-		var (
-			keyMarker       = ""
-			versionIDMarker = ""
-			preName         = ""
-			preKey          = ""
-			perVersions     []Version
-			numVersions     int
-		)
-		send := func(vers []Version) {
-			if opts.WithVersions && opts.ReverseVersions {
-				slices.Reverse(vers)
-				numVersions = len(vers)
-			}
-			for _, version := range vers {
-				info := ObjectInfo{
-					ETag:           trimEtag(version.ETag),
-					Key:            version.Key,
-					LastModified:   version.LastModified.Truncate(time.Millisecond),
-					Size:           version.Size,
-					Owner:          version.Owner,
-					StorageClass:   version.StorageClass,
-					IsLatest:       version.IsLatest,
-					VersionID:      version.VersionID,
-					IsDeleteMarker: version.isDeleteMarker,
-					UserTags:       version.UserTags,
-					UserMetadata:   version.UserMetadata,
-					Internal:       version.Internal,
-					NumVersions:    numVersions,
-				}
-				select {
-				// Send object version info.
-				case resultCh <- info:
-					// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-		}
-		for {
-			// Get list of objects a maximum of 1000 per request.
-			result, err := c.listObjectVersionsQuery(ctx, bucketName, opts, keyMarker, versionIDMarker, delimiter)
-			if err != nil {
-				sendObjectInfo(ObjectInfo{
-					Err: err,
-				})
-				return
-			}
-			if opts.WithVersions && opts.ReverseVersions {
-				for _, version := range result.Versions {
-					if preName == "" {
-						preName = result.Name
-						preKey = version.Key
-					}
-					if result.Name == preName && preKey == version.Key {
-						// If the current name is same as previous name,
-						// we need to append the version to the previous version.
-						perVersions = append(perVersions, version)
-						continue
-					}
-					// Send the file versions.
-					send(perVersions)
-					perVersions = perVersions[:0]
-					perVersions = append(perVersions, version)
-					preName = result.Name
-					preKey = version.Key
-				}
-			} else {
-				send(result.Versions)
-			}
-
-			// Send all common prefixes if any.
-			// NOTE: prefixes are only present if the request is delimited.
-			for _, obj := range result.CommonPrefixes {
-				select {
-				// Send object prefixes.
-				case resultCh <- ObjectInfo{Key: obj.Prefix}:
-				// If receives done from the caller, return here.
-				case <-ctx.Done():
-					return
-				}
-			}
-
-			// If next key marker is present, save it for next request.
-			if result.NextKeyMarker != "" {
-				keyMarker = result.NextKeyMarker
-			}
-
-			// If next version id marker is present, save it for next request.
-			if result.NextVersionIDMarker != "" {
-				versionIDMarker = result.NextVersionIDMarker
-			}
-
-			// If context is canceled, return here.
-			if contextCanceled(ctx) {
-				return
-			}
-
-			// Listing ends result is not truncated, return right here.
-			if !result.IsTruncated {
-				// sent the lasted file with versions
-				if opts.ReverseVersions && len(perVersions) > 0 {
-					send(perVersions)
-				}
-				return
-			}
-		}
-	}(resultCh)
-	return resultCh
+	opts.WithVersions = true
+	return pagesToChan(ctx, c.ListObjectsPaginator(bucketName, opts), objectInfoErr)
 }
 
 // listObjectVersions - (List Object Versions) - List some or all (up to 1000) of the existing objects
@@ -727,8 +371,18 @@ type ListObjectsOptions struct {
 	ReverseVersions bool
 	// Include objects versions in the listing
 	WithVersions bool
-	// Include objects metadata in the listing
+	// Include objects metadata in the listing. MinIO servers
+	// return the content type, user metadata, tags, encryption
+	// and checksums of the objects with the listing, they are set
+	// in ObjectInfo as StatObject sets them. Other servers do not
+	// return any metadata, the UserMetadata of the objects is nil
+	// then, unless StatMissingMetadata is set.
 	WithMetadata bool
+	// StatMissingMetadata fetches the metadata of each object
+	// with a HEAD request if the server did not return it with a
+	// WithMetadata listing, e.g. with other servers than MinIO or
+	// with UseV1. Objects removed meanwhile are listed without.
+	StatMissingMetadata bool
 	// Only list objects with the prefix
 	Prefix string
 	// Ignore '/' delimiter
@@ -741,13 +395,71 @@ type ListObjectsOptions struct {
 	// object onwards, this value can also be set
 	// for Marker when `UseV1` is set to true.
 	StartAfter string
+	// Delimiter groups keys into common prefixes,
+	// defaults to "/", ignored if Recursive is set.
+	Delimiter string
+	// Limit stops the listing after this many entries,
+	// common prefixes included. No more entries than
+	// needed are requested from the server.
+	Limit int
 
 	// Use the deprecated list objects V1 API
 	UseV1 bool
 
+	// Client side filters, objects which do not match all of
+	// the set filters are skipped. Common prefixes and errors
+	// are always returned.
+	//
+	// Only return objects of at least MinSize bytes and, if
+	// MaxSize is set, of at most MaxSize bytes.
+	MinSize int64
+	MaxSize int64
+	// Only return objects modified after ModifiedAfter and
+	// before ModifiedBefore, if set.
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+	// Only return objects whose key ends with Suffix.
+	Suffix string
+	// Only return objects whose key matches MatchRegexp.
+	MatchRegexp *regexp.Regexp
+	// Only return objects which have all of these tags. MinIO
+	// servers return the tags with the listing, for other servers
+	// the tags of each candidate object are fetched.
+	MatchTags map[string]string
+
 	headers http.Header
 }
 
+// delimiter returns the delimiter to list with.
+func (o ListObjectsOptions) delimiter() string {
+	if o.Recursive {
+		// If recursive we do not delimit.
+		return ""
+	}
+	if o.Delimiter != "" {
+		return o.Delimiter
+	}
+	return "/"
+}
+
+// pageSize returns the max-keys of the next listing request
+// after sent entries were returned.
+func (o ListObjectsOptions) pageSize(sent int) int {
+	if o.Limit <= 0 {
+		return o.MaxKeys
+	}
+	if remaining := o.Limit - sent; o.MaxKeys <= 0 || remaining < o.MaxKeys {
+		return remaining
+	}
+	return o.MaxKeys
+}
+
+// limitReached returns true if the listing must stop after
+// sent entries were returned.
+func (o ListObjectsOptions) limitReached(sent int) bool {
+	return o.Limit > 0 && sent >= o.Limit
+}
+
 // Set adds a key value pair to the options. The
 // key-value pair will be part of the HTTP GET request
 // headers.
@@ -769,23 +481,14 @@ func (o *ListObjectsOptions) Set(key, value string) {
 // caller must drain the channel entirely and wait until channel is closed before proceeding, without
 // waiting on the channel to be closed completely you might leak goroutines.
 func (c *Client) ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
-	if opts.WithVersions {
-		return c.listObjectVersions(ctx, bucketName, opts)
-	}
-
-	// Use legacy list objects v1 API
-	if opts.UseV1 {
-		return c.listObjects(ctx, bucketName, opts)
-	}
-
-	// Check whether this is snowball region, if yes ListObjectsV2 doesn't work, fallback to listObjectsV1.
-	if location, ok := c.bucketLocCache.Get(bucketName); ok {
-		if location == "snowball" {
-			return c.listObjects(ctx, bucketName, opts)
-		}
+	if opts.hasFilters() {
+		return c.filterObjects(ctx, bucketName, opts)
 	}
+	return c.listObjectsUnfiltered(ctx, bucketName, opts)
+}
 
-	return c.listObjectsV2(ctx, bucketName, opts)
+func (c *Client) listObjectsUnfiltered(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	return pagesToChan(ctx, c.ListObjectsPaginator(bucketName, opts), objectInfoErr)
 }
 
 // ListIncompleteUploads - List incompletely uploaded multipart objects.
@@ -796,7 +499,9 @@ func (c *Client) ListObjects(ctx context.Context, bucketName string, opts ListOb
 //
 // Your input parameters are just bucketName, objectPrefix, recursive.
 // If you enable recursive as 'true' this function will return back all
-// the multipart objects in a given bucket name.
+// the multipart objects in a given bucket name. See
+// ListIncompleteUploadsWithOptions to filter the uploads by initiation
+// time and aggregate their sizes.
 //
 //	api := client.New(....)
 //	// Recurively list all objects in 'mytestbucket'
@@ -820,85 +525,9 @@ func contextCanceled(ctx context.Context) bool {
 
 // listIncompleteUploads lists all incomplete uploads.
 func (c *Client) listIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo {
-	// Allocate channel for multipart uploads.
-	objectMultipartStatCh := make(chan ObjectMultipartInfo, 1)
-	// Delimiter is set to "/" by default.
-	delimiter := "/"
-	if recursive {
-		// If recursive do not delimit.
-		delimiter = ""
-	}
-	// Validate bucket name.
-	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		defer close(objectMultipartStatCh)
-		objectMultipartStatCh <- ObjectMultipartInfo{
-			Err: err,
-		}
-		return objectMultipartStatCh
-	}
-	// Validate incoming object prefix.
-	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
-		defer close(objectMultipartStatCh)
-		objectMultipartStatCh <- ObjectMultipartInfo{
-			Err: err,
-		}
-		return objectMultipartStatCh
-	}
-	go func(objectMultipartStatCh chan<- ObjectMultipartInfo) {
-		defer func() {
-			if contextCanceled(ctx) {
-				objectMultipartStatCh <- ObjectMultipartInfo{
-					Err: ctx.Err(),
-				}
-			}
-			close(objectMultipartStatCh)
-		}()
-
-		// object and upload ID marker for future requests.
-		var objectMarker string
-		var uploadIDMarker string
-		for {
-			// list all multipart uploads.
-			result, err := c.listMultipartUploadsQuery(ctx, bucketName, objectMarker, uploadIDMarker, objectPrefix, delimiter, 0)
-			if err != nil {
-				objectMultipartStatCh <- ObjectMultipartInfo{
-					Err: err,
-				}
-				return
-			}
-			objectMarker = result.NextKeyMarker
-			uploadIDMarker = result.NextUploadIDMarker
-
-			// Send all multipart uploads.
-			for _, obj := range result.Uploads {
-				// Calculate total size of the uploaded parts if 'aggregateSize' is enabled.
-				select {
-				// Send individual uploads here.
-				case objectMultipartStatCh <- obj:
-				// If the context is canceled
-				case <-ctx.Done():
-					return
-				}
-			}
-			// Send all common prefixes if any.
-			// NOTE: prefixes are only present if the request is delimited.
-			for _, obj := range result.CommonPrefixes {
-				select {
-				// Send delimited prefixes here.
-				case objectMultipartStatCh <- ObjectMultipartInfo{Key: obj.Prefix, Size: 0}:
-				// If context is canceled.
-				case <-ctx.Done():
-					return
-				}
-			}
-			// Listing ends if result not truncated, return right here.
-			if !result.IsTruncated {
-				return
-			}
-		}
-	}(objectMultipartStatCh)
-	// return.
-	return objectMultipartStatCh
+	return pagesToChan(ctx, c.ListIncompleteUploadsPaginator(bucketName, objectPrefix, recursive), func(err error) ObjectMultipartInfo {
+		return ObjectMultipartInfo{Err: err}
+	})
 }
 
 // listMultipartUploadsQuery - (List Multipart Uploads).
@@ -991,8 +620,6 @@ func (c *Client) listMultipartUploadsQuery(ctx context.Context, bucketName, keyM
 }
 
 // listObjectParts list all object parts recursively.
-//
-//lint:ignore U1000 Keep this around
 func (c *Client) listObjectParts(ctx context.Context, bucketName, objectName, uploadID string) (partsInfo map[int]ObjectPart, err error) {
 	// Part number marker for the next batch of request.
 	var nextPartNumberMarker int
@@ -0,0 +1,148 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestListObjectsLimit(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Record the max-keys of each listing request.
+	var (
+		mu      sync.Mutex
+		maxKeys []string
+	)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.TrimSuffix(r.URL.Path, "/") == "/bucket" {
+			mu.Lock()
+			maxKeys = append(maxKeys, r.URL.Query().Get("max-keys"))
+			mu.Unlock()
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{
+		"a": "a", "b": "b", "c-1": "c", "c-2": "c", "d": "d", "e": "e",
+	})
+
+	testCases := []struct {
+		opts     ListObjectsOptions
+		want     string
+		requests string
+	}{
+		{ListObjectsOptions{Limit: 3}, "a,b,c-1", "3"},
+		{ListObjectsOptions{Limit: 3, UseV1: true}, "a,b,c-1", "3"},
+		{ListObjectsOptions{Limit: 3, WithVersions: true}, "a,b,c-1", "3"},
+		{ListObjectsOptions{Limit: 3, MaxKeys: 2}, "a,b,c-1", "2,1"},
+		{ListObjectsOptions{Limit: 3, Delimiter: "-"}, "a,b,c-", "3"},
+		{ListObjectsOptions{Limit: 10, Delimiter: "-", StartAfter: "b"}, "d,e,c-", "10"},
+		{ListObjectsOptions{Limit: 2, Suffix: "-2"}, "c-2", ""},
+	}
+	for i, tc := range testCases {
+		mu.Lock()
+		maxKeys = nil
+		mu.Unlock()
+		var keys []string
+		for info := range clnt.ListObjects(ctx, "bucket", tc.opts) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			keys = append(keys, info.Key)
+		}
+		if got := strings.Join(keys, ","); got != tc.want {
+			t.Errorf("Test %d: expected %s, got %s", i+1, tc.want, got)
+		}
+		mu.Lock()
+		requests := strings.Join(maxKeys, ",")
+		mu.Unlock()
+		if tc.requests != "" && requests != tc.requests {
+			t.Errorf("Test %d: expected max-keys %s, got %s", i+1, tc.requests, requests)
+		}
+	}
+}
+
+func TestListObjectsWithMetadata(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	_, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{
+		ContentType:  "text/plain",
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "red", "env": "prod"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(opts ListObjectsOptions) ObjectInfo {
+		t.Helper()
+		var objects []ObjectInfo
+		for info := range clnt.ListObjects(ctx, "bucket", opts) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			objects = append(objects, info)
+		}
+		if len(objects) != 1 {
+			t.Fatalf("expected 1 object, got %d", len(objects))
+		}
+		return objects[0]
+	}
+
+	for _, opts := range []ListObjectsOptions{
+		{WithMetadata: true},
+		{WithMetadata: true, WithVersions: true},
+		{WithMetadata: true, UseV1: true, StatMissingMetadata: true},
+	} {
+		info := list(opts)
+		if info.ContentType != "text/plain" || info.Metadata.Get("X-Amz-Meta-Color") != "blue" || info.UserTagCount != 2 {
+			t.Fatalf("%+v: unexpected metadata %q %v %d", opts, info.ContentType, info.Metadata, info.UserTagCount)
+		}
+	}
+
+	// V1 listings do not return metadata.
+	if info := list(ListObjectsOptions{WithMetadata: true, UseV1: true}); info.UserMetadata != nil || info.ContentType != "" {
+		t.Fatalf("unexpected metadata %v", info.UserMetadata)
+	}
+	// The tags are fetched when the metadata only has their count.
+	info := list(ListObjectsOptions{UseV1: true, WithMetadata: true, StatMissingMetadata: true, MatchTags: map[string]string{"team": "red"}})
+	if info.Key != "object" {
+		t.Fatalf("unexpected object %q", info.Key)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// MoveObjectOptions configures MoveObject and MovePrefix.
+type MoveObjectOptions struct {
+	// NoOverwrite fails the move of an object if its destination
+	// exists, the check is done atomically by the server.
+	NoOverwrite bool
+
+	// VersionID moves a version of the source, which is then deleted
+	// permanently. Otherwise the latest version is moved and a delete
+	// marker is created in versioned buckets. Ignored by MovePrefix.
+	VersionID string
+
+	// NumWorkers is the number of objects moved concurrently by
+	// MovePrefix, 4 if zero.
+	NumWorkers int
+}
+
+// singleCopyLimit is the size of the largest object moved, promoted or
+// copied by prefix with a single copy, larger ones are copied in parts.
+var singleCopyLimit int64 = maxPartSize
+
+// MoveObject renames src to dst in bucket. The object is copied
+// server-side with its metadata and tags, the copy is verified and
+// the source is only deleted once the copy is known to be complete.
+// The copy is conditional on the ETag of the source, so a source
+// overwritten during the move is reported as an error and not deleted.
+func (c *Client) MoveObject(ctx context.Context, bucket, src, dst string, opts MoveObjectOptions) (UploadInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucket); err != nil {
+		return UploadInfo{}, err
+	}
+	if err := s3utils.CheckValidObjectName(src); err != nil {
+		return UploadInfo{}, err
+	}
+	if err := s3utils.CheckValidObjectName(dst); err != nil {
+		return UploadInfo{}, err
+	}
+	if src == dst {
+		return UploadInfo{}, errInvalidArgument("Source and destination of a move must be different.")
+	}
+
+	st, err := c.StatObject(ctx, bucket, src, StatObjectOptions{VersionID: opts.VersionID})
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	srcTags, err := c.sourceTags(ctx, bucket, src, opts.VersionID, st)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	dstOpts := CopyDestOptions{
+		Bucket:      bucket,
+		Object:      dst,
+		NoOverwrite: opts.NoOverwrite,
+	}
+	srcOpts := CopySrcOptions{
+		Bucket:    bucket,
+		Object:    src,
+		VersionID: opts.VersionID,
+		MatchETag: st.ETag,
+	}
+	var info UploadInfo
+	if st.Size > singleCopyLimit {
+		// Single copies are limited to 5GiB. The parts get the
+		// metadata and tags of the source from the client.
+		dstOpts.ReplaceMetadata = true
+		dstOpts.UserMetadata = sourceMetadata(st)
+		dstOpts.ReplaceTags = true
+		dstOpts.UserTags = srcTags
+		info, err = c.ComposeObject(ctx, dstOpts, srcOpts)
+	} else {
+		info, err = c.CopyObject(ctx, dstOpts, srcOpts)
+	}
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	if err = c.verifyMove(ctx, bucket, st, srcTags, dst, info); err != nil {
+		return info, err
+	}
+
+	if err = c.RemoveObject(ctx, bucket, src, RemoveObjectOptions{VersionID: opts.VersionID}); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+// verifyMove checks that the copy info of a move to dst is present and
+// has the content, content type and tags of the source st.
+func (c *Client) verifyMove(ctx context.Context, bucket string, st ObjectInfo, srcTags map[string]string, dst string, info UploadInfo) error {
+	cp, err := c.StatObject(ctx, bucket, dst, StatObjectOptions{VersionID: info.VersionID})
+	if err != nil {
+		return err
+	}
+	// The ETags of encrypted and multipart objects, including copies
+	// in parts, are not the MD5 of their content and change with the
+	// copy.
+	compareETags := !strings.Contains(st.ETag, "-") && !strings.Contains(cp.ETag, "-") && st.Metadata.Get("X-Amz-Server-Side-Encryption") != "aws:kms"
+	if cp.Size != st.Size || cp.ContentType != st.ContentType || (compareETags && cp.ETag != st.ETag) || (info.ETag != "" && cp.ETag != info.ETag) {
+		return fmt.Errorf("copy of %s to %s does not match the source, the source is not deleted", st.Key, dst)
+	}
+	cpTags, err := c.sourceTags(ctx, bucket, dst, cp.VersionID, cp)
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(cpTags, srcTags) {
+		return fmt.Errorf("copy of %s to %s does not have the tags of the source, the source is not deleted", st.Key, dst)
+	}
+	return nil
+}
+
+// MovePrefixResult is the outcome of MovePrefix.
+type MovePrefixResult struct {
+	// Moved is the number of objects and Bytes their total size.
+	Moved int64
+	Bytes int64
+
+	// Failed lists the objects which could not be moved, their source
+	// is not deleted.
+	Failed []CopyObjectError
+}
+
+// MovePrefix moves the objects below srcPrefix of bucket below
+// dstPrefix with MoveObject, e.g. to rename a directory. Objects are
+// moved concurrently and the failures to move individual objects are
+// reported in the result, the returned error is set if listing fails.
+func (c *Client) MovePrefix(ctx context.Context, bucket, srcPrefix, dstPrefix string, opts MoveObjectOptions) (MovePrefixResult, error) {
+	var result MovePrefixResult
+	if err := s3utils.CheckValidBucketName(bucket); err != nil {
+		return result, err
+	}
+	if strings.HasPrefix(dstPrefix, srcPrefix) {
+		// The moved objects would be listed again.
+		return result, errInvalidArgument("Destination prefix cannot be below the source prefix.")
+	}
+	workers := opts.NumWorkers
+	if workers <= 0 {
+		workers = 4
+	}
+	opts.VersionID = ""
+
+	var mu sync.Mutex
+	jobs := make(chan ObjectInfo)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range jobs {
+				dst := dstPrefix + strings.TrimPrefix(obj.Key, srcPrefix)
+				_, err := c.MoveObject(ctx, bucket, obj.Key, dst, opts)
+				mu.Lock()
+				if err != nil {
+					result.Failed = append(result.Failed, CopyObjectError{Key: obj.Key, Err: err})
+				} else {
+					result.Moved++
+					result.Bytes += obj.Size
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var listErr error
+	for obj := range c.ListObjects(ctx, bucket, ListObjectsOptions{Prefix: srcPrefix, Recursive: true}) {
+		if obj.Err != nil {
+			listErr = obj.Err
+			break
+		}
+		jobs <- obj
+	}
+	close(jobs)
+	wg.Wait()
+	return result, listErr
+}
@@ -0,0 +1,130 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMoveObject(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	_, err := clnt.PutObject(ctx, "bucket", "a", strings.NewReader("abc"), 3, PutObjectOptions{
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"team": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"taken": "x"})
+
+	if _, err = clnt.MoveObject(ctx, "bucket", "a", "taken", MoveObjectOptions{NoOverwrite: true}); ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed, got %v", err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "a", StatObjectOptions{}); err != nil {
+		t.Fatalf("source deleted by a failed move: %v", err)
+	}
+
+	info, err := clnt.MoveObject(ctx, "bucket", "a", "b", MoveObjectOptions{NoOverwrite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Key != "b" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "a", StatObjectOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected source to be deleted, got %v", err)
+	}
+	st, err := clnt.StatObject(ctx, "bucket", "b", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Size != 3 || st.UserMetadata["Color"] != "blue" || st.UserTagCount != 1 {
+		t.Fatalf("unexpected destination %+v", st)
+	}
+
+	if _, err = clnt.MoveObject(ctx, "bucket", "b", "b", MoveObjectOptions{}); err == nil {
+		t.Fatal("expected moving an object onto itself to fail")
+	}
+}
+
+func TestMoveLargeObject(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	_, err := clnt.PutObject(ctx, "bucket", "a", strings.NewReader("abc"), 3, PutObjectOptions{
+		UserMetadata: map[string]string{"Color": "blue"},
+		UserTags:     map[string]string{"Shape": "round"},
+		ContentType:  "text/csv",
+		CacheControl: "no-cache",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Objects above the limit are copied in parts.
+	defer func(limit int64) { singleCopyLimit = limit }(singleCopyLimit)
+	singleCopyLimit = 1
+	if _, err = clnt.MoveObject(ctx, "bucket", "a", "b", MoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	st, err := clnt.StatObject(ctx, "bucket", "b", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Size != 3 || st.UserMetadata["Color"] != "blue" || st.ContentType != "text/csv" || st.Metadata.Get("Cache-Control") != "no-cache" {
+		t.Fatalf("unexpected destination %+v", st)
+	}
+	tags, err := clnt.GetObjectTagging(ctx, "bucket", "b", GetObjectTaggingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := tags.Get("Shape"); v != "round" || tags.Count() != 1 {
+		t.Fatalf("unexpected destination tags %v", tags.ToMap())
+	}
+}
+
+func TestMovePrefix(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"dir/a": "a", "dir/b/c": "bc", "dir/d": "ddd", "new/d": "x", "other": "y"})
+
+	result, err := clnt.MovePrefix(ctx, "bucket", "dir/", "new/", MoveObjectOptions{NoOverwrite: true, NumWorkers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Moved != 2 || result.Bytes != 3 || len(result.Failed) != 1 || result.Failed[0].Key != "dir/d" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	var keys []string
+	for obj := range clnt.ListObjects(ctx, "bucket", ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+		keys = append(keys, obj.Key)
+	}
+	if got := strings.Join(keys, ","); got != "dir/d,new/a,new/b/c,new/d,other" {
+		t.Fatalf("unexpected keys %s", got)
+	}
+
+	if _, err = clnt.MovePrefix(ctx, "bucket", "new/", "new/sub/", MoveObjectOptions{}); err == nil {
+		t.Fatal("expected moving below the source prefix to fail")
+	}
+}
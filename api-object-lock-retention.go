@@ -0,0 +1,164 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// objectLockCacheTTL is how long object lock configurations of buckets
+// are cached, see GetBucketObjectLockConfiguration.
+const objectLockCacheTTL = 5 * time.Minute
+
+// DefaultRetention is the retention applied to new objects of a bucket
+// uploaded without an explicit retention.
+type DefaultRetention struct {
+	Mode     RetentionMode
+	Validity uint
+	Unit     ValidityUnit
+}
+
+// IsEmpty returns whether the bucket has no default retention.
+func (r DefaultRetention) IsEmpty() bool {
+	return r.Mode == "" || r.Validity == 0
+}
+
+// RetainUntil returns the retain until date of an object created at t.
+func (r DefaultRetention) RetainUntil(t time.Time) time.Time {
+	if r.Unit == Years {
+		return t.AddDate(int(r.Validity), 0, 0)
+	}
+	return t.AddDate(0, 0, int(r.Validity))
+}
+
+// ObjectLockConfiguration is the object lock configuration of a bucket.
+type ObjectLockConfiguration struct {
+	// Enabled is false for buckets without object lock.
+	Enabled          bool
+	DefaultRetention DefaultRetention
+}
+
+// objectLockCache holds the object lock configurations of buckets.
+type objectLockCache struct {
+	sync.Mutex
+	items map[string]objectLockCacheItem
+}
+
+type objectLockCacheItem struct {
+	config  ObjectLockConfiguration
+	expires time.Time
+}
+
+func newObjectLockCache() *objectLockCache {
+	return &objectLockCache{
+		items: make(map[string]objectLockCacheItem),
+	}
+}
+
+// Get - Returns the configuration of the bucket if cached and not expired.
+func (r *objectLockCache) Get(bucketName string) (config ObjectLockConfiguration, ok bool) {
+	r.Lock()
+	defer r.Unlock()
+	item, ok := r.items[bucketName]
+	if !ok || time.Now().After(item.expires) {
+		return ObjectLockConfiguration{}, false
+	}
+	return item.config, true
+}
+
+// Set - Caches the configuration of the bucket for objectLockCacheTTL.
+func (r *objectLockCache) Set(bucketName string, config ObjectLockConfiguration) {
+	r.Lock()
+	defer r.Unlock()
+	r.items[bucketName] = objectLockCacheItem{config: config, expires: time.Now().Add(objectLockCacheTTL)}
+}
+
+// Delete - Deletes a bucket name from cache.
+func (r *objectLockCache) Delete(bucketName string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.items, bucketName)
+}
+
+// GetBucketObjectLockConfiguration returns the object lock configuration
+// of the bucket, like GetObjectLockConfig, in a typed form. Buckets
+// without object lock have a configuration which is not Enabled.
+//
+// Configurations are cached by the client for a few minutes, changes
+// made with SetBucketObjectLockConfig of the client are seen at once,
+// changes made by other clients once the cached configuration expires.
+func (c *Client) GetBucketObjectLockConfiguration(ctx context.Context, bucketName string) (ObjectLockConfiguration, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return ObjectLockConfiguration{}, err
+	}
+	if config, ok := c.objectLockCache.Get(bucketName); ok {
+		return config, nil
+	}
+
+	var config ObjectLockConfiguration
+	enabled, mode, validity, unit, err := c.GetObjectLockConfig(ctx, bucketName)
+	switch {
+	case err == nil:
+		config.Enabled = enabled == "Enabled"
+		if mode != nil && validity != nil && unit != nil {
+			config.DefaultRetention = DefaultRetention{Mode: *mode, Validity: *validity, Unit: *unit}
+		}
+	case ToErrorResponse(err).Code == "ObjectLockConfigurationNotFoundError":
+	default:
+		return ObjectLockConfiguration{}, err
+	}
+	c.objectLockCache.Set(bucketName, config)
+	return config, nil
+}
+
+// ResolveRetention returns the retention a new object of the bucket
+// uploaded with opts gets: the explicit Mode and RetainUntilDate of
+// opts, completed by the default retention of the bucket if either is
+// missing, with the retain until date computed from now.
+//
+// Without explicit nor default retention the mode is empty and the
+// date zero. An explicit mode or date without default retention to
+// complete it is an error, as servers require both.
+func (c *Client) ResolveRetention(ctx context.Context, bucketName string, opts PutObjectOptions) (RetentionMode, time.Time, error) {
+	mode, retainUntil := opts.Mode, opts.RetainUntilDate
+	if mode != "" && !retainUntil.IsZero() {
+		return mode, retainUntil, nil
+	}
+
+	config, err := c.GetBucketObjectLockConfiguration(ctx, bucketName)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if !config.Enabled || config.DefaultRetention.IsEmpty() {
+		if mode != "" || !retainUntil.IsZero() {
+			return "", time.Time{}, errInvalidArgument("Retention mode and retain until date must be set together without default retention on bucket " + bucketName + ".")
+		}
+		return "", time.Time{}, nil
+	}
+	if mode == "" {
+		mode = config.DefaultRetention.Mode
+	}
+	if retainUntil.IsZero() {
+		retainUntil = config.DefaultRetention.RetainUntil(time.Now().UTC())
+	}
+	return mode, retainUntil, nil
+}
@@ -0,0 +1,99 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResolveRetention(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	// Buckets without object lock have no retention to inherit.
+	mode, retainUntil, err := clnt.ResolveRetention(ctx, "bucket", PutObjectOptions{})
+	if err != nil || mode != "" || !retainUntil.IsZero() {
+		t.Fatalf("expected no retention, got %v, %v, %v", mode, retainUntil, err)
+	}
+	if _, _, err = clnt.ResolveRetention(ctx, "bucket", PutObjectOptions{Mode: Governance}); ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected incomplete retention to be refused, got %v", err)
+	}
+
+	governance, validity, days := Governance, uint(30), Days
+	if err = clnt.SetBucketObjectLockConfig(ctx, "bucket", &governance, &validity, &days); err != nil {
+		t.Fatal(err)
+	}
+	config, err := clnt.GetBucketObjectLockConfiguration(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := DefaultRetention{Mode: Governance, Validity: 30, Unit: Days}
+	if !config.Enabled || config.DefaultRetention != expected {
+		t.Fatalf("unexpected object lock configuration %+v", config)
+	}
+
+	before := time.Now().UTC().Truncate(time.Second)
+	info, err := clnt.PutObject(ctx, "bucket", "inherited", strings.NewReader("data"), 4, PutObjectOptions{InheritRetention: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytesInfo, err := clnt.PutObjectBytes(ctx, "bucket", "inherited-bytes", []byte("data"), PutObjectOptions{InheritRetention: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{info.Key, bytesInfo.Key} {
+		gotMode, gotUntil, err := clnt.GetObjectRetention(ctx, "bucket", key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotMode == nil || *gotMode != Governance || gotUntil.Before(before.AddDate(0, 0, 30)) || gotUntil.After(time.Now().AddDate(0, 0, 30)) {
+			t.Fatalf("%s: unexpected retention %v until %v", key, gotMode, gotUntil)
+		}
+	}
+
+	// Explicit settings win over the default retention.
+	explicit := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	mode, retainUntil, err = clnt.ResolveRetention(ctx, "bucket", PutObjectOptions{Mode: Compliance, RetainUntilDate: explicit})
+	if err != nil || mode != Compliance || !retainUntil.Equal(explicit) {
+		t.Fatalf("expected explicit retention, got %v, %v, %v", mode, retainUntil, err)
+	}
+	mode, retainUntil, err = clnt.ResolveRetention(ctx, "bucket", PutObjectOptions{Mode: Compliance})
+	if err != nil || mode != Compliance || retainUntil.Before(before.AddDate(0, 0, 30)) {
+		t.Fatalf("expected compliance mode for 30 days, got %v, %v, %v", mode, retainUntil, err)
+	}
+
+	// Changes by other clients are seen once the cached configuration expires.
+	other, err := New(clnt.EndpointURL().Host, &Options{Creds: clnt.credsProvider, Region: srv.Region})
+	if err != nil {
+		t.Fatal(err)
+	}
+	years := Years
+	if err = other.SetBucketObjectLockConfig(ctx, "bucket", &governance, &validity, &years); err != nil {
+		t.Fatal(err)
+	}
+	if config, err = clnt.GetBucketObjectLockConfiguration(ctx, "bucket"); err != nil || config.DefaultRetention.Unit != Days {
+		t.Fatalf("expected cached configuration, got %+v, %v", config, err)
+	}
+	clnt.objectLockCache.Delete("bucket")
+	if config, err = clnt.GetBucketObjectLockConfiguration(ctx, "bucket"); err != nil || config.DefaultRetention.Unit != Years {
+		t.Fatalf("expected updated configuration, got %+v, %v", config, err)
+	}
+}
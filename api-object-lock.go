@@ -172,6 +172,7 @@ func (c *Client) SetBucketObjectLockConfig(ctx context.Context, bucketName strin
 	// Execute PUT bucket object lock configuration.
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
 	defer closeResponse(resp)
+	c.objectLockCache.Delete(bucketName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,148 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// MetadataMergeMode selects how UpdateObjectMetadata combines the
+// new metadata with the metadata of the object.
+type MetadataMergeMode int
+
+const (
+	// MetadataMerge sets the given keys and keeps all other user
+	// metadata, keys with an empty value are removed.
+	MetadataMerge MetadataMergeMode = iota
+	// MetadataReplace replaces all user metadata.
+	MetadataReplace
+)
+
+// preservedHeaders are the system headers kept by UpdateObjectMetadata
+// unless they are part of the new metadata.
+var preservedHeaders = []string{
+	"Content-Type",
+	"Content-Encoding",
+	"Content-Disposition",
+	"Content-Language",
+	"Cache-Control",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+// metadataHeaderKey returns the header name used for a metadata key
+// the way PutObjectOptions and CopyDestOptions send it.
+func metadataHeaderKey(k string) string {
+	if isAmzHeader(k) || isStandardHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
+		return http.CanonicalHeaderKey(k)
+	}
+	return http.CanonicalHeaderKey("X-Amz-Meta-" + k)
+}
+
+// UpdateObjectMetadata changes the metadata of an object in place by
+// copying it onto itself. Content headers, storage class, server side
+// encryption, tags and object lock settings are kept unless newMeta
+// sets them, objects larger than 5GiB are copied with a multipart
+// upload. Objects encrypted with SSE-C are not supported.
+func (c *Client) UpdateObjectMetadata(ctx context.Context, bucketName, objectName string, newMeta map[string]string, mergeMode MetadataMergeMode) (UploadInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return UploadInfo{}, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return UploadInfo{}, err
+	}
+
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{})
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	var sse encrypt.ServerSide
+	switch info.Encryption.Type() {
+	case encrypt.SSEC:
+		return UploadInfo{}, errInvalidArgument("Updating the metadata of SSE-C encrypted objects is not supported.")
+	case encrypt.KMS:
+		sse, err = encrypt.NewSSEKMSWithOptions(info.Encryption.KMSKeyID, encrypt.KMSOptions{BucketKey: info.Encryption.BucketKeyEnabled})
+		if err != nil {
+			return UploadInfo{}, err
+		}
+	case encrypt.S3:
+		sse = encrypt.NewSSE()
+	}
+
+	meta := make(map[string]string)
+	for _, k := range preservedHeaders {
+		if v := info.Metadata.Get(k); v != "" {
+			meta[k] = v
+		}
+	}
+	if !info.Expires.IsZero() {
+		meta["Expires"] = info.Expires.UTC().Format(http.TimeFormat)
+	}
+	if mergeMode == MetadataMerge {
+		for k, v := range info.Metadata {
+			if strings.HasPrefix(k, "X-Amz-Meta-") {
+				meta[k] = v[0]
+			}
+		}
+	}
+	for k, v := range newMeta {
+		k = metadataHeaderKey(k)
+		if v == "" {
+			delete(meta, k)
+			continue
+		}
+		meta[k] = v
+	}
+
+	dst := CopyDestOptions{
+		Bucket:          bucketName,
+		Object:          objectName,
+		Encryption:      sse,
+		UserMetadata:    meta,
+		ReplaceMetadata: true,
+		LegalHold:       info.ObjectLock.LegalHold,
+		Mode:            info.ObjectLock.Mode,
+		RetainUntilDate: info.ObjectLock.RetainUntilDate,
+	}
+	// Copy the version that was looked at, unless it changes meanwhile.
+	src := CopySrcOptions{
+		Bucket:    bucketName,
+		Object:    objectName,
+		VersionID: info.VersionID,
+		MatchETag: info.ETag,
+	}
+	if info.Size > maxPartSize {
+		// Multipart copies do not carry over the tags.
+		if info.UserTagCount > 0 {
+			otags, err := c.GetObjectTagging(ctx, bucketName, objectName, GetObjectTaggingOptions{VersionID: info.VersionID})
+			if err != nil {
+				return UploadInfo{}, err
+			}
+			dst.UserTags = otags.ToMap()
+			dst.ReplaceTags = true
+		}
+		return c.ComposeObject(ctx, dst, src)
+	}
+	return c.CopyObject(ctx, dst, src)
+}
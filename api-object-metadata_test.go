@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+)
+
+func TestUpdateObjectMetadata(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	_, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{
+		ContentType:          "text/plain",
+		CacheControl:         "max-age=60",
+		UserMetadata:         map[string]string{"Color": "red", "Size": "big"},
+		UserTags:             map[string]string{"team": "blue"},
+		ServerSideEncryption: encrypt.NewSSE(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(wantMeta map[string]string, contentType string) {
+		t.Helper()
+		info, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(map[string]string(info.UserMetadata), wantMeta) {
+			t.Errorf("expected metadata %v, got %v", wantMeta, info.UserMetadata)
+		}
+		if info.ContentType != contentType || info.Metadata.Get("Cache-Control") != "max-age=60" {
+			t.Errorf("unexpected content headers %v", info.Metadata)
+		}
+		if info.Encryption.Type() != encrypt.S3 {
+			t.Errorf("expected SSE-S3 to be kept, got %+v", info.Encryption)
+		}
+		otags, err := clnt.GetObjectTagging(ctx, "bucket", "object", GetObjectTaggingOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, _ := otags.Get("team"); v != "blue" {
+			t.Errorf("expected tags to be kept, got %s", otags)
+		}
+	}
+
+	_, err = clnt.UpdateObjectMetadata(ctx, "bucket", "object", map[string]string{"color": "blue", "x-amz-meta-size": "", "Owner": "ops"}, MetadataMerge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(map[string]string{"Color": "blue", "Owner": "ops"}, "text/plain")
+
+	_, err = clnt.UpdateObjectMetadata(ctx, "bucket", "object", map[string]string{"Shape": "round", "Content-Type": "application/json"}, MetadataReplace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(map[string]string{"Shape": "round"}, "application/json")
+
+	if _, err = clnt.UpdateObjectMetadata(ctx, "bucket", "missing", nil, MetadataMerge); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
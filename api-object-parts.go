@@ -0,0 +1,161 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ObjectPartInfo is a part of an object as uploaded, see ObjectParts.
+// The checksums of the part are empty if the object was uploaded
+// without or the parts were found by probing, its ETag and
+// LastModified are always empty.
+type ObjectPartInfo struct {
+	ObjectPart
+
+	// Offset of the first byte of the part in the object.
+	Offset int64
+}
+
+// ObjectParts returns the parts of an object as uploaded, so that it
+// can be downloaded in parallel with ranges or the PartNumber option
+// aligned on the parts, and its composite checksum validated part by
+// part. Objects uploaded in a single request have a single part.
+//
+// The parts are listed with GetObjectAttributes. Servers which do not
+// support it, or only list the parts of objects uploaded with
+// checksums like AWS S3, are probed with a HEAD request per part,
+// which returns the sizes of the parts only. opts.VersionID and the
+// SSE-C key of opts.ServerSideEncryption are honored.
+func (c *Client) ObjectParts(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) ([]ObjectPartInfo, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	if parts, ok := c.objectAttributeParts(ctx, bucketName, objectName, opts); ok {
+		return parts, nil
+	}
+	return c.probeObjectParts(ctx, bucketName, objectName, opts)
+}
+
+// objectAttributeParts lists the parts with GetObjectAttributes, it
+// returns false if they are not all listed.
+func (c *Client) objectAttributeParts(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) ([]ObjectPartInfo, bool) {
+	attrOpts := ObjectAttributesOptions{
+		VersionID:            opts.VersionID,
+		ServerSideEncryption: opts.ServerSideEncryption,
+	}
+	attrs, err := c.GetObjectAttributes(ctx, bucketName, objectName, attrOpts)
+	if err != nil {
+		return nil, false
+	}
+	if attrs.ObjectParts.PartsCount == 0 {
+		// Single part objects have the checksums of the object, unless
+		// these are composite.
+		part := ObjectPartInfo{ObjectPart: ObjectPart{PartNumber: 1, Size: int64(attrs.ObjectSize)}}
+		if attrs.Checksum.ChecksumType != "COMPOSITE" {
+			part.ChecksumCRC32 = attrs.Checksum.ChecksumCRC32
+			part.ChecksumCRC32C = attrs.Checksum.ChecksumCRC32C
+			part.ChecksumSHA1 = attrs.Checksum.ChecksumSHA1
+			part.ChecksumSHA256 = attrs.Checksum.ChecksumSHA256
+			part.ChecksumCRC64NVME = attrs.Checksum.ChecksumCRC64NVME
+		}
+		return []ObjectPartInfo{part}, true
+	}
+
+	parts := make([]ObjectPartInfo, 0, attrs.ObjectParts.PartsCount)
+	var offset int64
+	paginator := c.ObjectAttributePartsPaginator(bucketName, objectName, attrOpts)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, false
+		}
+		for _, part := range page {
+			parts = append(parts, ObjectPartInfo{
+				ObjectPart: ObjectPart{
+					PartNumber:        part.PartNumber,
+					Size:              int64(part.Size),
+					ChecksumCRC32:     part.ChecksumCRC32,
+					ChecksumCRC32C:    part.ChecksumCRC32C,
+					ChecksumSHA1:      part.ChecksumSHA1,
+					ChecksumSHA256:    part.ChecksumSHA256,
+					ChecksumCRC64NVME: part.ChecksumCRC64NVME,
+				},
+				Offset: offset,
+			})
+			offset += int64(part.Size)
+		}
+	}
+	if len(parts) != attrs.ObjectParts.PartsCount || int64(attrs.ObjectSize) != offset {
+		return nil, false
+	}
+	return parts, true
+}
+
+// probeObjectParts finds the sizes of the parts with HEAD requests of
+// every part.
+func (c *Client) probeObjectParts(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) ([]ObjectPartInfo, error) {
+	var parts []ObjectPartInfo
+	var offset int64
+	for partNumber, partsCount := 1, 1; partNumber <= partsCount; partNumber++ {
+		opts.PartNumber = partNumber
+		resp, err := c.executeMethod(ctx, http.MethodHead, requestMetadata{
+			bucketName:       bucketName,
+			objectName:       objectName,
+			queryValues:      opts.toQueryValues(),
+			customHeader:     opts.Header(),
+			contentSHA256Hex: emptySHA256Hex,
+		})
+		closeResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+			return nil, httpRespToErrorResponse(resp, bucketName, objectName)
+		}
+		size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+		if err != nil {
+			return nil, ErrorResponse{
+				Code:       "InternalError",
+				Message:    "Content-Length of part " + strconv.Itoa(partNumber) + " is invalid: " + err.Error(),
+				BucketName: bucketName,
+				Key:        objectName,
+			}
+		}
+		if partNumber == 1 {
+			// Servers ignoring partNumber return the whole object,
+			// which is a single part then.
+			if count, err := strconv.Atoi(resp.Header.Get(amzMpPartsCount)); err == nil && count > 1 {
+				partsCount = count
+				parts = make([]ObjectPartInfo, 0, count)
+			}
+		}
+		parts = append(parts, ObjectPartInfo{ObjectPart: ObjectPart{PartNumber: partNumber, Size: size}, Offset: offset})
+		offset += size
+	}
+	return parts, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// noAttributesTransport answers GetObjectAttributes requests like
+// servers without support for it.
+type noAttributesTransport struct {
+	http.RoundTripper
+}
+
+func (t noAttributesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !req.URL.Query().Has("attributes") {
+		return t.RoundTripper.RoundTrip(req)
+	}
+	body := "<Error><Code>NotImplemented</Code><Message>A header you provided implies functionality that is not implemented</Message></Error>"
+	return &http.Response{
+		StatusCode: http.StatusNotImplemented,
+		Header:     http.Header{"Content-Type": []string{"application/xml"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestObjectParts(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	newClient := func(transport http.RoundTripper) *Client {
+		t.Helper()
+		clnt, err := New(srv.Endpoint(), &Options{
+			Creds:           credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+			Region:          srv.Region,
+			TrailingHeaders: true,
+			Transport:       transport,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+	clnt := newClient(nil)
+	ctx := context.Background()
+
+	const partSize = 5 << 20
+	data := bytes.Repeat([]byte("a"), 2*partSize+1)
+	_, err := clnt.PutObject(ctx, "bucket", "multipart", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		PartSize: partSize,
+		Checksum: ChecksumCRC32C,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"single": "single part"})
+
+	probing := newClient(noAttributesTransport{http.DefaultTransport})
+	for _, c := range []*Client{clnt, probing} {
+		parts, err := c.ObjectParts(ctx, "bucket", "multipart", GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(parts) != 3 {
+			t.Fatalf("expected 3 parts, got %+v", parts)
+		}
+		for i, part := range parts {
+			size := int64(partSize)
+			if i == 2 {
+				size = 1
+			}
+			if part.PartNumber != i+1 || part.Offset != int64(i)*partSize || part.Size != size {
+				t.Fatalf("unexpected part %d: %+v", i+1, part)
+			}
+			if hasChecksum := part.Checksum(ChecksumCRC32C) != ""; hasChecksum != (c == clnt) {
+				t.Fatalf("unexpected checksum of part %d: %+v", i+1, part)
+			}
+		}
+
+		parts, err = c.ObjectParts(ctx, "bucket", "single", GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(parts) != 1 || parts[0].PartNumber != 1 || parts[0].Offset != 0 || parts[0].Size != int64(len("single part")) {
+			t.Fatalf("expected a single part, got %+v", parts)
+		}
+
+		if _, err = c.ObjectParts(ctx, "bucket", "missing", GetObjectOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+			t.Fatalf("expected NoSuchKey, got %v", err)
+		}
+	}
+}
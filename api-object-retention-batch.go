@@ -0,0 +1,217 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// RetentionBatchOptions selects the objects updated by SetRetentionBatch
+// and the retention and legal hold applied to them.
+type RetentionBatchOptions struct {
+	// Objects to update, e.g. the output of ListObjects. If nil,
+	// the objects below Prefix are listed recursively.
+	Objects <-chan ObjectInfo
+	Prefix  string
+	// AllVersions updates all versions below Prefix instead of
+	// the latest ones only.
+	AllVersions bool
+
+	// Mode and RetainUntilDate, if set, are applied to each object.
+	Mode            *RetentionMode
+	RetainUntilDate *time.Time
+	// ClearRetention removes the retention of each object, which
+	// requires GovernanceBypass for governance mode retention.
+	ClearRetention bool
+	// ExtendOnly skips objects retained until RetainUntilDate or
+	// later, so that no retention is ever shortened.
+	ExtendOnly       bool
+	GovernanceBypass bool
+
+	// LegalHold, if set, is applied to each object.
+	LegalHold *LegalHoldStatus
+
+	// NumThreads is the number of objects updated concurrently,
+	// defaults to 4.
+	NumThreads int
+
+	// OnProgress, if set, is called after each object with the
+	// number of objects processed and failed so far.
+	OnProgress func(done, failed int)
+}
+
+func (o RetentionBatchOptions) validate() error {
+	setRetention := o.Mode != nil || o.RetainUntilDate != nil
+	if setRetention && (o.Mode == nil || o.RetainUntilDate == nil) {
+		return errInvalidArgument("Retention mode and retain until date must be set together.")
+	}
+	if setRetention && o.ClearRetention {
+		return errInvalidArgument("Retention cannot be set and cleared at the same time.")
+	}
+	if o.ExtendOnly && !setRetention {
+		return errInvalidArgument("ExtendOnly requires a retain until date.")
+	}
+	if !setRetention && !o.ClearRetention && o.LegalHold == nil {
+		return errInvalidArgument("Neither retention nor legal hold is set.")
+	}
+	if o.Mode != nil && !o.Mode.IsValid() {
+		return errInvalidArgument("Invalid retention mode " + o.Mode.String())
+	}
+	if o.LegalHold != nil && !o.LegalHold.IsValid() {
+		return errInvalidArgument("Invalid legal hold status " + o.LegalHold.String())
+	}
+	return nil
+}
+
+// RetentionBatchError is the failure to update an object in
+// SetRetentionBatch, listing errors have no ObjectName.
+type RetentionBatchError struct {
+	ObjectName string
+	VersionID  string
+	Err        error
+}
+
+// SetRetentionBatch applies or clears the retention and legal hold of
+// many objects concurrently. Failures are sent on the returned channel,
+// which is closed once all objects were processed and must be drained.
+func (c *Client) SetRetentionBatch(ctx context.Context, bucketName string, opts RetentionBatchOptions) <-chan RetentionBatchError {
+	errorCh := make(chan RetentionBatchError, 1)
+
+	err := s3utils.CheckValidBucketName(bucketName)
+	if err == nil {
+		err = opts.validate()
+	}
+	if err != nil {
+		go func() {
+			defer close(errorCh)
+			errorCh <- RetentionBatchError{Err: err}
+		}()
+		return errorCh
+	}
+	if opts.NumThreads <= 0 {
+		opts.NumThreads = totalWorkers
+	}
+
+	go func() {
+		defer close(errorCh)
+
+		objects := opts.Objects
+		if objects == nil {
+			listCtx, cancel := context.WithCancel(ctx)
+			listCh := c.ListObjects(listCtx, bucketName, ListObjectsOptions{
+				Prefix:       opts.Prefix,
+				Recursive:    true,
+				WithVersions: opts.AllVersions,
+			})
+			defer func() {
+				// Drain the channel so the listing goroutine can exit.
+				cancel()
+				for range listCh {
+				}
+			}()
+			objects = listCh
+		}
+
+		var (
+			mu           sync.Mutex
+			done, failed int
+		)
+		report := func(info ObjectInfo, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			done++
+			if err != nil {
+				failed++
+				errorCh <- RetentionBatchError{ObjectName: info.Key, VersionID: info.VersionID, Err: err}
+			}
+			if opts.OnProgress != nil {
+				opts.OnProgress(done, failed)
+			}
+		}
+
+		var wg sync.WaitGroup
+		workCh := make(chan ObjectInfo)
+		for i := 0; i < opts.NumThreads; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for info := range workCh {
+					report(info, c.applyRetention(ctx, bucketName, info, opts))
+				}
+			}()
+		}
+
+	loop:
+		for info := range objects {
+			if info.Err != nil {
+				mu.Lock()
+				errorCh <- RetentionBatchError{Err: info.Err}
+				mu.Unlock()
+				continue
+			}
+			// Skip common prefixes and delete markers.
+			if info.IsDeleteMarker || (info.ETag == "" && info.LastModified.IsZero()) {
+				continue
+			}
+			select {
+			case workCh <- info:
+			case <-ctx.Done():
+				break loop
+			}
+		}
+		close(workCh)
+		wg.Wait()
+	}()
+	return errorCh
+}
+
+// applyRetention updates the retention and legal hold of one object.
+func (c *Client) applyRetention(ctx context.Context, bucketName string, info ObjectInfo, opts RetentionBatchOptions) error {
+	if opts.Mode != nil || opts.ClearRetention {
+		apply := true
+		if opts.ExtendOnly {
+			_, until, err := c.GetObjectRetention(ctx, bucketName, info.Key, info.VersionID)
+			if err != nil && ToErrorResponse(err).Code != "NoSuchObjectLockConfiguration" {
+				return err
+			}
+			apply = until == nil || until.Before(*opts.RetainUntilDate)
+		}
+		if apply {
+			err := c.PutObjectRetention(ctx, bucketName, info.Key, PutObjectRetentionOptions{
+				GovernanceBypass: opts.GovernanceBypass,
+				Mode:             opts.Mode,
+				RetainUntilDate:  opts.RetainUntilDate,
+				VersionID:        info.VersionID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if opts.LegalHold != nil {
+		return c.PutObjectLegalHold(ctx, bucketName, info.Key, PutObjectLegalHoldOptions{
+			VersionID: info.VersionID,
+			Status:    opts.LegalHold,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSetRetentionBatch(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"logs/a": "a", "logs/b": "b", "logs/c": "c", "other": "o"})
+
+	collect := func(errCh <-chan RetentionBatchError) []RetentionBatchError {
+		var errs []RetentionBatchError
+		for e := range errCh {
+			errs = append(errs, e)
+		}
+		return errs
+	}
+
+	// Retain logs/a far in the future, it must not be shortened.
+	mode := Governance
+	far := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+	err := clnt.PutObjectRetention(ctx, "bucket", "logs/a", PutObjectRetentionOptions{Mode: &mode, RetainUntilDate: &far})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	near := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	var done int
+	errs := collect(clnt.SetRetentionBatch(ctx, "bucket", RetentionBatchOptions{
+		Prefix:          "logs/",
+		Mode:            &mode,
+		RetainUntilDate: &near,
+		ExtendOnly:      true,
+		NumThreads:      2,
+		OnProgress:      func(n, _ int) { done = n },
+	}))
+	if len(errs) != 0 || done != 3 {
+		t.Fatalf("unexpected errors %v, %d objects done", errs, done)
+	}
+	for key, want := range map[string]time.Time{"logs/a": far, "logs/b": near, "logs/c": near} {
+		_, until, err := clnt.GetObjectRetention(ctx, "bucket", key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !until.Equal(want) {
+			t.Errorf("%s: expected retention until %v, got %v", key, want, until)
+		}
+	}
+	if _, _, err = clnt.GetObjectRetention(ctx, "bucket", "other", ""); err == nil {
+		t.Fatal("expected no retention outside of the prefix")
+	}
+
+	// Clearing governance retention fails without bypass, per object.
+	errs = collect(clnt.SetRetentionBatch(ctx, "bucket", RetentionBatchOptions{Prefix: "logs/", ClearRetention: true}))
+	if len(errs) != 3 || errs[0].ObjectName == "" || ToErrorResponse(errs[0].Err).Code != "AccessDenied" {
+		t.Fatalf("expected 3 access denied errors, got %v", errs)
+	}
+
+	// Legal holds on an explicit stream of objects.
+	objects := make(chan ObjectInfo, 2)
+	for _, key := range []string{"logs/b", "other"} {
+		info, err := clnt.StatObject(ctx, "bucket", key, StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		objects <- info
+	}
+	close(objects)
+	hold := LegalHoldEnabled
+	errs = collect(clnt.SetRetentionBatch(ctx, "bucket", RetentionBatchOptions{
+		Objects:          objects,
+		LegalHold:        &hold,
+		ClearRetention:   true,
+		GovernanceBypass: true,
+	}))
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	status, err := clnt.GetObjectLegalHold(ctx, "bucket", "other", GetObjectLegalHoldOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *status != LegalHoldEnabled {
+		t.Fatalf("expected legal hold, got %s", *status)
+	}
+	if _, _, err = clnt.GetObjectRetention(ctx, "bucket", "logs/b", ""); ToErrorResponse(err).Code != "NoSuchObjectLockConfiguration" {
+		t.Fatalf("expected retention to be cleared, got %v", err)
+	}
+
+	if errs = collect(clnt.SetRetentionBatch(ctx, "bucket", RetentionBatchOptions{Prefix: "logs/"})); len(errs) != 1 || errs[0].ObjectName != "" {
+		t.Fatalf("expected a single validation error, got %v", errs)
+	}
+}
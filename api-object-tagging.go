@@ -99,6 +99,13 @@ type GetObjectTaggingOptions struct {
 // GetObjectTagging fetches object tag(s) with options to target
 // a specific object version in a versioned bucket.
 func (c *Client) GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error) {
+	otags, _, err := c.getObjectTagging(ctx, bucketName, objectName, opts)
+	return otags, err
+}
+
+// getObjectTagging fetches object tag(s) and the version ID they
+// belong to, empty for unversioned buckets.
+func (c *Client) getObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, string, error) {
 	// Get resources properly escaped and lined up before
 	// using them in http request.
 	urlValues := make(url.Values)
@@ -121,16 +128,105 @@ func (c *Client) GetObjectTagging(ctx context.Context, bucketName, objectName st
 
 	defer closeResponse(resp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if resp != nil {
 		if resp.StatusCode != http.StatusOK {
-			return nil, httpRespToErrorResponse(resp, bucketName, objectName)
+			return nil, "", httpRespToErrorResponse(resp, bucketName, objectName)
 		}
 	}
 
-	return tags.ParseObjectXML(resp.Body)
+	otags, err := tags.ParseObjectXML(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return otags, resp.Header.Get(amzVersionID), nil
+}
+
+// MergeObjectTagsOptions holds the tags to remove and the
+// object version to update in MergeObjectTags.
+type MergeObjectTagsOptions struct {
+	VersionID string
+	// Remove lists the tag keys to remove.
+	Remove []string
+	// MaxRetries is the number of times the merge is retried when
+	// the tags change concurrently, defaults to 3.
+	MaxRetries int
+}
+
+// MergeObjectTags sets the given tags on an object while keeping its
+// other tags, unlike PutObjectTagging which replaces all of them.
+//
+// S3 has no conditional tagging requests, so the tags are read, merged
+// and written back to the same object version, then read again to
+// verify that no concurrent update replaced them. The merge is retried
+// if it was lost. The resulting tags are returned.
+func (c *Client) MergeObjectTags(ctx context.Context, bucketName, objectName string, tagMap map[string]string, opts MergeObjectTagsOptions) (*tags.Tags, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 3
+	}
+
+	merged := func(otags *tags.Tags) bool {
+		for _, key := range opts.Remove {
+			if _, found := otags.Get(key); found {
+				if _, set := tagMap[key]; !set {
+					return false
+				}
+			}
+		}
+		for key, value := range tagMap {
+			if v, found := otags.Get(key); !found || v != value {
+				return false
+			}
+		}
+		return true
+	}
+
+	versionID := opts.VersionID
+	for i := 0; i <= maxRetries; i++ {
+		current, vid, err := c.getObjectTagging(ctx, bucketName, objectName, GetObjectTaggingOptions{VersionID: versionID})
+		if err != nil {
+			return nil, err
+		}
+		// Pin the version so that a concurrent upload of the
+		// object does not receive the merged tags.
+		if versionID == "" {
+			versionID = vid
+		}
+		if merged(current) {
+			return current, nil
+		}
+		if err = current.Merge(tagMap, opts.Remove...); err != nil {
+			return nil, err
+		}
+		err = c.PutObjectTagging(ctx, bucketName, objectName, current, PutObjectTaggingOptions{VersionID: versionID})
+		if err != nil {
+			return nil, err
+		}
+
+		latest, _, err := c.getObjectTagging(ctx, bucketName, objectName, GetObjectTaggingOptions{VersionID: versionID})
+		if err != nil {
+			return nil, err
+		}
+		if merged(latest) {
+			return latest, nil
+		}
+	}
+	return nil, ErrorResponse{
+		StatusCode: http.StatusConflict,
+		Code:       "OperationAborted",
+		Message:    "Object tags were concurrently replaced during the merge",
+		BucketName: bucketName,
+		Key:        objectName,
+	}
 }
 
 // RemoveObjectTaggingOptions holds the version id of the object to remove
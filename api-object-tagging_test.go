@@ -0,0 +1,109 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+	"github.com/jie123108/minio-go/v7/pkg/tags"
+)
+
+func TestMergeObjectTags(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Drop the first tagging update as if a concurrent writer had
+	// replaced it right away.
+	var puts atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Query().Has("tagging") && strings.HasSuffix(r.URL.Path, "/lost") {
+			if puts.Add(1) == 1 {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"object": "data", "lost": "data"})
+
+	initial, err := tags.MapToObjectTags(map[string]string{"env": "dev", "team": "blue", "tmp": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.PutObjectTagging(ctx, "bucket", "object", initial, PutObjectTaggingOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, err := clnt.MergeObjectTags(ctx, "bucket", "object", map[string]string{"env": "prod", "owner": "ops"}, MergeObjectTagsOptions{Remove: []string{"tmp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"env": "prod", "team": "blue", "owner": "ops"}
+	got, err := clnt.GetObjectTagging(ctx, "bucket", "object", GetObjectTaggingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.ToMap(), want) || !reflect.DeepEqual(merged.ToMap(), want) {
+		t.Fatalf("expected tags %v, got %v", want, got.ToMap())
+	}
+	expr, err := tags.ParseExpression("env=prod,owner,!tmp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Matches(expr) {
+		t.Fatalf("expected %s to match", got)
+	}
+
+	if _, err = clnt.MergeObjectTags(ctx, "bucket", "lost", map[string]string{"env": "prod"}, MergeObjectTagsOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if puts.Load() != 2 {
+		t.Fatalf("expected the lost update to be retried, got %d updates", puts.Load())
+	}
+	got, err = clnt.GetObjectTagging(ctx, "bucket", "lost", GetObjectTaggingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := got.Get("env"); v != "prod" {
+		t.Fatalf("unexpected tags %s", got)
+	}
+
+	if _, err = clnt.MergeObjectTags(ctx, "bucket", "missing", map[string]string{"env": "prod"}, MergeObjectTagsOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
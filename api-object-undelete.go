@@ -0,0 +1,123 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// objectVersions returns the versions of objectName, latest first.
+func (c *Client) objectVersions(ctx context.Context, bucketName, objectName string) ([]ObjectInfo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	listCh := c.ListObjects(ctx, bucketName, ListObjectsOptions{
+		Prefix:       objectName,
+		Recursive:    true,
+		WithVersions: true,
+	})
+	defer func() {
+		// Drain the channel so the listing goroutine can exit.
+		cancel()
+		for range listCh {
+		}
+	}()
+
+	var versions []ObjectInfo
+	for info := range listCh {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		// Keys are listed in lexical order, objectName comes first.
+		if info.Key != objectName {
+			break
+		}
+		versions = append(versions, info)
+	}
+	return versions, nil
+}
+
+// UndeleteObject removes the delete marker which is the latest version
+// of objectName in a versioned bucket, making the previous version
+// visible again. The now latest version is returned, it may be
+// another delete marker. An error is returned if the latest version
+// is not a delete marker.
+func (c *Client) UndeleteObject(ctx context.Context, bucketName, objectName string) (ObjectInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return ObjectInfo{}, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return ObjectInfo{}, err
+	}
+
+	versions, err := c.objectVersions(ctx, bucketName, objectName)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if len(versions) == 0 {
+		return ObjectInfo{}, ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Code:       "NoSuchKey",
+			Message:    "The specified key does not exist.",
+			BucketName: bucketName,
+			Key:        objectName,
+		}
+	}
+	if !versions[0].IsDeleteMarker {
+		return ObjectInfo{}, errInvalidArgument("Latest version of " + objectName + " is not a delete marker.")
+	}
+	if err = c.RemoveObject(ctx, bucketName, objectName, RemoveObjectOptions{VersionID: versions[0].VersionID}); err != nil {
+		return ObjectInfo{}, err
+	}
+	if len(versions) == 1 {
+		return ObjectInfo{}, nil
+	}
+	latest := versions[1]
+	latest.IsLatest = true
+	return latest, nil
+}
+
+// PromoteObjectVersion makes versionID the latest version of objectName
+// by copying it server side, metadata and tags are preserved. The
+// version itself and all other versions are kept.
+func (c *Client) PromoteObjectVersion(ctx context.Context, bucketName, objectName, versionID string) (UploadInfo, error) {
+	if versionID == "" {
+		return UploadInfo{}, errInvalidArgument("Version ID cannot be empty.")
+	}
+	st, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{VersionID: versionID})
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	dst := CopyDestOptions{Bucket: bucketName, Object: objectName}
+	src := CopySrcOptions{Bucket: bucketName, Object: objectName, VersionID: versionID, MatchETag: st.ETag}
+	if st.Size <= singleCopyLimit {
+		return c.CopyObject(ctx, dst, src)
+	}
+	// Larger versions are copied in parts, which get the metadata and
+	// tags of the version from the client.
+	tags, err := c.sourceTags(ctx, bucketName, objectName, versionID, st)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	dst.ReplaceMetadata = true
+	dst.UserMetadata = sourceMetadata(st)
+	dst.ReplaceTags = true
+	dst.UserTags = tags
+	return c.ComposeObject(ctx, dst, src)
+}
@@ -0,0 +1,101 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestUndeleteAndPromote(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions []string
+	for _, content := range []string{"v1", "v2"} {
+		info, err := clnt.PutObject(ctx, "bucket", "obj", strings.NewReader(content), int64(len(content)), PutObjectOptions{
+			UserMetadata: map[string]string{"Content": content},
+			UserTags:     map[string]string{"Content": content},
+			ContentType:  "text/" + content,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, info.VersionID)
+	}
+	// A key sharing the prefix must not be mistaken for a version.
+	putTestObjects(t, clnt, map[string]string{"obj2": "other"})
+
+	if _, err := clnt.UndeleteObject(ctx, "bucket", "obj"); err == nil {
+		t.Fatal("expected error undeleting an object which is not deleted")
+	}
+	if err := clnt.RemoveObject(ctx, "bucket", "obj", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := clnt.UndeleteObject(ctx, "bucket", "obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.VersionID != versions[1] {
+		t.Fatalf("expected version %s to be latest, got %s", versions[1], info.VersionID)
+	}
+
+	up, err := clnt.PromoteObjectVersion(ctx, "bucket", "obj", versions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up.VersionID == "" || up.VersionID == versions[0] {
+		t.Fatalf("expected a new version, got %q", up.VersionID)
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "obj", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := obj.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "v1" || st.UserMetadata["Content"] != "v1" || st.ContentType != "text/v1" {
+		t.Fatalf("unexpected promoted object %q %v %s", data, st.UserMetadata, st.ContentType)
+	}
+
+	// Versions above the limit are copied in parts.
+	defer func(limit int64) { singleCopyLimit = limit }(singleCopyLimit)
+	singleCopyLimit = 1
+	for i, content := range []string{"v1", "v2"} {
+		if _, err = clnt.PromoteObjectVersion(ctx, "bucket", "obj", versions[i]); err != nil {
+			t.Fatal(err)
+		}
+		tags, err := clnt.GetObjectTagging(ctx, "bucket", "obj", GetObjectTaggingOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, _ := tags.Get("Content"); v != content {
+			t.Fatalf("unexpected promoted tags %v", tags.ToMap())
+		}
+	}
+}
@@ -0,0 +1,404 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// Paginator fetches a listing one page, i.e. one request, at a time.
+//
+//	p := client.ListObjectsPaginator("mytestbucket", minio.ListObjectsOptions{Recursive: true})
+//	for p.HasMorePages() {
+//	    page, err := p.NextPage(ctx)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    for _, object := range page {
+//	        fmt.Println(object.Key)
+//	    }
+//	}
+//
+// A Paginator is not safe for concurrent use.
+type Paginator[T any] struct {
+	fetch func(ctx context.Context) (page []T, more bool, err error)
+	more  bool
+}
+
+func newPaginator[T any](fetch func(ctx context.Context) ([]T, bool, error)) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, more: true}
+}
+
+// failedPaginator returns a paginator whose pages all fail with err.
+func failedPaginator[T any](err error) *Paginator[T] {
+	return newPaginator(func(context.Context) ([]T, bool, error) {
+		return nil, true, err
+	})
+}
+
+// HasMorePages returns true until the last page was fetched.
+func (p *Paginator[T]) HasMorePages() bool {
+	return p.more
+}
+
+// NextPage fetches the next page, which may be empty even if more
+// pages follow. After an error the paginator is left unchanged and
+// NextPage retries the same page. Once all pages were fetched NextPage
+// returns an empty page.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if !p.more {
+		return nil, nil
+	}
+	page, more, err := p.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.more = more
+	return page, nil
+}
+
+// pagesToChan sends the entries of all pages of p over the returned
+// channel, the way the channel based list APIs do. A failing page ends
+// the listing with an entry made by errEntry, and so does a canceled
+// context.
+func pagesToChan[T any](ctx context.Context, p *Paginator[T], errEntry func(error) T) <-chan T {
+	resultCh := make(chan T, 1)
+	go func() {
+		defer func() {
+			if contextCanceled(ctx) {
+				resultCh <- errEntry(ctx.Err())
+			}
+			close(resultCh)
+		}()
+		for p.HasMorePages() && !contextCanceled(ctx) {
+			page, err := p.NextPage(ctx)
+			if err != nil {
+				select {
+				case resultCh <- errEntry(err):
+				case <-ctx.Done():
+				}
+				return
+			}
+			for _, entry := range page {
+				select {
+				case resultCh <- entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return resultCh
+}
+
+// objectInfoErr is the channel entry of a failed object listing.
+func objectInfoErr(err error) ObjectInfo {
+	return ObjectInfo{Err: err}
+}
+
+// limitPage cuts page to the entries left before the limit after sent
+// entries, and returns whether the listing continues after the page.
+func (o ListObjectsOptions) limitPage(page []ObjectInfo, sent int, truncated bool) ([]ObjectInfo, bool) {
+	if o.Limit > 0 && sent+len(page) >= o.Limit {
+		return page[:o.Limit-sent], false
+	}
+	return page, truncated
+}
+
+// ListObjectsPaginator returns a paginator over the objects selected by
+// opts, the counterpart of ListObjects. Common prefixes are returned as
+// entries with only the Key set. The client side filters of opts are
+// not applied, Limit is.
+func (c *Client) ListObjectsPaginator(bucketName string, opts ListObjectsOptions) *Paginator[ObjectInfo] {
+	// Validate bucket name.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return failedPaginator[ObjectInfo](err)
+	}
+	// Validate incoming object prefix.
+	if err := s3utils.CheckValidObjectNamePrefix(opts.Prefix); err != nil {
+		return failedPaginator[ObjectInfo](err)
+	}
+
+	if opts.WithVersions {
+		return c.listObjectVersionsPaginator(bucketName, opts)
+	}
+
+	// Use legacy list objects v1 API
+	if opts.UseV1 {
+		return c.listObjectsV1Paginator(bucketName, opts)
+	}
+
+	// Check whether this is snowball region, if yes ListObjectsV2 doesn't work, fallback to listObjectsV1.
+	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+		if location == "snowball" {
+			return c.listObjectsV1Paginator(bucketName, opts)
+		}
+	}
+
+	return c.listObjectsV2Paginator(bucketName, opts)
+}
+
+func (c *Client) listObjectsV2Paginator(bucketName string, opts ListObjectsOptions) *Paginator[ObjectInfo] {
+	delimiter := opts.delimiter()
+
+	// Return object owner information by default
+	fetchOwner := true
+
+	var (
+		continuationToken string
+		sent              int
+	)
+	return newPaginator(func(ctx context.Context) ([]ObjectInfo, bool, error) {
+		// Get list of objects a maximum of 1000 per request.
+		result, err := c.listObjectsV2Query(ctx, bucketName, opts.Prefix, continuationToken,
+			fetchOwner, opts.WithMetadata, delimiter, opts.StartAfter, opts.pageSize(sent), opts.headers)
+		if err != nil {
+			return nil, true, err
+		}
+
+		page := make([]ObjectInfo, 0, len(result.Contents)+len(result.CommonPrefixes))
+		for _, object := range result.Contents {
+			object.ETag = trimEtag(object.ETag)
+			page = append(page, object)
+		}
+		if err = c.statListedObjects(ctx, bucketName, opts, page); err != nil {
+			return nil, true, err
+		}
+		// NOTE: prefixes are only present if the request is delimited.
+		for _, obj := range result.CommonPrefixes {
+			page = append(page, ObjectInfo{Key: obj.Prefix})
+		}
+
+		// listObjectsV2Query fails on truncated results without token.
+		continuationToken = result.NextContinuationToken
+
+		page, more := opts.limitPage(page, sent, result.IsTruncated)
+		sent += len(page)
+		return page, more, nil
+	})
+}
+
+func (c *Client) listObjectsV1Paginator(bucketName string, opts ListObjectsOptions) *Paginator[ObjectInfo] {
+	delimiter := opts.delimiter()
+
+	marker := opts.StartAfter
+	sent := 0
+	return newPaginator(func(ctx context.Context) ([]ObjectInfo, bool, error) {
+		// Get list of objects a maximum of 1000 per request.
+		result, err := c.listObjectsQuery(ctx, bucketName, opts.Prefix, marker, delimiter, opts.pageSize(sent), opts.headers)
+		if err != nil {
+			return nil, true, err
+		}
+
+		page := make([]ObjectInfo, 0, len(result.Contents)+len(result.CommonPrefixes))
+		for _, object := range result.Contents {
+			object.ETag = trimEtag(object.ETag)
+			page = append(page, object)
+		}
+		if err = c.statListedObjects(ctx, bucketName, opts, page); err != nil {
+			return nil, true, err
+		}
+		// NOTE: prefixes are only present if the request is delimited.
+		for _, obj := range result.CommonPrefixes {
+			page = append(page, ObjectInfo{Key: obj.Prefix})
+		}
+
+		// Continue after the last key unless the next marker is present.
+		if len(result.Contents) > 0 {
+			marker = result.Contents[len(result.Contents)-1].Key
+		}
+		if result.NextMarker != "" {
+			marker = result.NextMarker
+		}
+
+		page, more := opts.limitPage(page, sent, result.IsTruncated)
+		sent += len(page)
+		return page, more, nil
+	})
+}
+
+func (c *Client) listObjectVersionsPaginator(bucketName string, opts ListObjectsOptions) *Paginator[ObjectInfo] {
+	delimiter := opts.delimiter()
+	reverse := opts.WithVersions && opts.ReverseVersions
+
+	var (
+		keyMarker       string
+		versionIDMarker string
+		sent            int
+		// versions of the last key seen, held back until all of
+		// them are listed when listing in reverse order.
+		pending []ObjectInfo
+	)
+	flush := func(page []ObjectInfo) []ObjectInfo {
+		for i := len(pending) - 1; i >= 0; i-- {
+			info := pending[i]
+			info.NumVersions = len(pending)
+			page = append(page, info)
+		}
+		pending = nil
+		return page
+	}
+	return newPaginator(func(ctx context.Context) ([]ObjectInfo, bool, error) {
+		// Get list of objects a maximum of 1000 per request.
+		pageOpts := opts
+		pageOpts.MaxKeys = opts.pageSize(sent)
+		result, err := c.listObjectVersionsQuery(ctx, bucketName, pageOpts, keyMarker, versionIDMarker, delimiter)
+		if err != nil {
+			return nil, true, err
+		}
+
+		page := make([]ObjectInfo, 0, len(result.Versions)+len(result.CommonPrefixes))
+		for _, version := range result.Versions {
+			info := ObjectInfo{
+				ETag:           trimEtag(version.ETag),
+				Key:            version.Key,
+				LastModified:   version.LastModified.Truncate(time.Millisecond),
+				Size:           version.Size,
+				Owner:          version.Owner,
+				StorageClass:   version.StorageClass,
+				IsLatest:       version.IsLatest,
+				VersionID:      version.VersionID,
+				IsDeleteMarker: version.isDeleteMarker,
+				UserTags:       version.UserTags,
+				UserMetadata:   version.UserMetadata,
+				Internal:       version.Internal,
+			}
+			if !reverse {
+				page = append(page, info)
+				continue
+			}
+			// The versions of a key may span several pages.
+			if len(pending) > 0 && pending[0].Key != info.Key {
+				page = flush(page)
+			}
+			pending = append(pending, info)
+		}
+		if !result.IsTruncated {
+			page = flush(page)
+		}
+		if err = c.statListedObjects(ctx, bucketName, opts, page); err != nil {
+			return nil, true, err
+		}
+
+		// NOTE: prefixes are only present if the request is delimited.
+		for _, obj := range result.CommonPrefixes {
+			page = append(page, ObjectInfo{Key: obj.Prefix})
+		}
+
+		// If next key marker is present, save it for next request.
+		if result.NextKeyMarker != "" {
+			keyMarker = result.NextKeyMarker
+		}
+		// If next version id marker is present, save it for next request.
+		if result.NextVersionIDMarker != "" {
+			versionIDMarker = result.NextVersionIDMarker
+		}
+
+		page, more := opts.limitPage(page, sent, result.IsTruncated)
+		sent += len(page)
+		return page, more, nil
+	})
+}
+
+// ListIncompleteUploadsPaginator returns a paginator over the incomplete
+// uploads below objectPrefix, the counterpart of ListIncompleteUploads.
+// Common prefixes are returned as entries with only the Key set.
+func (c *Client) ListIncompleteUploadsPaginator(bucketName, objectPrefix string, recursive bool) *Paginator[ObjectMultipartInfo] {
+	// Delimiter is set to "/" by default.
+	delimiter := "/"
+	if recursive {
+		// If recursive do not delimit.
+		delimiter = ""
+	}
+	return c.listMultipartUploadsPaginator(bucketName, objectPrefix, delimiter, 0)
+}
+
+// ListMultipartUploadsPaginator returns a paginator over the incomplete
+// uploads, requesting at most maxUploads of them per page. Common
+// prefixes are returned as entries with only the Key set.
+func (c Core) ListMultipartUploadsPaginator(bucket, prefix, delimiter string, maxUploads int) *Paginator[ObjectMultipartInfo] {
+	return c.listMultipartUploadsPaginator(bucket, prefix, delimiter, maxUploads)
+}
+
+func (c *Client) listMultipartUploadsPaginator(bucketName, objectPrefix, delimiter string, maxUploads int) *Paginator[ObjectMultipartInfo] {
+	// Validate bucket name.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return failedPaginator[ObjectMultipartInfo](err)
+	}
+	// Validate incoming object prefix.
+	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
+		return failedPaginator[ObjectMultipartInfo](err)
+	}
+
+	// object and upload ID marker for future requests.
+	var objectMarker, uploadIDMarker string
+	return newPaginator(func(ctx context.Context) ([]ObjectMultipartInfo, bool, error) {
+		result, err := c.listMultipartUploadsQuery(ctx, bucketName, objectMarker, uploadIDMarker, objectPrefix, delimiter, maxUploads)
+		if err != nil {
+			return nil, true, err
+		}
+		objectMarker = result.NextKeyMarker
+		uploadIDMarker = result.NextUploadIDMarker
+
+		page := make([]ObjectMultipartInfo, 0, len(result.Uploads)+len(result.CommonPrefixes))
+		page = append(page, result.Uploads...)
+		// NOTE: prefixes are only present if the request is delimited.
+		for _, obj := range result.CommonPrefixes {
+			page = append(page, ObjectMultipartInfo{Key: obj.Prefix})
+		}
+		return page, result.IsTruncated, nil
+	})
+}
+
+// ListObjectPartsPaginator returns a paginator over the uploaded parts of
+// a multipart upload, requesting at most maxParts of them per page.
+func (c Core) ListObjectPartsPaginator(bucket, object, uploadID string, maxParts int) *Paginator[ObjectPart] {
+	// Validate input arguments.
+	if err := s3utils.CheckValidBucketName(bucket); err != nil {
+		return failedPaginator[ObjectPart](err)
+	}
+	if err := s3utils.CheckValidObjectName(object); err != nil {
+		return failedPaginator[ObjectPart](err)
+	}
+	if uploadID == "" {
+		return failedPaginator[ObjectPart](errInvalidArgument("Upload ID cannot be empty."))
+	}
+
+	// Part number marker for the next batch of request.
+	var partNumberMarker int
+	return newPaginator(func(ctx context.Context) ([]ObjectPart, bool, error) {
+		result, err := c.listObjectPartsQuery(ctx, bucket, object, uploadID, partNumberMarker, maxParts)
+		if err != nil {
+			return nil, true, err
+		}
+		for i := range result.ObjectParts {
+			// Trim off the odd double quotes from ETag in the beginning and end.
+			result.ObjectParts[i].ETag = trimEtag(result.ObjectParts[i].ETag)
+		}
+		partNumberMarker = result.NextPartNumberMarker
+		// Catch servers which would make the listing loop forever.
+		if result.IsTruncated && len(result.ObjectParts) == 0 {
+			return nil, true, fmt.Errorf("listObjectParts is truncated without parts, %s S3 server is incompatible with S3 API", c.endpointURL)
+		}
+		return result.ObjectParts, result.IsTruncated, nil
+	})
+}
@@ -0,0 +1,103 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// collectPages returns the keys of all pages of p, one page per element.
+func collectPages[T any](t *testing.T, p *Paginator[T], key func(T) string) []string {
+	t.Helper()
+	var pages []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys := make([]string, 0, len(page))
+		for _, entry := range page {
+			keys = append(keys, key(entry))
+		}
+		pages = append(pages, strings.Join(keys, ","))
+	}
+	return pages
+}
+
+func TestListObjectsPaginator(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"a": "1", "b": "1", "c": "1", "dir/d": "1"})
+	putTestObjects(t, clnt, map[string]string{"a": "2"})
+
+	objectKey := func(info ObjectInfo) string { return info.Key }
+	testCases := []struct {
+		opts ListObjectsOptions
+		want string
+	}{
+		{ListObjectsOptions{MaxKeys: 2}, "a,b|c,dir/"},
+		{ListObjectsOptions{MaxKeys: 2, UseV1: true}, "a,b|c,dir/"},
+		{ListObjectsOptions{MaxKeys: 2, Recursive: true, Limit: 3}, "a,b|c"},
+		{ListObjectsOptions{MaxKeys: 2, WithVersions: true}, "a,a|b,c|dir/"},
+		{ListObjectsOptions{MaxKeys: 1, WithVersions: true, ReverseVersions: true, Recursive: true}, "||a,a|b|c,dir/d"},
+	}
+	for i, tc := range testCases {
+		got := strings.Join(collectPages(t, clnt.ListObjectsPaginator("bucket", tc.opts), objectKey), "|")
+		if got != tc.want {
+			t.Errorf("Test %d: expected pages %s, got %s", i+1, tc.want, got)
+		}
+	}
+
+	// Validation errors are returned by every page.
+	p := clnt.ListObjectsPaginator("x", ListObjectsOptions{})
+	for i := 0; i < 2; i++ {
+		if _, err := p.NextPage(ctx); err == nil || !p.HasMorePages() {
+			t.Fatalf("expected an error, got %v", err)
+		}
+	}
+}
+
+func TestListMultipartUploadsPaginator(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	core := Core{clnt}
+	for i := 0; i < 3; i++ {
+		if _, err := core.NewMultipartUpload(ctx, "bucket", fmt.Sprintf("obj-%d", i), PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := core.NewMultipartUpload(ctx, "bucket", "dir/obj", PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	uploadKey := func(info ObjectMultipartInfo) string { return info.Key }
+	got := strings.Join(collectPages(t, core.ListMultipartUploadsPaginator("bucket", "", "", 2), uploadKey), "|")
+	if want := "dir/obj,obj-0|obj-1,obj-2"; got != want {
+		t.Fatalf("expected pages %s, got %s", want, got)
+	}
+	got = strings.Join(collectPages(t, clnt.ListIncompleteUploadsPaginator("bucket", "", false), uploadKey), "|")
+	if want := "obj-0,obj-1,obj-2,dir/"; got != want {
+		t.Fatalf("expected pages %s, got %s", want, got)
+	}
+}
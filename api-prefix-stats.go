@@ -0,0 +1,205 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// Size ranges of PrefixStats.SizeHistogram, named as in the data usage
+// reported by MinIO servers.
+const (
+	SizeLessThan1KiB        = "LESS_THAN_1024_B"
+	SizeBetween1KiBAnd1MiB  = "BETWEEN_1024_B_AND_1_MB"
+	SizeBetween1And10MiB    = "BETWEEN_1_MB_AND_10_MB"
+	SizeBetween10And64MiB   = "BETWEEN_10_MB_AND_64_MB"
+	SizeBetween64And128MiB  = "BETWEEN_64_MB_AND_128_MB"
+	SizeBetween128And512MiB = "BETWEEN_128_MB_AND_512_MB"
+	SizeGreaterThan512MiB   = "GREATER_THAN_512_MB"
+)
+
+// sizeRanges are the upper bounds, exclusive, of the histogram ranges.
+var sizeRanges = []struct {
+	name  string
+	limit int64
+}{
+	{SizeLessThan1KiB, 1 << 10},
+	{SizeBetween1KiBAnd1MiB, 1 << 20},
+	{SizeBetween1And10MiB, 10 << 20},
+	{SizeBetween10And64MiB, 64 << 20},
+	{SizeBetween64And128MiB, 128 << 20},
+	{SizeBetween128And512MiB, 512 << 20},
+}
+
+// PrefixStats summarizes the objects below a prefix.
+type PrefixStats struct {
+	// Objects is the number of objects and Size their total size.
+	Objects int64 `json:"objects"`
+	Size    int64 `json:"size"`
+
+	// SizeHistogram counts the objects per size range, keyed by the
+	// Size* range names.
+	SizeHistogram map[string]int64 `json:"sizeHistogram"`
+
+	// Oldest and Newest are the earliest and latest modification
+	// times of the objects, zero if there are none.
+	Oldest time.Time `json:"oldest"`
+	Newest time.Time `json:"newest"`
+}
+
+// add counts an object.
+func (s *PrefixStats) add(info ObjectInfo) {
+	s.Objects++
+	s.Size += info.Size
+	name := SizeGreaterThan512MiB
+	for _, r := range sizeRanges {
+		if info.Size < r.limit {
+			name = r.name
+			break
+		}
+	}
+	if s.SizeHistogram == nil {
+		s.SizeHistogram = make(map[string]int64)
+	}
+	s.SizeHistogram[name]++
+	if s.Oldest.IsZero() || info.LastModified.Before(s.Oldest) {
+		s.Oldest = info.LastModified
+	}
+	if info.LastModified.After(s.Newest) {
+		s.Newest = info.LastModified
+	}
+}
+
+// merge adds the counts of other.
+func (s *PrefixStats) merge(other PrefixStats) {
+	s.Objects += other.Objects
+	s.Size += other.Size
+	for name, n := range other.SizeHistogram {
+		if s.SizeHistogram == nil {
+			s.SizeHistogram = make(map[string]int64)
+		}
+		s.SizeHistogram[name] += n
+	}
+	if !other.Oldest.IsZero() && (s.Oldest.IsZero() || other.Oldest.Before(s.Oldest)) {
+		s.Oldest = other.Oldest
+	}
+	if other.Newest.After(s.Newest) {
+		s.Newest = other.Newest
+	}
+}
+
+// PrefixStatsOptions configures PrefixStats.
+type PrefixStatsOptions struct {
+	// NumWorkers is the number of sub-prefixes scanned concurrently,
+	// 4 if zero.
+	NumWorkers int
+
+	// Usage, if set, is asked for the stats first, e.g. from the data
+	// usage of a MinIO admin client or from the storage metrics of the
+	// provider. The listing is only scanned if it returns false.
+	Usage func(ctx context.Context, bucketName, prefix string) (PrefixStats, bool, error)
+}
+
+// PrefixStats returns the number, total size, size histogram and
+// modification time range of the objects below prefix, e.g. for
+// storage reports. The sub-prefixes of prefix, up to the next '/', are
+// listed concurrently, the delete markers and non current versions of
+// the objects are not counted.
+func (c *Client) PrefixStats(ctx context.Context, bucketName, prefix string, opts PrefixStatsOptions) (PrefixStats, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return PrefixStats{}, err
+	}
+	if opts.Usage != nil {
+		stats, ok, err := opts.Usage(ctx, bucketName, prefix)
+		if err != nil || ok {
+			return stats, err
+		}
+	}
+	workers := opts.NumWorkers
+	if workers <= 0 {
+		workers = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		total    PrefixStats
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mu.Unlock()
+		cancel()
+	}
+
+	prefixCh := make(chan string)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range prefixCh {
+				var stats PrefixStats
+				for info := range c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: p, Recursive: true}) {
+					if info.Err != nil {
+						fail(info.Err)
+						break
+					}
+					stats.add(info)
+				}
+				mu.Lock()
+				total.merge(stats)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var top PrefixStats
+	for info := range c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: prefix}) {
+		if info.Err != nil {
+			fail(info.Err)
+			break
+		}
+		// Common prefixes carry neither ETag nor modification time.
+		if info.ETag == "" && info.LastModified.IsZero() {
+			select {
+			case prefixCh <- info.Key:
+			case <-ctx.Done():
+			}
+			continue
+		}
+		top.add(info)
+	}
+	close(prefixCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return PrefixStats{}, firstErr
+	}
+	total.merge(top)
+	return total, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPrefixStats(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{
+		"data/a":       "a",
+		"data/x/b":     "bb",
+		"data/x/y/c":   "ccc",
+		"data/z/d":     strings.Repeat("d", 2048),
+		"other/e":      "eeeee",
+		"data-sibling": "f",
+	})
+
+	stats, err := clnt.PrefixStats(ctx, "bucket", "data/", PrefixStatsOptions{NumWorkers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Objects != 4 || stats.Size != 2054 {
+		t.Fatalf("expected 4 objects of 2054 bytes, got %d of %d", stats.Objects, stats.Size)
+	}
+	if stats.SizeHistogram[SizeLessThan1KiB] != 3 || stats.SizeHistogram[SizeBetween1KiBAnd1MiB] != 1 {
+		t.Fatalf("unexpected histogram %v", stats.SizeHistogram)
+	}
+	if stats.Oldest.IsZero() || stats.Newest.Before(stats.Oldest) {
+		t.Fatalf("unexpected time range %v - %v", stats.Oldest, stats.Newest)
+	}
+
+	if stats, err = clnt.PrefixStats(ctx, "bucket", "", PrefixStatsOptions{}); err != nil || stats.Objects != 6 {
+		t.Fatalf("expected 6 objects, got %d: %v", stats.Objects, err)
+	}
+
+	// The usage source is preferred to scanning.
+	stats, err = clnt.PrefixStats(ctx, "bucket", "data/", PrefixStatsOptions{
+		Usage: func(context.Context, string, string) (PrefixStats, bool, error) {
+			return PrefixStats{Objects: 42}, true, nil
+		},
+	})
+	if err != nil || stats.Objects != 42 {
+		t.Fatalf("expected the usage stats, got %+v: %v", stats, err)
+	}
+
+	if _, err = clnt.PrefixStats(ctx, "missing", "", PrefixStatsOptions{}); ToErrorResponse(err).Code != "NoSuchBucket" {
+		t.Fatalf("expected NoSuchBucket, got %v", err)
+	}
+}
@@ -22,8 +22,10 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 	"github.com/jie123108/minio-go/v7/pkg/signer"
 )
@@ -73,6 +75,17 @@ func (c *Client) PresignedGetObject(ctx context.Context, bucketName, objectName
 	return c.presignURL(ctx, http.MethodGet, bucketName, objectName, expires, reqParams, nil)
 }
 
+// PresignedGetObjectWithOptions - Returns a presigned URL to access an
+// object like PresignedGetObject, taking the version and the response
+// header overrides from opts, e.g. SetResponseAttachment. Request
+// headers of opts such as ranges and conditions are not part of the URL.
+func (c *Client) PresignedGetObjectWithOptions(ctx context.Context, bucketName, objectName string, expires time.Duration, opts GetObjectOptions) (u *url.URL, err error) {
+	if err = s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	return c.presignURL(ctx, http.MethodGet, bucketName, objectName, expires, opts.toQueryValues(), nil)
+}
+
 // PresignedHeadObject - Returns a presigned URL to access
 // object metadata without credentials. URL can have a maximum expiry
 // of upto 7days or a minimum of 1sec. Additionally you can override
@@ -112,6 +125,98 @@ func (c *Client) Presign(ctx context.Context, method, bucketName, objectName str
 	return c.presignURL(ctx, method, bucketName, objectName, expires, reqParams, nil)
 }
 
+// presignQueryParams are the query parameters set by presigning, for
+// signature V4 and V2.
+var presignQueryParams = []string{
+	"X-Amz-Algorithm",
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Expires",
+	"X-Amz-SignedHeaders",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+	"AWSAccessKeyId",
+	"Expires",
+	"Signature",
+}
+
+// RePresign - returns a new presigned URL for the request a presigned URL
+// was generated for, with a new expiry and signed with the current
+// credentials, e.g. for services caching presigned URLs whose expiry or
+// credentials ran out. The method is the one the URL was presigned for,
+// it is not part of the URL. Query parameters such as response header
+// overrides and versionId are kept.
+//
+// Only URLs for the endpoint of the client can be re-presigned, and
+// only if they were presigned without extra signed headers, see
+// PresignHeader, as their values are not part of the URL.
+func (c *Client) RePresign(ctx context.Context, method string, presignedURL *url.URL, expires time.Duration) (u *url.URL, err error) {
+	// Input validation.
+	if method == "" {
+		return nil, errInvalidArgument("method cannot be empty.")
+	}
+	if presignedURL == nil {
+		return nil, errInvalidArgument("presigned URL cannot be nil.")
+	}
+	if err = isValidExpiry(expires); err != nil {
+		return nil, err
+	}
+
+	// Virtual host style URLs have the bucket prepended to the host,
+	// for AWS it may as well be a regional or dual-stack endpoint.
+	host := presignedURL.Host
+	isVirtualHost := host != c.endpointURL.Host
+	if isVirtualHost && !strings.HasSuffix(host, "."+c.endpointURL.Host) &&
+		!(s3utils.IsAmazonEndpoint(*c.endpointURL) && s3utils.IsAmazonEndpoint(url.URL{Host: host})) {
+		return nil, errInvalidArgument("Presigned URL for " + host + " is not for the endpoint " + c.endpointURL.Host + ".")
+	}
+
+	query := presignedURL.Query()
+	if signedHeaders := query.Get("X-Amz-SignedHeaders"); signedHeaders != "" && signedHeaders != "host" {
+		return nil, errInvalidArgument("Presigned URLs with extra signed headers cannot be re-presigned.")
+	}
+	// Keep the region of the credential scope,
+	// <access-key>/<date>/<region>/s3/aws4_request.
+	location := ""
+	if scope := strings.Split(query.Get("X-Amz-Credential"), "/"); len(scope) == 5 {
+		location = scope[2]
+	}
+	if location == "" {
+		location = getDefaultLocation(*c.endpointURL, c.region)
+	}
+	for _, param := range presignQueryParams {
+		query.Del(param)
+	}
+
+	target := *presignedURL
+	target.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get credentials from the configured credentials provider.
+	value, err := c.credsProvider.GetWithContext(c.CredContext())
+	if err != nil {
+		return nil, err
+	}
+	signerType := value.SignerType
+	if c.overrideSignerType != credentials.SignatureDefault {
+		signerType = c.overrideSignerType
+	}
+	if value.SignerType.IsAnonymous() || signerType.IsAnonymous() {
+		return nil, errInvalidArgument("Presigned URLs cannot be generated with anonymous credentials.")
+	}
+
+	expireSeconds := int64(expires / time.Second)
+	if signerType.IsV2() {
+		req = signer.PreSignV2(*req, value.AccessKeyID, value.SecretAccessKey, expireSeconds, isVirtualHost)
+	} else {
+		req = signer.PreSignV4(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken, location, expireSeconds)
+	}
+	return req.URL, nil
+}
+
 // PresignedPostPolicy - Returns POST urlString, form data to upload an object.
 func (c *Client) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url.URL, formData map[string]string, err error) {
 	// Validate input arguments.
@@ -0,0 +1,93 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestRePresign(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"obj": "presigned"})
+	ctx := context.Background()
+
+	get := func(u *url.URL) int {
+		t.Helper()
+		resp, err := http.Get(u.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusOK && string(body) != "presigned" {
+			t.Fatalf("unexpected content %q", body)
+		}
+		return resp.StatusCode
+	}
+
+	// A URL signed with credentials since rotated.
+	stale, err := New(clnt.EndpointURL().Host, &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, "rotated-secret", ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := url.Values{"response-content-type": []string{"text/plain"}}
+	u, err := stale.PresignedGetObject(ctx, "bucket", "obj", time.Second, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := get(u); status != http.StatusForbidden {
+		t.Fatalf("expected stale URL to be rejected, got %d", status)
+	}
+
+	u, err = clnt.RePresign(ctx, http.MethodGet, u, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Get("X-Amz-Expires") != "3600" || query.Get("response-content-type") != "text/plain" || len(query["X-Amz-Signature"]) != 1 {
+		t.Fatalf("unexpected re-presigned URL %s", u)
+	}
+	if status := get(u); status != http.StatusOK {
+		t.Fatalf("expected re-presigned URL to be accepted, got %d", status)
+	}
+
+	// URLs of other endpoints and with extra signed headers are refused.
+	foreign := *u
+	foreign.Host = "example.com"
+	if _, err = clnt.RePresign(ctx, http.MethodGet, &foreign, time.Hour); err == nil {
+		t.Fatal("expected URL of another endpoint to be refused")
+	}
+	u, err = clnt.PresignHeader(ctx, http.MethodGet, "bucket", "obj", time.Hour, nil, http.Header{"X-Amz-Meta-Tenant": []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.RePresign(ctx, http.MethodGet, u, time.Hour); err == nil || !strings.Contains(err.Error(), "signed headers") {
+		t.Fatalf("expected URL with extra signed headers to be refused, got %v", err)
+	}
+}
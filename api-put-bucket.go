@@ -22,8 +22,12 @@ import (
 	"context"
 	"encoding/xml"
 	"net/http"
+	"time"
 
+	"github.com/jie123108/minio-go/v7/pkg/lifecycle"
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+	"github.com/jie123108/minio-go/v7/pkg/sse"
+	"github.com/jie123108/minio-go/v7/pkg/tags"
 )
 
 // Bucket operations
@@ -109,6 +113,24 @@ type MakeBucketOptions struct {
 	Region string
 	// Enable object locking
 	ObjectLocking bool
+
+	// The configuration below is applied once the bucket is
+	// created. If applying any of it fails, the bucket is
+	// removed again.
+
+	// Versioning configuration of the bucket.
+	Versioning *BucketVersioningConfiguration
+	// DefaultRetention of new objects, implies ObjectLocking.
+	// The validity must be a whole number of days.
+	DefaultRetention *Retention
+	// Tags of the bucket.
+	Tags map[string]string
+	// Policy of the bucket as JSON.
+	Policy string
+	// Encryption is the default server side encryption.
+	Encryption *sse.Configuration
+	// Lifecycle configuration of the bucket.
+	Lifecycle *lifecycle.Configuration
 }
 
 // MakeBucket creates a new bucket with bucketName with a context to control cancellations and timeouts.
@@ -118,6 +140,83 @@ type MakeBucketOptions struct {
 //
 // For Amazon S3 for more supported regions - http://docs.aws.amazon.com/general/latest/gr/rande.html
 // For Google Cloud Storage for more supported regions - https://cloud.google.com/storage/docs/bucket-locations
+//
+// Versioning, default retention, tags, policy, encryption and lifecycle
+// configuration set in opts are applied right after creation. If any of
+// them fails the bucket is removed, unless it existed before, and the
+// error is returned.
 func (c *Client) MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error) {
-	return c.makeBucket(ctx, bucketName, opts)
+	var bucketTags *tags.Tags
+	if len(opts.Tags) > 0 {
+		if bucketTags, err = tags.MapToBucketTags(opts.Tags); err != nil {
+			return err
+		}
+	}
+	var retentionDays uint
+	if opts.DefaultRetention != nil {
+		if !opts.DefaultRetention.Mode.IsValid() {
+			return errInvalidArgument("Invalid retention mode " + opts.DefaultRetention.Mode.String())
+		}
+		validity := opts.DefaultRetention.Validity
+		if validity <= 0 || validity%(24*time.Hour) != 0 {
+			return errInvalidArgument("Retention validity must be a whole number of days.")
+		}
+		retentionDays = uint(validity / (24 * time.Hour))
+		opts.ObjectLocking = true
+	}
+
+	configured := opts.DefaultRetention != nil || opts.Versioning != nil || bucketTags != nil ||
+		opts.Policy != "" || opts.Encryption != nil || opts.Lifecycle != nil
+	// Creating a bucket the caller already owns succeeds in us-east-1,
+	// such a bucket must not be removed on failure.
+	var created bool
+	if configured {
+		exists, eerr := c.BucketExists(ctx, bucketName)
+		created = eerr == nil && !exists
+	}
+
+	if err = c.makeBucket(ctx, bucketName, opts); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil && created {
+			// Rollback, the bucket is still empty. The original
+			// error is more useful than a failure to remove.
+			c.RemoveBucket(context.WithoutCancel(ctx), bucketName)
+		}
+	}()
+
+	if opts.DefaultRetention != nil {
+		mode, unit := opts.DefaultRetention.Mode, Days
+		if err = c.SetObjectLockConfig(ctx, bucketName, &mode, &retentionDays, &unit); err != nil {
+			return err
+		}
+	}
+	if opts.Versioning != nil {
+		if err = c.SetBucketVersioning(ctx, bucketName, *opts.Versioning); err != nil {
+			return err
+		}
+	}
+	if bucketTags != nil {
+		if err = c.SetBucketTagging(ctx, bucketName, bucketTags); err != nil {
+			return err
+		}
+	}
+	if opts.Policy != "" {
+		if err = c.SetBucketPolicy(ctx, bucketName, opts.Policy); err != nil {
+			return err
+		}
+	}
+	if opts.Encryption != nil {
+		if err = c.SetBucketEncryption(ctx, bucketName, opts.Encryption); err != nil {
+			return err
+		}
+	}
+	if opts.Lifecycle != nil {
+		if err = c.SetBucketLifecycle(ctx, bucketName, opts.Lifecycle); err != nil {
+			return err
+		}
+	}
+	return nil
 }
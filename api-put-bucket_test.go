@@ -0,0 +1,117 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestMakeBucketWithConfiguration(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	err := clnt.MakeBucket(ctx, "configured", MakeBucketOptions{
+		Versioning: &BucketVersioningConfiguration{Status: "Enabled"},
+		Tags:       map[string]string{"team": "blue"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	versioning, err := clnt.GetBucketVersioning(ctx, "configured")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !versioning.Enabled() {
+		t.Fatalf("expected versioning to be enabled, got %+v", versioning)
+	}
+	bt, err := clnt.GetBucketTagging(ctx, "configured")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bt.ToMap()["team"] != "blue" {
+		t.Fatalf("unexpected tags %v", bt.ToMap())
+	}
+
+	// Invalid options are rejected before the bucket is created.
+	err = clnt.MakeBucket(ctx, "invalid", MakeBucketOptions{
+		DefaultRetention: &Retention{Mode: Governance, Validity: time.Hour},
+	})
+	if err == nil {
+		t.Fatal("expected error for a partial day retention")
+	}
+
+	// The test server does not implement bucket policies, the bucket
+	// must be removed again.
+	err = clnt.MakeBucket(ctx, "rollback", MakeBucketOptions{
+		Tags:   map[string]string{"team": "red"},
+		Policy: `{"Version":"2012-10-17","Statement":[]}`,
+	})
+	if ToErrorResponse(err).Code != "NotImplemented" {
+		t.Fatalf("expected NotImplemented, got %v", err)
+	}
+	for _, name := range []string{"invalid", "rollback"} {
+		found, err := clnt.BucketExists(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found {
+			t.Fatalf("expected bucket %s to not exist", name)
+		}
+	}
+
+	// Creating an owned bucket succeeds in us-east-1, the bucket was not
+	// created and must not be removed.
+	owned, err := New(srv.Endpoint(), &Options{
+		Creds:     credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:    srv.Region,
+		Transport: ownedBucketTransport{http.DefaultTransport},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = owned.MakeBucket(ctx, "configured", MakeBucketOptions{
+		Policy: `{"Version":"2012-10-17","Statement":[]}`,
+	})
+	if ToErrorResponse(err).Code != "NotImplemented" {
+		t.Fatalf("expected NotImplemented, got %v", err)
+	}
+	if found, err := clnt.BucketExists(ctx, "configured"); err != nil || !found {
+		t.Fatalf("expected bucket configured to exist, got %v, %v", found, err)
+	}
+}
+
+// ownedBucketTransport answers the creation of buckets which exist
+// like us-east-1 does for buckets owned by the caller.
+type ownedBucketTransport struct {
+	http.RoundTripper
+}
+
+func (t ownedBucketTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err == nil && req.Method == http.MethodPut && req.URL.RawQuery == "" && resp.StatusCode == http.StatusConflict {
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Body = http.NoBody
+	}
+	return resp, err
+}
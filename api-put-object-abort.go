@@ -0,0 +1,82 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// abortUploadTimeout bounds the abort of a failed multipart upload
+// made with PutObjectOptions.AbortOnContextCancel.
+const abortUploadTimeout = 10 * time.Second
+
+// ErrIncompleteUpload is returned by PutObject with
+// PutObjectOptions.AbortOnContextCancel if a multipart upload failed,
+// e.g. because the context was canceled. It reports whether the upload
+// was aborted or its parts are left behind on the server.
+type ErrIncompleteUpload struct {
+	// Err is the error which made the upload fail.
+	Err error
+	// UploadID of the failed multipart upload.
+	UploadID string
+	// Aborted is true if the uploaded parts were removed.
+	Aborted bool
+	// AbortErr is the error of the abort if Aborted is false.
+	AbortErr error
+}
+
+// Error returns the upload error and the outcome of the abort.
+func (e ErrIncompleteUpload) Error() string {
+	if e.Aborted {
+		return fmt.Sprintf("%v (upload %s aborted)", e.Err, e.UploadID)
+	}
+	return fmt.Sprintf("%v (upload %s left behind: %v)", e.Err, e.UploadID, e.AbortErr)
+}
+
+// Unwrap returns the error which made the upload fail.
+func (e ErrIncompleteUpload) Unwrap() error {
+	return e.Err
+}
+
+// abortFailedUpload aborts the multipart upload if *err is set, to be
+// deferred by the multipart uploaders. With detach the abort is made
+// even if ctx was canceled, and *err is replaced by ErrIncompleteUpload.
+func (c *Client) abortFailedUpload(ctx context.Context, err *error, bucketName, objectName, uploadID string, detach bool) {
+	if *err == nil {
+		return
+	}
+	if !detach {
+		c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
+		return
+	}
+	abortCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), abortUploadTimeout)
+	defer cancel()
+	abortErr := c.abortMultipartUpload(abortCtx, bucketName, objectName, uploadID)
+	// An upload which is gone left nothing behind.
+	if ToErrorResponse(abortErr).Code == "NoSuchUpload" {
+		abortErr = nil
+	}
+	*err = ErrIncompleteUpload{
+		Err:      *err,
+		UploadID: uploadID,
+		Aborted:  abortErr == nil,
+		AbortErr: abortErr,
+	}
+}
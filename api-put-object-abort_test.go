@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+// cancelingReader cancels a context once n bytes were read.
+type cancelingReader struct {
+	r      io.Reader
+	n      int64
+	cancel context.CancelFunc
+}
+
+func (c *cancelingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.n -= int64(n); c.n <= 0 {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestPutObjectAbortOnContextCancel(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+
+	const partSize = 5 << 20
+	data := bytes.Repeat([]byte("a"), 3*partSize)
+	upload := func(abort bool) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r := &cancelingReader{r: bytes.NewReader(data), n: partSize, cancel: cancel}
+		_, err := clnt.PutObject(ctx, "bucket", "object", r, int64(len(data)), PutObjectOptions{
+			PartSize:             partSize,
+			AbortOnContextCancel: abort,
+		})
+		return err
+	}
+	incompleteUploads := func() int {
+		t.Helper()
+		uploads, err := Core{clnt}.ListMultipartUploadsPaginator("bucket", "", "", 0).NextPage(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(uploads)
+	}
+
+	// Without the option the abort fails with the canceled context.
+	if err := upload(false); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the upload to be canceled, got %v", err)
+	}
+	if n := incompleteUploads(); n != 1 {
+		t.Fatalf("expected the upload to be left behind, got %d uploads", n)
+	}
+
+	err := upload(true)
+	var incomplete ErrIncompleteUpload
+	if !errors.As(err, &incomplete) || !incomplete.Aborted || incomplete.UploadID == "" || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected an aborted upload, got %v", err)
+	}
+	if n := incompleteUploads(); n != 1 {
+		t.Fatalf("expected only the first upload to be left behind, got %d uploads", n)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// adaptivePartDuration is the time an adaptive part size takes to
+// upload at the observed throughput, long enough for the request
+// overhead to be small and short enough for retries to be cheap.
+const adaptivePartDuration = 10 * time.Second
+
+// observePartUpload updates the moving average of the upload throughput
+// of a single part, parts below the absolute minimum part size are
+// dominated by the request overhead and ignored.
+func (c *Client) observePartUpload(size int64, d time.Duration) {
+	if size < absMinPartSize || d <= 0 {
+		return
+	}
+	bps := int64(float64(size) / d.Seconds())
+	for {
+		old := atomic.LoadInt64(&c.uploadThroughput)
+		avg := bps
+		if old != 0 {
+			avg = (3*old + bps) / 4
+		}
+		if atomic.CompareAndSwapInt64(&c.uploadThroughput, old, max(avg, 1)) {
+			return
+		}
+	}
+}
+
+// UploadThroughput returns the moving average of the throughput of a
+// single part upload in bytes per second, zero until the first part
+// of a multipart upload was uploaded.
+func (c *Client) UploadThroughput() int64 {
+	return atomic.LoadInt64(&c.uploadThroughput)
+}
+
+// adaptivePartSize returns the part size for an object of size bytes
+// uploaded by numThreads parallel uploads, or zero to use the static
+// part size if the throughput is not known yet or the size is unknown.
+func (c *Client) adaptivePartSize(size int64, numThreads int) uint64 {
+	bps := c.UploadThroughput()
+	if bps == 0 || size < 0 {
+		return 0
+	}
+	partSize := bps * int64(adaptivePartDuration/time.Second)
+	// Give all threads a part to upload.
+	partSize = min(partSize, ceilDiv(size, int64(max(numThreads, 1))))
+	// Stay above the default part size and below the maximum number
+	// of parts.
+	partSize = max(partSize, minPartSize, ceilDiv(size, maxPartsCount))
+	partSize = min(partSize, maxPartSize)
+	// Round up to full MiBs.
+	const mib = 1 << 20
+	partSize = min(ceilDiv(partSize, mib)*mib, maxPartSize)
+	return uint64(partSize)
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,84 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAdaptivePartSize(t *testing.T) {
+	const mib = 1 << 20
+	testCases := []struct {
+		bps        int64
+		size       int64
+		numThreads int
+		want       uint64
+	}{
+		// Unknown throughput or size.
+		{0, 1 << 30, 4, 0},
+		{10 * mib, -1, 4, 0},
+		// Ten seconds at the throughput.
+		{10 * mib, 10 << 30, 4, 100 * mib},
+		{10*mib + 1, 10 << 30, 4, 101 * mib},
+		// Enough parts for all threads.
+		{100 * mib, 1 << 30, 4, 256 * mib},
+		// Not below the default part size.
+		{mib, 10 << 30, 4, minPartSize},
+		{10 * mib, 32 * mib, 4, minPartSize},
+		// At most 10000 parts.
+		{mib, 1 << 40, 4, 105 * mib},
+		// Not above the maximum part size.
+		{1 << 30, 5 << 40, 1, maxPartSize},
+	}
+	for i, tc := range testCases {
+		c := &Client{uploadThroughput: tc.bps}
+		if got := c.adaptivePartSize(tc.size, tc.numThreads); got != tc.want {
+			t.Errorf("Test %d: expected part size %d, got %d", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestObservePartUpload(t *testing.T) {
+	c := &Client{}
+	c.observePartUpload(absMinPartSize-1, time.Second)
+	if c.UploadThroughput() != 0 {
+		t.Fatalf("expected small parts to be ignored, got %d", c.UploadThroughput())
+	}
+	c.observePartUpload(8<<20, time.Second)
+	c.observePartUpload(16<<20, time.Second)
+	if got := c.UploadThroughput(); got != 10<<20 {
+		t.Fatalf("expected a throughput of %d, got %d", 10<<20, got)
+	}
+
+	_, clnt := newTestServerClient(t)
+	data := bytes.Repeat([]byte("a"), 2*minPartSize+1)
+	opts := PutObjectOptions{AdaptivePartSize: true, NumThreads: 2}
+	if _, err := clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), opts); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt64(&clnt.uploadThroughput) == 0 {
+		t.Fatal("expected the part uploads to be observed")
+	}
+	if _, err := clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), opts); err != nil {
+		t.Fatal(err)
+	}
+}
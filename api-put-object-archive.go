@@ -0,0 +1,343 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// maxBufferedArchiveEntry is the largest tar entry which is read
+// into memory to be uploaded concurrently, larger entries are
+// streamed one at a time.
+const maxBufferedArchiveEntry = 16 << 20
+
+// ArchiveEntry describes a regular file read from an archive.
+type ArchiveEntry struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+	Mode    fs.FileMode
+
+	// PAXRecords of tar entries. Records written by SnowballWriter
+	// are already applied to the upload options.
+	PAXRecords map[string]string
+}
+
+// PutObjectsFromArchiveOptions contains options for PutObjectsFromArchive.
+type PutObjectsFromArchiveOptions struct {
+	// Format of the archive, defaults to ArchiveTar. Zip archives
+	// are spooled to a temporary file first.
+	Format ArchiveFormat
+
+	// Prefix is prepended to the entry names to form object keys.
+	Prefix string
+
+	// NumThreads is the number of concurrent uploads, defaults to 4.
+	NumThreads int
+
+	// Opts is applied to all objects.
+	Opts PutObjectOptions
+
+	// Metadata, if set, is called to adjust the options of each
+	// entry, opts is a copy of Opts.
+	Metadata func(entry ArchiveEntry, opts *PutObjectOptions)
+}
+
+// archiveUpload is a single entry to upload.
+type archiveUpload struct {
+	index int
+	entry ArchiveEntry
+	open  func() (io.ReadCloser, error)
+}
+
+// PutObjectsFromArchive reads a tar or zip archive and uploads every
+// regular file as an object below opts.Prefix. Directories and other
+// special entries are skipped. The upload results are returned in
+// archive order, the first error aborts all pending uploads.
+//
+// Unlike PutObjectsSnowball the archive is expanded on the client
+// and works with any S3 compatible server.
+func (c *Client) PutObjectsFromArchive(ctx context.Context, bucketName string, archive io.Reader, opts PutObjectsFromArchiveOptions) ([]UploadInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := opts.Opts.validate(c); err != nil {
+		return nil, err
+	}
+	if opts.NumThreads <= 0 {
+		opts.NumThreads = totalWorkers
+	}
+
+	var zr *zip.Reader
+	switch opts.Format {
+	case "":
+		opts.Format = ArchiveTar
+	case ArchiveTar:
+	case ArchiveZip:
+		var (
+			cleanup func()
+			err     error
+		)
+		if zr, cleanup, err = openZipArchive(archive); err != nil {
+			return nil, err
+		}
+		defer cleanup()
+	default:
+		return nil, errInvalidArgument("Unsupported archive format " + string(opts.Format))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		results []UploadInfo
+		upErr   error
+		wg      sync.WaitGroup
+	)
+	setErr := func(err error) {
+		mu.Lock()
+		if upErr == nil {
+			upErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+	upload := func(u archiveUpload) {
+		rc, err := u.open()
+		if err != nil {
+			setErr(err)
+			return
+		}
+		defer rc.Close()
+		info, err := c.PutObject(ctx, bucketName, opts.Prefix+u.entry.Name, rc, u.entry.Size, opts.entryOptions(u.entry))
+		if err != nil {
+			setErr(err)
+			return
+		}
+		mu.Lock()
+		results[u.index] = info
+		mu.Unlock()
+	}
+
+	uploadCh := make(chan archiveUpload)
+	for i := 0; i < opts.NumThreads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range uploadCh {
+				upload(u)
+			}
+		}()
+	}
+
+	// send queues u, or uploads it directly when it is not buffered.
+	send := func(u archiveUpload, buffered bool) bool {
+		mu.Lock()
+		results = append(results, UploadInfo{})
+		mu.Unlock()
+		if !buffered {
+			upload(u)
+			return ctx.Err() == nil
+		}
+		select {
+		case uploadCh <- u:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var err error
+	if zr != nil {
+		readZipArchive(zr, send)
+	} else {
+		err = readTarArchive(archive, send)
+	}
+	close(uploadCh)
+	wg.Wait()
+
+	if upErr != nil {
+		return nil, upErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// entryOptions returns the upload options of entry.
+func (opts PutObjectsFromArchiveOptions) entryOptions(entry ArchiveEntry) PutObjectOptions {
+	o := opts.Opts
+	o.UserMetadata = make(map[string]string, len(opts.Opts.UserMetadata))
+	for k, v := range opts.Opts.UserMetadata {
+		o.UserMetadata[k] = v
+	}
+	for k, v := range entry.PAXRecords {
+		name, ok := strings.CutPrefix(k, "minio.metadata.")
+		if !ok {
+			continue
+		}
+		switch http.CanonicalHeaderKey(name) {
+		case "Content-Type":
+			o.ContentType = v
+		case "Content-Encoding":
+			o.ContentEncoding = v
+		case "Content-Disposition":
+			o.ContentDisposition = v
+		case "Content-Language":
+			o.ContentLanguage = v
+		case "Cache-Control":
+			o.CacheControl = v
+		case "Expires":
+			if t, err := http.ParseTime(v); err == nil {
+				o.Expires = t
+			}
+		default:
+			o.UserMetadata[name] = v
+		}
+	}
+	if opts.Metadata != nil {
+		opts.Metadata(entry, &o)
+	}
+	return o
+}
+
+// archiveEntryName cleans the name of an archive entry, it returns
+// an empty name for entries which must not be extracted.
+func archiveEntryName(name string) string {
+	name = path.Clean("/" + name)[1:]
+	if name == "" || name == "." {
+		return ""
+	}
+	return name
+}
+
+func readTarArchive(r io.Reader, send func(archiveUpload, bool) bool) error {
+	tr := tar.NewReader(r)
+	for index := 0; ; {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := archiveEntryName(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || name == "" {
+			continue
+		}
+		u := archiveUpload{
+			index: index,
+			entry: ArchiveEntry{
+				Name:       name,
+				Size:       hdr.Size,
+				ModTime:    hdr.ModTime,
+				Mode:       hdr.FileInfo().Mode(),
+				PAXRecords: hdr.PAXRecords,
+			},
+		}
+		index++
+		buffered := hdr.Size <= maxBufferedArchiveEntry
+		if buffered {
+			buf := make([]byte, hdr.Size)
+			if _, err = io.ReadFull(tr, buf); err != nil {
+				return err
+			}
+			u.open = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(buf)), nil
+			}
+		} else {
+			u.open = func() (io.ReadCloser, error) {
+				return io.NopCloser(tr), nil
+			}
+		}
+		if !send(u, buffered) {
+			return nil
+		}
+	}
+}
+
+// openZipArchive returns a zip reader for r, which is spooled to a
+// temporary file unless it is a file already.
+func openZipArchive(r io.Reader) (*zip.Reader, func(), error) {
+	if f, ok := r.(*os.File); ok {
+		st, err := f.Stat()
+		if err != nil {
+			return nil, nil, err
+		}
+		zr, err := zip.NewReader(f, st.Size())
+		return zr, func() {}, err
+	}
+	tmp, err := os.CreateTemp("", "minio-zip-archive-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return zr, cleanup, nil
+}
+
+func readZipArchive(zr *zip.Reader, send func(archiveUpload, bool) bool) {
+	index := 0
+	for _, f := range zr.File {
+		name := archiveEntryName(f.Name)
+		if !f.Mode().IsRegular() || name == "" {
+			continue
+		}
+		u := archiveUpload{
+			index: index,
+			entry: ArchiveEntry{
+				Name:    name,
+				Size:    int64(f.UncompressedSize64),
+				ModTime: f.Modified,
+				Mode:    f.Mode(),
+			},
+			open: f.Open,
+		}
+		index++
+		if !send(u, true) {
+			return
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPutObjectsFromArchiveTar(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	sw := NewSnowballWriter(&buf)
+	for _, obj := range []SnowballObject{
+		{Key: "a.txt", Size: 3, Content: strings.NewReader("aaa"), Headers: http.Header{"Content-Type": {"text/plain"}}},
+		{Key: "/dir/b.bin", Size: 2, Content: strings.NewReader("bb"), Headers: http.Header{"X-Amz-Meta-Origin": {"archive"}}},
+		{Key: "../escape", Size: 1, Content: strings.NewReader("e")},
+	} {
+		if err := sw.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	infos, err := clnt.PutObjectsFromArchive(ctx, "bucket", &buf, PutObjectsFromArchiveOptions{
+		Prefix: "extracted/",
+		Metadata: func(entry ArchiveEntry, opts *PutObjectOptions) {
+			opts.UserMetadata["Size"] = "small"
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 3 || infos[0].Key != "extracted/a.txt" || infos[2].Key != "extracted/escape" {
+		t.Fatalf("unexpected upload results %+v", infos)
+	}
+
+	info, err := clnt.StatObject(ctx, "bucket", "extracted/a.txt", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "text/plain" || info.UserMetadata["Size"] != "small" {
+		t.Fatalf("unexpected object info %+v", info)
+	}
+	info, err = clnt.StatObject(ctx, "bucket", "extracted/dir/b.bin", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.UserMetadata["Origin"] != "archive" {
+		t.Fatalf("expected metadata from archive, got %v", info.UserMetadata)
+	}
+}
+
+func TestPutObjectsFromArchiveZip(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if _, err := zw.Create("dir/"); err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"dir/1", "dir/2", "3"} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(w, strings.Repeat("x", i+1))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	infos, err := clnt.PutObjectsFromArchive(ctx, "bucket", &buf, PutObjectsFromArchiveOptions{Format: ArchiveZip, NumThreads: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, info := range infos {
+		keys = append(keys, info.Key)
+	}
+	if got := strings.Join(keys, ","); got != "dir/1,dir/2,3" {
+		t.Fatalf("unexpected keys %s", got)
+	}
+	info, err := clnt.StatObject(ctx, "bucket", "3", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != 3 {
+		t.Fatalf("expected size 3, got %d", info.Size)
+	}
+}
+
+func TestPutObjectOptionsAutoExtract(t *testing.T) {
+	h := PutObjectOptions{AutoExtract: true, AutoExtractPrefix: "imports/"}.Header()
+	if h.Get("X-Amz-Meta-Snowball-Auto-Extract") != "true" || h.Get("X-Amz-Meta-Minio-Snowball-Prefix") != "imports/" {
+		t.Fatalf("unexpected headers %v", h)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"unsafe"
+)
+
+// PutObjectBytes creates an object from a byte slice, which must not be
+// modified until it returns.
+//
+// Objects up to 5GiB are uploaded with a single PUT request. As the
+// data is in memory its SHA256 and MD5 sums, unless provided, are computed
+// over the slice up front, so on insecure connections the request is signed with its
+// SHA256 sum rather than with the costlier streaming signature, and no
+// intermediate buffers are allocated. Larger objects are uploaded as
+// PutObject does.
+func (c *Client) PutObjectBytes(ctx context.Context, bucketName, objectName string, data []byte, opts PutObjectOptions) (UploadInfo, error) {
+	size := int64(len(data))
+	if size > maxSinglePutObjectSize {
+		return c.PutObject(ctx, bucketName, objectName, bytes.NewReader(data), size, opts)
+	}
+
+	// The content type is sniffed from the reader, which is rewound.
+	body, err := c.preparePutObject(ctx, bucketName, objectName, bytes.NewReader(data), &opts)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	opts.Checksum = c.negotiateChecksum(opts.Checksum)
+	opts.AutoChecksum = c.negotiateChecksum(opts.AutoChecksum)
+	if opts.Checksum.IsSet() {
+		opts.SendContentMd5 = false
+	}
+
+	md5Base64, sha256Hex := opts.contentSums()
+	if opts.SendContentMd5 && md5Base64 == "" {
+		hash := c.md5Hasher()
+		hash.Write(data)
+		md5Base64 = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+		hash.Close()
+	}
+	// The checksum of opts.Checksum is sent in a trailer, which cannot
+	// be combined with a signed payload.
+	if sha256Hex == "" && !c.secure && !opts.DisableContentSha256 && !opts.Checksum.IsSet() && !c.overrideSignerType.IsV2() {
+		hash := c.sha256Hasher()
+		hash.Write(data)
+		sha256Hex = hex.EncodeToString(hash.Sum(nil))
+		hash.Close()
+	}
+
+	if opts.OnProgress != nil && opts.progress == nil {
+		opts.progress = newProgressTracker(opts.OnProgress, size)
+	}
+	reader := newHook(body, opts.progressHook())
+	return c.putObjectDo(ctx, bucketName, objectName, reader, md5Base64, sha256Hex, size, opts)
+}
+
+// PutObjectString creates an object from a string, without copying it,
+// as PutObjectBytes.
+func (c *Client) PutObjectString(ctx context.Context, bucketName, objectName, data string, opts PutObjectOptions) (UploadInfo, error) {
+	// The bytes of the string are only read.
+	return c.PutObjectBytes(ctx, bucketName, objectName, unsafe.Slice(unsafe.StringData(data), len(data)), opts)
+}
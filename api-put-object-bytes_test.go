@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestPutObjectBytes(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var reqHeader http.Header
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			reqHeader = r.Header.Clone()
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var transferred int64
+	info, err := clnt.PutObjectBytes(ctx, "bucket", "object.txt", []byte("hello world"), PutObjectOptions{
+		SendContentMd5:    true,
+		DetectContentType: true,
+		OnProgress:        func(n, _ int64) { transferred = n },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != 11 || transferred != 11 {
+		t.Fatalf("unexpected size %d, progress %d", info.Size, transferred)
+	}
+	if got := reqHeader.Get("X-Amz-Content-Sha256"); got != sum256Hex([]byte("hello world")) {
+		t.Fatalf("expected a signed payload, got %q", got)
+	}
+	if got := reqHeader.Get("Content-Md5"); got != sumMD5Base64([]byte("hello world")) {
+		t.Fatalf("unexpected Content-Md5 %q", got)
+	}
+
+	if _, err = clnt.PutObjectString(ctx, "bucket", "string", "hello", PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{"object.txt": "hello world", "string": "hello"} {
+		data, info, err := clnt.GetObjectBytes(ctx, "bucket", name, GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Fatalf("%s: expected %q, got %q", name, want, data)
+		}
+		if name == "object.txt" && info.ContentType != "text/plain; charset=utf-8" {
+			t.Fatalf("unexpected content type %q", info.ContentType)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// PutObjectCASOptions are the options of PutObjectCAS.
+type PutObjectCASOptions struct {
+	PutObjectOptions
+
+	// Prefix of the keys of the objects, see ContentAddressedKey.
+	Prefix string
+}
+
+// PutObjectCAS stores content by hash, like artifact and blob stores:
+// the object is written to the key derived from the SHA-256 of its
+// content, ContentAddressedKey(opts.Prefix, reader), unless it exists.
+// The key is returned, along with whether the object was written.
+//
+// The content is read twice, to hash it and to upload it, seeking
+// back readers implementing io.Seeker, others being spooled to a
+// temporary file. The upload is conditional with If-None-Match, so
+// concurrent uploads of the same content store it once.
+func (c *Client) PutObjectCAS(ctx context.Context, bucketName string, reader io.Reader, objectSize int64, opts PutObjectCASOptions) (key string, created bool, err error) {
+	// Input validation.
+	if err = s3utils.CheckValidBucketName(bucketName); err != nil {
+		return "", false, err
+	}
+
+	seeker, ok := reader.(io.ReadSeeker)
+	if !ok {
+		f, err := os.CreateTemp("", "minio-cas-")
+		if err != nil {
+			return "", false, err
+		}
+		defer func() {
+			f.Close()
+			os.Remove(f.Name())
+		}()
+		if objectSize >= 0 {
+			reader = io.LimitReader(reader, objectSize)
+		}
+		if objectSize, err = io.Copy(f, reader); err != nil {
+			return "", false, err
+		}
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return "", false, err
+		}
+		seeker = f
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", false, err
+	}
+	h := sha256.New()
+	if objectSize >= 0 {
+		_, err = io.CopyN(h, seeker, objectSize)
+	} else {
+		objectSize, err = io.Copy(h, seeker)
+	}
+	if err != nil {
+		return "", false, err
+	}
+	key = contentAddressedKey(opts.Prefix, h.Sum(nil))
+
+	// Don't upload content which is already stored.
+	_, err = c.StatObject(ctx, bucketName, key, StatObjectOptions{})
+	if err == nil {
+		return key, false, nil
+	}
+	if ToErrorResponse(err).Code != "NoSuchKey" {
+		return "", false, err
+	}
+
+	if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+		return "", false, err
+	}
+	putOpts := opts.PutObjectOptions
+	putOpts.customHeaders = putOpts.customHeaders.Clone()
+	putOpts.SetMatchETagExcept("*")
+	_, err = c.PutObject(ctx, bucketName, key, seeker, objectSize, putOpts)
+	if err != nil {
+		if ToErrorResponse(err).Code == "PreconditionFailed" {
+			// Stored concurrently.
+			return key, false, nil
+		}
+		return "", false, err
+	}
+	return key, true, nil
+}
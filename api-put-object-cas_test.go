@@ -0,0 +1,106 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestPutObjectCAS(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	const expectedKey = "blobs/2c/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	// Non seekable readers are spooled.
+	key, created, err := clnt.PutObjectCAS(ctx, "bucket", io.MultiReader(strings.NewReader("hello")), -1, PutObjectCASOptions{Prefix: "blobs/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != expectedKey || !created {
+		t.Fatalf("expected %s to be created, got %s, %v", expectedKey, key, created)
+	}
+	data, _, err := clnt.GetObjectBytes(ctx, "bucket", key, GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("unexpected content %q", data)
+	}
+
+	// Seekable readers are read from their offset.
+	r := strings.NewReader("xhello")
+	r.Seek(1, io.SeekStart)
+	key, created, err = clnt.PutObjectCAS(ctx, "bucket", r, 5, PutObjectCASOptions{Prefix: "blobs/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != expectedKey || created {
+		t.Fatalf("expected %s to exist, got %s, %v", expectedKey, key, created)
+	}
+
+	// Objects stored after the check are not overwritten.
+	srv, _ := newTestServerClient(t)
+	racing, err := New(srv.Endpoint(), &Options{
+		Creds:     credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:    srv.Region,
+		Transport: missingHeadTransport{http.DefaultTransport},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = racing.PutObject(ctx, "bucket", expectedKey, strings.NewReader("hello"), 5, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	key, created, err = racing.PutObjectCAS(ctx, "bucket", bytes.NewReader([]byte("hello")), 5, PutObjectCASOptions{Prefix: "blobs/"})
+	if err != nil || key != expectedKey || created {
+		t.Fatalf("expected %s to exist, got %s, %v, %v", expectedKey, key, created, err)
+	}
+
+	// Failing to check for the object is not taken for the object
+	// being stored.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, created, err = racing.PutObjectCAS(cancelled, "bucket", strings.NewReader("unstored"), 8, PutObjectCASOptions{Prefix: "blobs/"})
+	if err == nil || created {
+		t.Fatalf("expected the cancelled upload to fail, got %v, %v", created, err)
+	}
+}
+
+// missingHeadTransport answers HEAD requests as if objects did not exist.
+type missingHeadTransport struct {
+	http.RoundTripper
+}
+
+func (t missingHeadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodHead {
+		return t.RoundTripper.RoundTrip(req)
+	}
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// FanOutOptions are the options of FanOutUpload common to all objects.
+type FanOutOptions struct {
+	Checksum Checksum
+	SSE      encrypt.ServerSide
+
+	// ContentAddressedPrefix, if set, additionally stores the content
+	// by hash, under ContentAddressedKey(ContentAddressedPrefix, ...).
+	// This requires the reader to be an io.Seeker, the content being
+	// read to hash it before it is uploaded.
+	ContentAddressedPrefix string
+}
+
+// FanOutResult is the outcome of storing one of the objects of a
+// fan-out upload.
+type FanOutResult struct {
+	Key          string
+	ETag         string
+	VersionID    string
+	LastModified time.Time
+
+	// Err is the error storing the object, nil if it was stored.
+	Err error
+}
+
+// ContentAddressedKey returns the key storing the content of r by hash,
+// prefix followed by the first two hex digits of its SHA-256 and the
+// full SHA-256, e.g. "cas/2c/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+// so identical contents share an object and keys spread evenly.
+func ContentAddressedKey(prefix string, r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return contentAddressedKey(prefix, h.Sum(nil)), nil
+}
+
+// contentAddressedKey returns the key of content with the SHA-256 sum.
+func contentAddressedKey(prefix string, sum []byte) string {
+	s := hex.EncodeToString(sum)
+	return prefix + s[:2] + "/" + s
+}
+
+// FanOutUpload stores the content of reader as all the objects of
+// entries, with their own metadata, tags and retention, in a single
+// request streaming the content once. This is a MinIO extension, see
+// PutObjectFanOut. The objects are written independently, the results
+// are returned in the order of entries along with the one of the
+// content addressed object if opts.ContentAddressedPrefix is set, each
+// with its own error. An error is returned only if the request failed.
+func (c *Client) FanOutUpload(ctx context.Context, bucketName string, reader io.Reader, entries []PutObjectFanOutEntry, opts FanOutOptions) ([]FanOutResult, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if opts.ContentAddressedPrefix != "" {
+		seeker, ok := reader.(io.Seeker)
+		if !ok {
+			return nil, errInvalidArgument("Content addressed fan-out uploads require an io.Seeker")
+		}
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ContentAddressedKey(opts.ContentAddressedPrefix, reader)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		entries = append(entries[:len(entries):len(entries)], PutObjectFanOutEntry{Key: key})
+	}
+	keys := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if err := s3utils.CheckValidObjectName(entry.Key); err != nil {
+			return nil, err
+		}
+		if keys[entry.Key] {
+			return nil, errInvalidArgument(fmt.Sprintf("Duplicate fan-out key %s", entry.Key))
+		}
+		keys[entry.Key] = true
+		if entry.Retention != "" && !entry.Retention.IsValid() {
+			return nil, errInvalidArgument(entry.Retention.String() + " unsupported retention mode")
+		}
+	}
+
+	responses, err := c.PutObjectFanOut(ctx, bucketName, reader, PutObjectFanOutRequest{
+		Entries:  entries,
+		Checksum: opts.Checksum,
+		SSE:      opts.SSE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]PutObjectFanOutResponse, len(responses))
+	for _, resp := range responses {
+		byKey[resp.Key] = resp
+	}
+	results := make([]FanOutResult, len(entries))
+	for i, entry := range entries {
+		result := FanOutResult{Key: entry.Key}
+		resp, ok := byKey[entry.Key]
+		switch {
+		case !ok:
+			result.Err = ErrorResponse{
+				StatusCode: http.StatusInternalServerError,
+				Code:       "InternalError",
+				Message:    "No fan-out result returned for the object.",
+				BucketName: bucketName,
+				Key:        entry.Key,
+			}
+		case resp.Error != "":
+			result.Err = errors.New(resp.Error)
+		default:
+			result.ETag = trimEtag(resp.ETag)
+			result.VersionID = resp.VersionID
+			if resp.LastModified != nil {
+				result.LastModified = *resp.LastModified
+			}
+		}
+		results[i] = result
+	}
+	return results, nil
+}
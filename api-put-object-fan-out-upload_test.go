@@ -0,0 +1,163 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// fanOutServer stores fan-out uploads like MinIO, failing objects with
+// keys starting with "fail".
+type fanOutServer struct {
+	sync.Mutex
+	objects map[string][]byte
+	meta    map[string]PutObjectFanOutEntry
+}
+
+func (s *fanOutServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f, _, err := r.FormFile("file")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	content, _ := io.ReadAll(f)
+	sum := md5.Sum(content)
+
+	s.Lock()
+	defer s.Unlock()
+	dec := json.NewDecoder(strings.NewReader(r.FormValue("x-minio-fanout-list")))
+	enc := json.NewEncoder(w)
+	for dec.More() {
+		var entry PutObjectFanOutEntry
+		if err := dec.Decode(&entry); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.HasPrefix(entry.Key, "fail") {
+			enc.Encode(PutObjectFanOutResponse{Key: entry.Key, Error: "Access Denied."})
+			continue
+		}
+		s.objects[entry.Key] = content
+		s.meta[entry.Key] = entry
+		now := time.Now().UTC()
+		enc.Encode(PutObjectFanOutResponse{Key: entry.Key, ETag: `"` + hex.EncodeToString(sum[:]) + `"`, LastModified: &now})
+	}
+}
+
+func TestFanOutUpload(t *testing.T) {
+	srv := &fanOutServer{objects: map[string][]byte{}, meta: map[string]PutObjectFanOutEntry{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	clnt, err := New(strings.TrimPrefix(ts.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	content := []byte("hello")
+	sum := md5.Sum(content)
+
+	entries := []PutObjectFanOutEntry{
+		{Key: "a.txt", UserMetadata: map[string]string{"Owner": "a"}, UserTags: map[string]string{"team": "a"}},
+		{Key: "fail.txt"},
+		{Key: "b.txt", ContentType: "text/plain"},
+	}
+	results, err := clnt.FanOutUpload(ctx, "bucket", bytes.NewReader(content), entries, FanOutOptions{ContentAddressedPrefix: "cas/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	casKey, err := ContentAddressedKey("cas/", bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if casKey != "cas/2c/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Fatalf("unexpected content addressed key %s", casKey)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %+v", results)
+	}
+	for i, key := range []string{"a.txt", "fail.txt", "b.txt", casKey} {
+		r := results[i]
+		if r.Key != key {
+			t.Fatalf("result %d: expected key %s, got %s", i, key, r.Key)
+		}
+		if key == "fail.txt" {
+			if r.Err == nil {
+				t.Fatal("expected failed object to have an error")
+			}
+			continue
+		}
+		if r.Err != nil || r.ETag != hex.EncodeToString(sum[:]) || r.LastModified.IsZero() {
+			t.Fatalf("unexpected result %+v", r)
+		}
+		if !bytes.Equal(srv.objects[key], content) {
+			t.Fatalf("unexpected content of %s: %q", key, srv.objects[key])
+		}
+	}
+	if srv.meta["a.txt"].UserTags["team"] != "a" || srv.meta["b.txt"].ContentType != "text/plain" {
+		t.Fatalf("unexpected metadata %+v", srv.meta)
+	}
+
+	if _, err = clnt.FanOutUpload(ctx, "bucket", bytes.NewReader(content), []PutObjectFanOutEntry{{Key: "x"}, {Key: "x"}}, FanOutOptions{}); err == nil {
+		t.Fatal("expected duplicate keys to fail")
+	}
+	if _, err = clnt.FanOutUpload(ctx, "bucket", strings.NewReader("hello"), entries, FanOutOptions{ContentAddressedPrefix: "cas/"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.FanOutUpload(ctx, "bucket", io.MultiReader(bytes.NewReader(content)), entries, FanOutOptions{ContentAddressedPrefix: "cas/"}); err == nil {
+		t.Fatal("expected content addressed upload of a non seekable reader to fail")
+	}
+
+	// Readers are uploaded from their current offset.
+	delete(srv.objects, casKey)
+	reader := bytes.NewReader(append([]byte("skip"), content...))
+	if _, err = reader.Seek(4, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	results, err = clnt.FanOutUpload(ctx, "bucket", reader, nil, FanOutOptions{ContentAddressedPrefix: "cas/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Key != casKey || !bytes.Equal(srv.objects[casKey], content) {
+		t.Fatalf("unexpected result %+v, content %q", results, srv.objects[casKey])
+	}
+}
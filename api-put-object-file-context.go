@@ -53,6 +53,13 @@ func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePat
 	// Save the file size.
 	fileSize := fileStat.Size()
 
+	// Detect contentType by the file name and contents if asked to.
+	if opts.ContentType == "" && opts.DetectContentType {
+		if opts.ContentType, _, err = detectContentType(filepath.Base(filePath), fileReader); err != nil {
+			return UploadInfo{}, err
+		}
+	}
+
 	// Set contentType based on filepath extension if not given or default
 	// value of "application/octet-stream" if the extension has no associated type.
 	if opts.ContentType == "" {
@@ -30,6 +30,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
@@ -93,11 +94,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	}
 	delete(opts.UserMetadata, "X-Amz-Checksum-Algorithm")
 
-	defer func() {
-		if err != nil {
-			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		}
-	}()
+	defer c.abortFailedUpload(ctx, &err, bucketName, objectName, uploadID, opts.AbortOnContextCancel)
 
 	// Part number always starts with '1'.
 	partNumber := 1
@@ -105,15 +102,19 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	buf := make([]byte, partSize)
+	// Create a buffer, or a staging file.
+	stage, err := newPartStage(opts.StagingDir, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer stage.Close()
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
 	customHeader := make(http.Header)
 	crc := opts.AutoChecksum.Hasher()
 	for partNumber <= totalPartsCount {
-		length, rErr := readFull(reader, buf)
+		length, rErr := stage.read(reader)
 		if rErr == io.EOF && partNumber > 1 {
 			break
 		}
@@ -124,14 +125,17 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 
 		// Calculates hash sums while copying partSize bytes into cw.
 		for k, v := range hashAlgos {
-			v.Write(buf[:length])
+			werr := stage.writeTo(v, length)
 			hashSums[k] = v.Sum(nil)
 			v.Close()
+			if werr != nil {
+				return UploadInfo{}, werr
+			}
 		}
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		rd := newHook(stage.reader(length), opts.progressHook())
 
 		// Checksums..
 		var (
@@ -147,14 +151,16 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 		}
 		if len(hashSums) == 0 {
 			crc.Reset()
-			crc.Write(buf[:length])
+			if err := stage.writeTo(crc, length); err != nil {
+				return UploadInfo{}, err
+			}
 			cSum := crc.Sum(nil)
 			customHeader.Set(opts.AutoChecksum.Key(), base64.StdEncoding.EncodeToString(cSum))
 		}
 
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: length, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
 		// Proceed to upload the part.
-		objPart, uerr := c.uploadPart(ctx, p)
+		objPart, uerr := c.uploadPartRetry(ctx, p, opts.PartRetries)
 		if uerr != nil {
 			return UploadInfo{}, uerr
 		}
@@ -163,7 +169,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 		partsInfo[partNumber] = objPart
 
 		// Save successfully uploaded size.
-		totalUploadedSize += int64(length)
+		totalUploadedSize += length
 
 		// Increment part number.
 		partNumber++
@@ -335,6 +341,7 @@ func (c *Client) uploadPart(ctx context.Context, p uploadPartParams) (ObjectPart
 	}
 
 	// Execute PUT on each part.
+	start := time.Now()
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
 	defer closeResponse(resp)
 	if err != nil {
@@ -345,6 +352,7 @@ func (c *Client) uploadPart(ctx context.Context, p uploadPartParams) (ObjectPart
 			return ObjectPart{}, httpRespToErrorResponse(resp, p.bucketName, p.objectName)
 		}
 	}
+	c.observePartUpload(p.size, time.Since(start))
 	// Once successfully uploaded, return completed part.
 	h := resp.Header
 	objPart := ObjectPart{
@@ -387,6 +395,7 @@ func (c *Client) completeMultipartUpload(ctx context.Context, bucketName, object
 		headers.Del(encrypt.SseKmsKeyID)          // Remove X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id not supported in CompleteMultipartUpload
 		headers.Del(encrypt.SseGenericHeader)     // Remove X-Amz-Server-Side-Encryption not supported in CompleteMultipartUpload
 		headers.Del(encrypt.SseEncryptionContext) // Remove X-Amz-Server-Side-Encryption-Context not supported in CompleteMultipartUpload
+		headers.Del(encrypt.SseBucketKeyEnabled)  // Remove X-Amz-Server-Side-Encryption-Bucket-Key-Enabled not supported in CompleteMultipartUpload
 	}
 
 	// Instantiate all the complete multipart buffer.
@@ -458,5 +467,9 @@ func (c *Client) completeMultipartUpload(ctx context.Context, bucketName, object
 		ChecksumCRC32C:    completeMultipartUploadResult.ChecksumCRC32C,
 		ChecksumCRC64NVME: completeMultipartUploadResult.ChecksumCRC64NVME,
 		ChecksumMode:      completeMultipartUploadResult.ChecksumType,
+
+		Encryption: encryptionInfo(resp.Header),
+
+		responseHeader: uploadResponseHeader(resp.Header),
 	}, nil
 }
@@ -0,0 +1,143 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+)
+
+// partStage holds the last part read from a reader which is not
+// seekable, so that its upload can be retried from it: in a buffer
+// of the part size, or in a temporary file if a staging directory
+// is set, see PutObjectOptions.StagingDir.
+type partStage struct {
+	buf  []byte
+	file *os.File
+	size int64
+}
+
+func newPartStage(dir string, partSize int64) (*partStage, error) {
+	if dir == "" {
+		return &partStage{buf: make([]byte, partSize), size: partSize}, nil
+	}
+	f, err := os.CreateTemp(dir, ".minio-part-*")
+	if err != nil {
+		return nil, err
+	}
+	return &partStage{file: f, size: partSize}, nil
+}
+
+// read stages the next part of r, of up to the part size, and returns
+// its length. Like readFull the error is io.EOF only if nothing was
+// read and io.ErrUnexpectedEOF if the part is short.
+func (s *partStage) read(r io.Reader) (int64, error) {
+	if s.file == nil {
+		n, err := readFull(r, s.buf)
+		return int64(n), err
+	}
+	if err := s.file.Truncate(0); err != nil {
+		return 0, err
+	}
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.CopyN(s.file, r, s.size)
+	switch {
+	case err == io.EOF && n == 0:
+		return 0, io.EOF
+	case err == io.EOF:
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// writeTo writes the staged part of length bytes to w, for hashing.
+// It fails if the staging file cannot be read back in full.
+func (s *partStage) writeTo(w io.Writer, length int64) error {
+	if s.file == nil {
+		_, err := w.Write(s.buf[:length])
+		return err
+	}
+	n, err := io.Copy(w, s.reader(length))
+	if err == nil && n != length {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// reader returns a reader of the staged part of length bytes.
+func (s *partStage) reader(length int64) io.ReadSeeker {
+	if s.file == nil {
+		return bytes.NewReader(s.buf[:length])
+	}
+	return io.NewSectionReader(s.file, 0, length)
+}
+
+// Close removes the staging file, if any.
+func (s *partStage) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	if rerr := os.Remove(s.file.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// uploadPartRetry uploads a part like uploadPart, and uploads it again
+// up to retries times if it still fails with a retryable error once
+// the retries of the client are exhausted. The reader of the part is
+// rewound before each upload, which takes back the progress reported.
+func (c *Client) uploadPartRetry(ctx context.Context, p uploadPartParams, retries int) (ObjectPart, error) {
+	for attempt := 0; ; attempt++ {
+		objPart, err := c.uploadPart(ctx, p)
+		if err == nil || attempt >= retries || !isPartErrorRetryable(ctx, err) {
+			return objPart, err
+		}
+		seeker, ok := p.reader.(io.Seeker)
+		if !ok {
+			return objPart, err
+		}
+		if _, serr := seeker.Seek(0, io.SeekStart); serr != nil {
+			return objPart, serr
+		}
+	}
+}
+
+// isPartErrorRetryable reports whether the upload of a part failing
+// with err may succeed if done again.
+func isPartErrorRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return isS3CodeRetryable(errResp.Code) || isHTTPStatusRetryable(errResp.StatusCode)
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return isRequestErrorRetryable(ctx, err)
+	}
+	return false
+}
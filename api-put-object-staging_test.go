@@ -0,0 +1,161 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// partFailTransport fails the first uploads of the first part with
+// a SlowDown error, without passing them on to the server.
+type partFailTransport struct {
+	mu    sync.Mutex
+	fails int
+}
+
+func (t *partFailTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPut && req.URL.Query().Get("partNumber") == "1" {
+		t.mu.Lock()
+		fail := t.fails > 0
+		if fail {
+			t.fails--
+		}
+		t.mu.Unlock()
+		if fail {
+			if req.Body != nil {
+				io.Copy(io.Discard, req.Body)
+				req.Body.Close()
+			}
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Content-Type": {"application/xml"}},
+				Body:       io.NopCloser(strings.NewReader("<Error><Code>SlowDown</Code><Message>Please reduce your request rate.</Message></Error>")),
+				Request:    req,
+			}, nil
+		}
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestPutObjectPartRetries(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	transport := &partFailTransport{}
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:      credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:     srv.Region,
+		Transport:  transport,
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("0123456789abcdef"), (6<<20)/16)
+
+	for _, tc := range []struct {
+		name       string
+		size       int64
+		stagingDir string
+		retries    int
+		fails      int
+		wantErr    bool
+	}{
+		{"no-retries", -1, "", 0, 1, true},
+		{"memory", -1, "", 2, 2, false},
+		{"staged", -1, t.TempDir(), 2, 2, false},
+		{"staged-size", int64(len(data)), t.TempDir(), 1, 1, false},
+		{"exhausted", -1, t.TempDir(), 1, 2, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport.fails = tc.fails
+			// io.MultiReader hides the seeking of the bytes.Reader.
+			_, err := clnt.PutObject(ctx, "bucket", "object-"+tc.name, io.MultiReader(bytes.NewReader(data)), tc.size, PutObjectOptions{
+				PartSize:    5 << 20,
+				StagingDir:  tc.stagingDir,
+				PartRetries: tc.retries,
+			})
+			if tc.wantErr {
+				if ToErrorResponse(err).Code != "SlowDown" {
+					t.Fatalf("expected SlowDown error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			obj, err := clnt.GetObject(ctx, "bucket", "object-"+tc.name, GetObjectOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatal("unexpected object data")
+			}
+			if tc.stagingDir != "" {
+				entries, err := os.ReadDir(tc.stagingDir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(entries) != 0 {
+					t.Fatalf("staging files left behind: %v", entries)
+				}
+			}
+		})
+	}
+}
+
+func TestPartStageWriteToError(t *testing.T) {
+	stage, err := newPartStage(t.TempDir(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stage.Close()
+	n, err := stage.read(strings.NewReader("staged part"))
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("read: %d, %v", n, err)
+	}
+	var buf bytes.Buffer
+	if err := stage.writeTo(&buf, n); err != nil || buf.String() != "staged part" {
+		t.Fatalf("writeTo: %q, %v", buf.String(), err)
+	}
+
+	// A staging file shorter than the part must not hash as the part.
+	if err := stage.file.Truncate(4); err != nil {
+		t.Fatal(err)
+	}
+	if err := stage.writeTo(io.Discard, n); err != io.ErrUnexpectedEOF {
+		t.Fatalf("writeTo of a truncated file: %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	// Nor one which cannot be read back.
+	stage.file.Close()
+	if err := stage.writeTo(io.Discard, n); err == nil {
+		t.Fatal("writeTo of a closed file: no error")
+	}
+}
@@ -44,11 +44,12 @@ import (
 func (c *Client) putObjectMultipartStream(ctx context.Context, bucketName, objectName string,
 	reader io.Reader, size int64, opts PutObjectOptions,
 ) (info UploadInfo, err error) {
-	if opts.ConcurrentStreamParts && opts.NumThreads > 1 {
-		info, err = c.putObjectMultipartStreamParallel(ctx, bucketName, objectName, reader, opts)
-	} else if !isObject(reader) && isReadAt(reader) && !opts.SendContentMd5 {
-		// Verify if the reader implements ReadAt and it is not a *minio.Object then we will use parallel uploader.
+	if !isObject(reader) && isReadAt(reader) {
+		// Verify if the reader implements ReadAt and it is not a *minio.Object then we will use parallel uploader,
+		// which reads the parts at their offsets without buffering them.
 		info, err = c.putObjectMultipartStreamFromReadAt(ctx, bucketName, objectName, reader.(io.ReaderAt), size, opts)
+	} else if opts.ConcurrentStreamParts && opts.NumThreads > 1 {
+		info, err = c.putObjectMultipartStreamParallel(ctx, bucketName, objectName, reader, opts)
 	} else {
 		info, err = c.putObjectMultipartStreamOptionalChecksum(ctx, bucketName, objectName, reader, size, opts)
 	}
@@ -110,8 +111,9 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 	}
 	if opts.Checksum.IsSet() {
 		opts.AutoChecksum = opts.Checksum
+		opts.SendContentMd5 = false
 	}
-	withChecksum := c.trailingHeaderSupport
+	withChecksum := c.trailingHeaderSupport && !opts.SendContentMd5
 	if withChecksum {
 		addAutoChecksumHeaders(&opts)
 	}
@@ -126,11 +128,7 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 	// function returns any error, since we do not resume
 	// we should purge the parts which have been uploaded
 	// to relinquish storage space.
-	defer func() {
-		if err != nil {
-			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		}
-	}()
+	defer c.abortFailedUpload(ctx, &err, bucketName, objectName, uploadID, opts.AbortOnContextCancel)
 
 	// Total data read and written to server. should be equal to 'size' at the end of the call.
 	var totalUploadedSize int64
@@ -190,7 +188,24 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					partSize = lastPartSize
 				}
 
-				sectionReader := newHook(io.NewSectionReader(reader, readOffset, partSize), opts.Progress)
+				// The part is read twice to calculate its md5sum,
+				// which is cheap for io.ReaderAt sources.
+				var md5Base64 string
+				if opts.SendContentMd5 {
+					md5Hash := c.md5Hasher()
+					_, err := io.Copy(md5Hash, io.NewSectionReader(reader, readOffset, partSize))
+					md5Base64 = base64.StdEncoding.EncodeToString(md5Hash.Sum(nil))
+					md5Hash.Close()
+					if err != nil {
+						uploadedPartsCh <- uploadedPartRes{
+							Error: err,
+						}
+						// Exit the goroutine.
+						return
+					}
+				}
+
+				sectionReader := newHook(io.NewSectionReader(reader, readOffset, partSize), opts.progressHook())
 				trailer := make(http.Header, 1)
 				if withChecksum {
 					crc := opts.AutoChecksum.Hasher()
@@ -207,6 +222,7 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					uploadID:     uploadID,
 					reader:       sectionReader,
 					partNumber:   uploadReq.PartNum,
+					md5Base64:    md5Base64,
 					size:         partSize,
 					sse:          opts.ServerSideEncryption,
 					streamSha256: !opts.DisableContentSha256,
@@ -322,11 +338,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	// any error, since we do not resume we should purge
 	// the parts which have been uploaded to relinquish
 	// storage space.
-	defer func() {
-		if err != nil {
-			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		}
-	}()
+	defer c.abortFailedUpload(ctx, &err, bucketName, objectName, uploadID, opts.AbortOnContextCancel)
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -341,8 +353,12 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	buf := make([]byte, partSize)
+	// Create a buffer, or a staging file.
+	stage, err := newPartStage(opts.StagingDir, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer stage.Close()
 
 	// Avoid declaring variables in the for loop
 	var md5Base64 string
@@ -355,7 +371,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 			partSize = lastPartSize
 		}
 
-		length, rerr := readFull(reader, buf)
+		length, rerr := stage.read(reader)
 		if rerr == io.EOF && partNumber > 1 {
 			break
 		}
@@ -367,21 +383,25 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 		// Calculate md5sum.
 		if opts.SendContentMd5 {
 			md5Hash.Reset()
-			md5Hash.Write(buf[:length])
+			if err := stage.writeTo(md5Hash, length); err != nil {
+				return UploadInfo{}, err
+			}
 			md5Base64 = base64.StdEncoding.EncodeToString(md5Hash.Sum(nil))
 		} else {
 			// Add CRC32C instead.
 			crc.Reset()
-			crc.Write(buf[:length])
+			if err := stage.writeTo(crc, length); err != nil {
+				return UploadInfo{}, err
+			}
 			cSum := crc.Sum(nil)
 			customHeader.Set(opts.AutoChecksum.KeyCapitalized(), base64.StdEncoding.EncodeToString(cSum))
 		}
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		hooked := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		hooked := newHook(stage.reader(length), opts.progressHook())
 		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
-		objPart, uerr := c.uploadPart(ctx, p)
+		objPart, uerr := c.uploadPartRetry(ctx, p, opts.PartRetries)
 		if uerr != nil {
 			return UploadInfo{}, uerr
 		}
@@ -482,11 +502,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 	// any error, since we do not resume we should purge
 	// the parts which have been uploaded to relinquish
 	// storage space.
-	defer func() {
-		if err != nil {
-			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		}
-	}()
+	defer c.abortFailedUpload(ctx, &err, bucketName, objectName, uploadID, opts.AbortOnContextCancel)
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -510,7 +526,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 	var mu sync.Mutex
 	errCh := make(chan error, opts.NumThreads)
 
-	reader = newHook(reader, opts.Progress)
+	reader = newHook(reader, opts.progressHook())
 
 	// Part number always starts with '1'.
 	var partNumber int
@@ -576,7 +592,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 				streamSha256: !opts.DisableContentSha256,
 				customHeader: customHeader,
 			}
-			objPart, uerr := c.uploadPart(ctx, p)
+			objPart, uerr := c.uploadPartRetry(ctx, p, opts.PartRetries)
 			if uerr != nil {
 				errCh <- uerr
 				return
@@ -683,8 +699,8 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, r
 		}
 	}
 
-	var md5Base64 string
-	if opts.SendContentMd5 {
+	md5Base64, sha256Hex := opts.contentSums()
+	if opts.SendContentMd5 && md5Base64 == "" {
 		// Calculate md5sum.
 		hash := c.md5Hasher()
 
@@ -716,11 +732,11 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, r
 
 	// Update progress reader appropriately to the latest offset as we
 	// read from the source.
-	progressReader := newHook(reader, opts.Progress)
+	progressReader := newHook(reader, opts.progressHook())
 
-	// This function does not calculate sha256 and md5sum for payload.
+	// This function does not calculate sha256 for payload.
 	// Execute put object.
-	return c.putObjectDo(ctx, bucketName, objectName, progressReader, md5Base64, "", size, opts)
+	return c.putObjectDo(ctx, bucketName, objectName, progressReader, md5Base64, sha256Hex, size, opts)
 }
 
 // putObjectDo - executes the put object http operation.
@@ -745,10 +761,11 @@ func (c *Client) putObjectDo(ctx context.Context, bucketName, objectName string,
 		contentLength:    size,
 		contentMD5Base64: md5Base64,
 		contentSHA256Hex: sha256Hex,
-		streamSha256:     !opts.DisableContentSha256,
+		// A known payload sum is signed instead of streaming it.
+		streamSha256: !opts.DisableContentSha256 && sha256Hex == "",
 	}
-	// Add CRC when client supports it, MD5 is not set, not Google and we don't add SHA256 to chunks.
-	addCrc := c.trailingHeaderSupport && md5Base64 == "" && !s3utils.IsGoogleEndpoint(*c.endpointURL) && (opts.DisableContentSha256 || c.secure)
+	// Add CRC when client supports it, MD5 and SHA256 are not set, not Google and we don't add SHA256 to chunks.
+	addCrc := c.trailingHeaderSupport && md5Base64 == "" && sha256Hex == "" && !s3utils.IsGoogleEndpoint(*c.endpointURL) && (opts.DisableContentSha256 || c.secure)
 	if opts.Checksum.IsSet() {
 		reqMetadata.addCrc = &opts.Checksum
 	} else if addCrc {
@@ -806,5 +823,9 @@ func (c *Client) putObjectDo(ctx context.Context, bucketName, objectName string,
 		ChecksumSHA256:    h.Get(ChecksumSHA256.Key()),
 		ChecksumCRC64NVME: h.Get(ChecksumCRC64NVME.Key()),
 		ChecksumMode:      h.Get(ChecksumFullObjectMode.Key()),
+
+		Encryption: encryptionInfo(h),
+
+		responseHeader: uploadResponseHeader(h),
 	}, nil
 }
@@ -18,14 +18,15 @@
 package minio
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
@@ -92,6 +93,15 @@ type PutObjectOptions struct {
 	DisableContentSha256    bool
 	DisableMultipart        bool
 
+	// ContentMD5 and ContentSHA256 are the hex encoded MD5 and SHA256
+	// sums of the content, if already known, sent as Content-Md5 and
+	// X-Amz-Content-Sha256 instead of computing them from the data.
+	// Objects are then uploaded with a single PUT, so their size must
+	// be known and at most 5GiB. ContentSHA256 cannot be combined with
+	// Checksum.
+	ContentMD5    string
+	ContentSHA256 string
+
 	// AutoChecksum is the type of checksum that will be added if no other checksum is added,
 	// like MD5 or SHA256 streaming checksum, and it is feasible for the upload type.
 	// If none is specified CRC32C is used, since it is generally the fastest.
@@ -107,10 +117,73 @@ type PutObjectOptions struct {
 	// ConcurrentStreamParts will create NumThreads buffers of PartSize bytes,
 	// fill them serially and upload them in parallel.
 	// This can be used for faster uploads on non-seekable or slow-to-seek input.
+	// Readers implementing io.ReaderAt with a known size are read at the part
+	// offsets in parallel instead, without buffering.
 	ConcurrentStreamParts bool
-	Internal              AdvancedPutOptions
+
+	// StagingDir, if set, is the directory where the parts read from
+	// readers which are not seekable are staged, in a temporary file
+	// removed once the upload is done, instead of a buffer of PartSize
+	// bytes in memory. Not used with ConcurrentStreamParts.
+	StagingDir string
+
+	// PartRetries is the number of times the upload of a part of a
+	// multipart upload is done again from its buffered or staged data,
+	// when it still fails with a retryable error once the retries of
+	// the client are exhausted, before the upload is aborted.
+	PartRetries int
+
+	// InheritRetention sets Mode and RetainUntilDate, when either is
+	// missing, from the default retention of the bucket, so that the
+	// retention of the object is known at upload time and sent along
+	// with it, see Client.ResolveRetention.
+	InheritRetention bool
+
+	// AbortOnContextCancel aborts failed multipart uploads even if the
+	// context was canceled, with a detached context and a short timeout,
+	// and makes failed multipart uploads return ErrIncompleteUpload
+	// reporting whether the uploaded parts are left behind. Otherwise the
+	// abort is made with the context of the upload.
+	AbortOnContextCancel bool
+
+	// AdaptivePartSize picks the part size, if PartSize is not set, from
+	// the object size and the part upload throughput observed by the
+	// client, see Client.UploadThroughput, so that parts take about ten
+	// seconds to upload. Parts are not smaller than the default part size
+	// and there are enough threads and at most 10000 parts.
+	AdaptivePartSize bool
+
+	// OnProgress is called as data is uploaded, including the parts
+	// of multipart uploads. Unlike Progress it takes back bytes of
+	// retried requests.
+	OnProgress ProgressFunc
+
+	// DetectContentType sets the content type, if ContentType is
+	// empty, by the extension of the object name, or of the file for
+	// FPutObject, or else by sniffing the first 512 bytes of the data.
+	// See RegisterContentType.
+	DetectContentType bool
+
+	// AutoExtract asks MinIO to extract the uploaded tar archive,
+	// optionally compressed, into the bucket instead of storing it
+	// as an object. Entries are stored below AutoExtractPrefix.
+	// See SnowballWriter to create such archives.
+	AutoExtract       bool
+	AutoExtractPrefix string
+
+	Internal AdvancedPutOptions
 
 	customHeaders http.Header
+	progress      *progressTracker
+}
+
+// progressHook returns the hook reporting the bytes read from a
+// single request body, see newHook.
+func (opts PutObjectOptions) progressHook() io.Reader {
+	if opts.progress == nil {
+		return opts.Progress
+	}
+	return &progressPart{tracker: opts.progress, hook: opts.Progress}
 }
 
 // SetMatchETag if etag matches while PUT MinIO returns an error
@@ -141,6 +214,43 @@ func (opts *PutObjectOptions) SetMatchETagExcept(etag string) {
 	}
 }
 
+// systemHeaders are the standard object headers which can be set
+// with SetSystemHeader.
+var systemHeaders = map[string]bool{
+	"Cache-Control":                   true,
+	"Content-Disposition":             true,
+	"Content-Encoding":                true,
+	"Content-Language":                true,
+	"Content-Type":                    true,
+	"Expires":                         true,
+	"X-Amz-Storage-Class":             true,
+	"X-Amz-Website-Redirect-Location": true,
+}
+
+// SetSystemHeader sets a standard header stored with the object, e.g.
+// "Cache-Control", overriding the corresponding field. User metadata,
+// encryption, checksum and object lock headers are not accepted, they
+// have options of their own.
+func (opts *PutObjectOptions) SetSystemHeader(key, value string) error {
+	key = http.CanonicalHeaderKey(key)
+	if !systemHeaders[key] {
+		return errInvalidArgument(key + " is not a supported system header")
+	}
+	if value == "" || !httpguts.ValidHeaderFieldValue(value) {
+		return errInvalidArgument(value + " unsupported value for " + key)
+	}
+	if key == "Expires" {
+		if _, err := http.ParseTime(value); err != nil {
+			return errInvalidArgument(value + " is not a valid HTTP date for Expires")
+		}
+	}
+	if opts.customHeaders == nil {
+		opts.customHeaders = http.Header{}
+	}
+	opts.customHeaders.Set(key, value)
+	return nil
+}
+
 // getNumThreads - gets the number of threads to be used in the multipart
 // put object operation
 func (opts PutObjectOptions) getNumThreads() (numThreads int) {
@@ -229,6 +339,13 @@ func (opts PutObjectOptions) Header() (header http.Header) {
 		header.Set(minIOBucketReplicationTaggingTimestamp, opts.Internal.TaggingTimestamp.Format(time.RFC3339Nano))
 	}
 
+	if opts.AutoExtract {
+		header.Set(minIOSnowballAutoExtract, "true")
+		if opts.AutoExtractPrefix != "" {
+			header.Set(minIOSnowballPrefix, opts.AutoExtractPrefix)
+		}
+	}
+
 	if len(opts.UserTags) != 0 {
 		if tags, _ := tags.NewTags(opts.UserTags, true); tags != nil {
 			header.Set(amzTaggingHeader, tags.String())
@@ -267,6 +384,22 @@ func (opts PutObjectOptions) validate(c *Client) (err error) {
 	if opts.LegalHold != "" && !opts.LegalHold.IsValid() {
 		return errInvalidArgument(opts.LegalHold.String() + " unsupported legal-hold status")
 	}
+	if opts.ContentMD5 != "" {
+		if sum, err := hex.DecodeString(opts.ContentMD5); err != nil || len(sum) != 16 {
+			return errInvalidArgument(opts.ContentMD5 + " invalid hex encoded MD5 sum")
+		}
+	}
+	if opts.ContentSHA256 != "" {
+		if sum, err := hex.DecodeString(opts.ContentSHA256); err != nil || len(sum) != 32 {
+			return errInvalidArgument(opts.ContentSHA256 + " invalid hex encoded SHA256 sum")
+		}
+		if opts.Checksum.IsSet() {
+			return errInvalidArgument("ContentSHA256 cannot be used with Checksum")
+		}
+	}
+	if opts.PartRetries < 0 {
+		return errInvalidArgument("PartRetries cannot be negative")
+	}
 	if opts.Checksum.IsSet() {
 		switch {
 		case !c.trailingHeaderSupport:
@@ -281,6 +414,16 @@ func (opts PutObjectOptions) validate(c *Client) (err error) {
 	return nil
 }
 
+// contentSums returns the provided sums of the content, the MD5 sum
+// base64 encoded as sent in Content-Md5 and the SHA256 sum hex encoded.
+func (opts PutObjectOptions) contentSums() (md5Base64, sha256Hex string) {
+	if opts.ContentMD5 != "" {
+		sum, _ := hex.DecodeString(opts.ContentMD5)
+		md5Base64 = base64.StdEncoding.EncodeToString(sum)
+	}
+	return md5Base64, strings.ToLower(opts.ContentSHA256)
+}
+
 // completedParts is a collection of parts sortable by their part numbers.
 // used for sorting the uploaded parts before completing the multipart request.
 type completedParts []CompletePart
@@ -314,7 +457,7 @@ func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, r
 		return UploadInfo{}, errors.New("object size must be provided with disable multipart upload")
 	}
 
-	err = opts.validate(c)
+	reader, err = c.preparePutObject(ctx, bucketName, objectName, reader, &opts)
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -322,18 +465,61 @@ func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, r
 	return c.putObjectCommon(ctx, bucketName, objectName, reader, objectSize, opts)
 }
 
+// preparePutObject validates opts and resolves the options of the upload
+// of reader to objectName which depend on the bucket or on the data, see
+// InheritRetention and DetectContentType. The returned reader must be
+// used in place of reader.
+func (c *Client) preparePutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, opts *PutObjectOptions) (_ io.Reader, err error) {
+	if err = opts.validate(c); err != nil {
+		return nil, err
+	}
+
+	if opts.InheritRetention {
+		opts.Mode, opts.RetainUntilDate, err = c.ResolveRetention(ctx, bucketName, *opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.ContentType == "" && opts.DetectContentType {
+		opts.ContentType, reader, err = detectContentType(objectName, reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reader, nil
+}
+
 func (c *Client) putObjectCommon(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (info UploadInfo, err error) {
 	// Check for largest object size allowed.
 	if size > int64(maxMultipartPutObjectSize) {
 		return UploadInfo{}, errEntityTooLarge(size, maxMultipartPutObjectSize, bucketName, objectName)
 	}
 	opts.AutoChecksum.SetDefault(ChecksumCRC32C)
+	opts.Checksum = c.negotiateChecksum(opts.Checksum)
+	opts.AutoChecksum = c.negotiateChecksum(opts.AutoChecksum)
+	if opts.OnProgress != nil && opts.progress == nil {
+		opts.progress = newProgressTracker(opts.OnProgress, size)
+	}
+
+	// The provided sums are of the whole content, which is then
+	// uploaded with a single PUT.
+	if opts.ContentMD5 != "" || opts.ContentSHA256 != "" {
+		if size < 0 || size > maxSinglePutObjectSize {
+			return UploadInfo{}, errInvalidArgument("ContentMD5 and ContentSHA256 require an object size of at most 5GiB")
+		}
+		return c.putObject(ctx, bucketName, objectName, reader, size, opts)
+	}
 
 	// NOTE: Streaming signature is not supported by GCS.
 	if s3utils.IsGoogleEndpoint(*c.endpointURL) {
 		return c.putObject(ctx, bucketName, objectName, reader, size, opts)
 	}
 
+	if opts.PartSize == 0 && opts.AdaptivePartSize {
+		opts.PartSize = c.adaptivePartSize(size, opts.getNumThreads())
+	}
+
 	partSize := opts.PartSize
 	if opts.PartSize == 0 {
 		partSize = minPartSize
@@ -400,11 +586,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	}
 	delete(opts.UserMetadata, "X-Amz-Checksum-Algorithm")
 
-	defer func() {
-		if err != nil {
-			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		}
-	}()
+	defer c.abortFailedUpload(ctx, &err, bucketName, objectName, uploadID, opts.AbortOnContextCancel)
 
 	// Part number always starts with '1'.
 	partNumber := 1
@@ -412,8 +594,12 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	buf := make([]byte, partSize)
+	// Create a buffer, or a staging file.
+	stage, err := newPartStage(opts.StagingDir, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer stage.Close()
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -421,7 +607,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	crc := opts.AutoChecksum.Hasher()
 
 	for partNumber <= totalPartsCount {
-		length, rerr := readFull(reader, buf)
+		length, rerr := stage.read(reader)
 		if rerr == io.EOF && partNumber > 1 {
 			break
 		}
@@ -434,23 +620,28 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 		if opts.SendContentMd5 {
 			// Calculate md5sum.
 			hash := c.md5Hasher()
-			hash.Write(buf[:length])
+			werr := stage.writeTo(hash, length)
 			md5Base64 = base64.StdEncoding.EncodeToString(hash.Sum(nil))
 			hash.Close()
+			if werr != nil {
+				return UploadInfo{}, werr
+			}
 		} else {
 			crc.Reset()
-			crc.Write(buf[:length])
+			if err := stage.writeTo(crc, length); err != nil {
+				return UploadInfo{}, err
+			}
 			cSum := crc.Sum(nil)
 			customHeader.Set(opts.AutoChecksum.Key(), base64.StdEncoding.EncodeToString(cSum))
 		}
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		rd := newHook(stage.reader(length), opts.progressHook())
 
 		// Proceed to upload the part.
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
-		objPart, uerr := c.uploadPart(ctx, p)
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: length, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		objPart, uerr := c.uploadPartRetry(ctx, p, opts.PartRetries)
 		if uerr != nil {
 			return UploadInfo{}, uerr
 		}
@@ -459,7 +650,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 		partsInfo[partNumber] = objPart
 
 		// Save successfully uploaded size.
-		totalUploadedSize += int64(length)
+		totalUploadedSize += length
 
 		// Increment part number.
 		partNumber++
@@ -17,12 +17,20 @@
 package minio
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
 )
 
@@ -124,6 +132,25 @@ func Test_SSEHeaders(t *testing.T) {
 			},
 			headerNotAllowedAfterInit: []string{encrypt.SseGenericHeader, encrypt.SseKmsKeyID, encrypt.SseEncryptionContext},
 		},
+		"sse with options": {
+			sse: func() encrypt.ServerSide {
+				s, err := encrypt.NewSSEKMSWithOptions("keyId", encrypt.KMSOptions{
+					Context:   map[string]string{"b": "2", "a": "1"},
+					BucketKey: true,
+				})
+				if err != nil {
+					t.Error(err)
+				}
+				return s
+			},
+			initiateMultipartUploadHeaders: http.Header{
+				encrypt.SseGenericHeader:     []string{"aws:kms"},
+				encrypt.SseKmsKeyID:          []string{"keyId"},
+				encrypt.SseEncryptionContext: []string{base64.StdEncoding.EncodeToString([]byte(`{"a":"1","b":"2"}`))},
+				encrypt.SseBucketKeyEnabled:  []string{"true"},
+			},
+			headerNotAllowedAfterInit: []string{encrypt.SseGenericHeader, encrypt.SseKmsKeyID, encrypt.SseEncryptionContext, encrypt.SseBucketKeyEnabled},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -166,3 +193,225 @@ func Test_SSEHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestPutObjectEncryptionInfo(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	sse, err := encrypt.NewSSEKMSWithOptions("my-key", encrypt.KMSOptions{BucketKey: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	up, err := clnt.PutObject(ctx, "bucket", "obj", strings.NewReader("data"), 4, PutObjectOptions{ServerSideEncryption: sse})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := EncryptionInfo{Algorithm: "aws:kms", KMSKeyID: "my-key", BucketKeyEnabled: true}
+	if up.Encryption != want {
+		t.Fatalf("expected %+v, got %+v", want, up.Encryption)
+	}
+	info, err := clnt.StatObject(ctx, "bucket", "obj", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Encryption != want {
+		t.Fatalf("expected %+v, got %+v", want, info.Encryption)
+	}
+
+	if _, err = encrypt.NewSSEKMSWithOptions("", encrypt.KMSOptions{Context: map[string]string{"": "x"}}); err == nil {
+		t.Fatal("expected error for an empty context key")
+	}
+	putTestObjects(t, clnt, map[string]string{"plain": "data"})
+	if info, err = clnt.StatObject(ctx, "bucket", "plain", StatObjectOptions{}); err != nil || info.Encryption.IsEncrypted() {
+		t.Fatalf("expected unencrypted object, got %+v, %v", info.Encryption, err)
+	}
+}
+
+func TestPutObjectSystemHeaders(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	opts := PutObjectOptions{CacheControl: "no-cache"}
+	for _, invalid := range [][2]string{
+		{"X-Amz-Meta-Color", "red"},
+		{"X-Amz-Server-Side-Encryption", "AES256"},
+		{"Cache-Control", ""},
+		{"Expires", "tomorrow"},
+	} {
+		if err := opts.SetSystemHeader(invalid[0], invalid[1]); err == nil {
+			t.Errorf("expected %s: %s to be rejected", invalid[0], invalid[1])
+		}
+	}
+	for k, v := range map[string]string{
+		"cache-control":                   "max-age=3600",
+		"Content-Language":                "de-DE",
+		"Expires":                         "Wed, 21 Oct 2099 07:28:00 GMT",
+		"x-amz-website-redirect-location": "/other",
+	} {
+		if err := opts.SetSystemHeader(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[string]string{
+		"Cache-Control":                   "max-age=3600",
+		"Content-Language":                "de-DE",
+		"X-Amz-Website-Redirect-Location": "/other",
+	} {
+		if got := info.Metadata.Get(k); got != want {
+			t.Errorf("expected %s: %s, got %s", k, want, got)
+		}
+	}
+	if info.Expires.Year() != 2099 {
+		t.Errorf("unexpected expiry %v", info.Expires)
+	}
+
+	// Override the stored headers in the response.
+	getOpts := GetObjectOptions{}
+	getOpts.SetResponseCacheControl("private")
+	getOpts.SetResponseContentLanguage("en")
+	if err = getOpts.SetResponseExpires(time.Time{}); err == nil {
+		t.Fatal("expected error for empty expiry")
+	}
+	expires := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err = getOpts.SetResponseExpires(expires); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "object", getOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	info, err = obj.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Metadata.Get("Cache-Control") != "private" || info.Metadata.Get("Content-Language") != "en" || !info.Expires.Equal(expires) {
+		t.Errorf("unexpected response headers %v", info.Metadata)
+	}
+}
+
+// concurrentReaderAt is an io.ReaderAt which records the highest number
+// of concurrent ReadAt calls, and is not readable sequentially.
+type concurrentReaderAt struct {
+	r            *bytes.Reader
+	active, peak atomic.Int32
+}
+
+func (c *concurrentReaderAt) Read([]byte) (int, error) {
+	return 0, io.ErrNoProgress
+}
+
+func (c *concurrentReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n := c.active.Add(1)
+	defer c.active.Add(-1)
+	for peak := c.peak.Load(); n > peak && !c.peak.CompareAndSwap(peak, n); peak = c.peak.Load() {
+	}
+	// Leave time for the other parts to be read.
+	time.Sleep(time.Millisecond)
+	return c.r.ReadAt(p, off)
+}
+
+func TestPutObjectFromReaderAt(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	const partSize = 5 << 20
+	data := bytes.Repeat([]byte("0123456789"), (3*partSize+10)/10)
+	for i, opts := range []PutObjectOptions{
+		{PartSize: partSize, NumThreads: 4},
+		{PartSize: partSize, NumThreads: 4, SendContentMd5: true},
+		{PartSize: partSize, NumThreads: 4, ConcurrentStreamParts: true},
+	} {
+		src := &concurrentReaderAt{r: bytes.NewReader(data)}
+		if _, err := clnt.PutObject(ctx, "bucket", "object", src, int64(len(data)), opts); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if src.peak.Load() < 2 {
+			t.Errorf("Test %d: expected concurrent part reads, got %d", i+1, src.peak.Load())
+		}
+		obj, err := clnt.GetObject(ctx, "bucket", "object", GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(obj)
+		obj.Close()
+		if err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("Test %d: uploaded data differs, %v", i+1, err)
+		}
+	}
+}
+
+func TestPutObjectContentSums(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var puts []*http.Request
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts = append(puts, r.Clone(context.Background()))
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// Larger than a part, uploaded with a single PUT nonetheless.
+	data := bytes.Repeat([]byte("a"), 20<<20)
+	md5Sum, _ := base64.StdEncoding.DecodeString(sumMD5Base64(data))
+	opts := PutObjectOptions{
+		ContentMD5:    hex.EncodeToString(md5Sum),
+		ContentSHA256: strings.ToUpper(sum256Hex(data)),
+	}
+	// io.MultiReader hides the seeking of the bytes.Reader.
+	if _, err = clnt.PutObject(ctx, "bucket", "object", io.MultiReader(bytes.NewReader(data)), int64(len(data)), opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(puts) != 1 || puts[0].URL.RawQuery != "" {
+		t.Fatalf("expected a single PUT, got %d", len(puts))
+	}
+	if got := puts[0].Header.Get("Content-Md5"); got != sumMD5Base64(data) {
+		t.Fatalf("unexpected Content-Md5 %q", got)
+	}
+	if got := puts[0].Header.Get("X-Amz-Content-Sha256"); got != sum256Hex(data) {
+		t.Fatalf("unexpected X-Amz-Content-Sha256 %q", got)
+	}
+
+	// The server checks the provided sums.
+	for _, opts := range []PutObjectOptions{
+		{ContentMD5: hex.EncodeToString(md5Sum[:8]) + hex.EncodeToString(md5Sum[:8])},
+		{ContentSHA256: sum256Hex([]byte("other"))},
+	} {
+		_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), opts)
+		if code := ToErrorResponse(err).Code; code != "BadDigest" && code != "XAmzContentSHA256Mismatch" {
+			t.Fatalf("expected a digest error, got %v", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		size int64
+		opts PutObjectOptions
+	}{
+		{-1, opts},
+		{int64(len(data)), PutObjectOptions{ContentMD5: "abc"}},
+		{int64(len(data)), PutObjectOptions{ContentSHA256: hex.EncodeToString(md5Sum)}},
+		{int64(len(data)), PutObjectOptions{ContentSHA256: opts.ContentSHA256, Checksum: ChecksumCRC32C}},
+	} {
+		_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), tc.size, tc.opts)
+		if ToErrorResponse(err).Code != "InvalidArgument" {
+			t.Fatalf("expected an invalid argument error for %+v, got %v", tc.opts, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ObjectUpload is an object uploaded by PutObjects.
+type ObjectUpload struct {
+	// Key is the name of the object.
+	Key string
+
+	// Content of the object, exactly Size bytes are read if Size is
+	// not negative, otherwise it is read until EOF. Content is closed
+	// after the upload if it is an io.Closer.
+	Content io.Reader
+	Size    int64
+
+	// Opts are the options of the upload. In snowball mode only the
+	// headers of the options are applied to the object.
+	Opts PutObjectOptions
+}
+
+// PutObjectResult is the result of an upload of PutObjects.
+type PutObjectResult struct {
+	Key string
+
+	// Info of the uploaded object, only the bucket, key and size are
+	// set for objects uploaded in snowball archives.
+	Info UploadInfo
+	Err  error
+}
+
+// PutObjectsOptions configures PutObjects.
+type PutObjectsOptions struct {
+	// NumWorkers is the number of concurrent uploads, 4 if zero.
+	NumWorkers int
+
+	// Snowball uploads the objects in tar archives extracted by the
+	// server, see PutObjectsSnowball, which is only supported by MinIO.
+	// An archive is uploaded once it reaches SnowballSize bytes, 64MiB
+	// if zero, and all the objects of an archive share its result.
+	Snowball     bool
+	SnowballSize int64
+
+	// SnowballCompress compresses the snowball archives.
+	SnowballCompress bool
+}
+
+const (
+	defaultPutObjectsWorkers      = 4
+	defaultPutObjectsSnowballSize = 64 << 20
+)
+
+// putObjectsBufPool holds the buffers the content of small objects of
+// PutObjects is read into.
+var putObjectsBufPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// PutObjects uploads the objects received from objects, up to
+// opts.NumWorkers at a time, until the channel is closed or ctx is
+// canceled. It is the write side of RemoveObjects: the returned channel
+// receives a result per object, in completion order, and is closed once
+// all uploads are done.
+//
+// Objects up to the minimum part size are read in pooled buffers and
+// uploaded in a single request, as with PutObjectBytes. Larger objects
+// are uploaded as with PutObject.
+func (c *Client) PutObjects(ctx context.Context, bucketName string, objects <-chan ObjectUpload, opts PutObjectsOptions) <-chan PutObjectResult {
+	resultCh := make(chan PutObjectResult, 1)
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		resultCh <- PutObjectResult{Err: err}
+		close(resultCh)
+		return resultCh
+	}
+	if objects == nil {
+		resultCh <- PutObjectResult{Err: errInvalidArgument("Objects channel cannot be nil")}
+		close(resultCh)
+		return resultCh
+	}
+
+	workers := opts.NumWorkers
+	if workers <= 0 {
+		workers = defaultPutObjectsWorkers
+	}
+	upload := c.putObjectsOne
+	if opts.Snowball {
+		upload = c.putObjectsSnowball(opts)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			upload(ctx, bucketName, objects, resultCh)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+	return resultCh
+}
+
+// nextObjectUpload receives the next object to upload, ok is false once
+// the channel is closed or ctx is canceled.
+func nextObjectUpload(ctx context.Context, objects <-chan ObjectUpload) (obj ObjectUpload, ok bool) {
+	select {
+	case <-ctx.Done():
+		return ObjectUpload{}, false
+	case obj, ok = <-objects:
+		return obj, ok
+	}
+}
+
+// putObjectsOne is the PutObjects worker uploading each object with its
+// own request.
+func (c *Client) putObjectsOne(ctx context.Context, bucketName string, objects <-chan ObjectUpload, resultCh chan<- PutObjectResult) {
+	for {
+		obj, ok := nextObjectUpload(ctx, objects)
+		if !ok {
+			return
+		}
+		info, err := c.putObjectUpload(ctx, bucketName, obj)
+		resultCh <- PutObjectResult{Key: obj.Key, Info: info, Err: err}
+	}
+}
+
+func (c *Client) putObjectUpload(ctx context.Context, bucketName string, obj ObjectUpload) (UploadInfo, error) {
+	if closer, ok := obj.Content.(io.Closer); ok {
+		defer closer.Close()
+	}
+	if obj.Size > minPartSize || obj.Opts.PartSize > 0 {
+		return c.PutObject(ctx, bucketName, obj.Key, obj.Content, obj.Size, obj.Opts)
+	}
+
+	buf := putObjectsBufPool.Get().(*bytes.Buffer)
+	defer putObjectsBufPool.Put(buf)
+	buf.Reset()
+	data, err := readObjectUpload(buf, obj, minPartSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	if data == nil {
+		// Larger than announced, upload the rest as a stream.
+		return c.PutObject(ctx, bucketName, obj.Key, io.MultiReader(bytes.NewReader(buf.Bytes()), obj.Content), -1, obj.Opts)
+	}
+	return c.PutObjectBytes(ctx, bucketName, obj.Key, data, obj.Opts)
+}
+
+// readObjectUpload reads the content of obj into buf. The returned
+// slice is nil if the content has an unknown size larger than limit,
+// the first limit+1 bytes are in buf in that case.
+func readObjectUpload(buf *bytes.Buffer, obj ObjectUpload, limit int64) ([]byte, error) {
+	if obj.Size >= 0 {
+		buf.Grow(int(obj.Size))
+		n, err := io.CopyN(buf, obj.Content, obj.Size)
+		if err != nil {
+			if err == io.EOF {
+				return nil, errUnexpectedEOF(n, obj.Size, "", obj.Key)
+			}
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	n, err := io.Copy(buf, io.LimitReader(obj.Content, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > limit {
+		return nil, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// putObjectsSnowball returns the PutObjects worker uploading the objects
+// in snowball archives.
+func (c *Client) putObjectsSnowball(opts PutObjectsOptions) func(context.Context, string, <-chan ObjectUpload, chan<- PutObjectResult) {
+	maxSize := opts.SnowballSize
+	if maxSize <= 0 {
+		maxSize = defaultPutObjectsSnowballSize
+	}
+	return func(ctx context.Context, bucketName string, objects <-chan ObjectUpload, resultCh chan<- PutObjectResult) {
+		var (
+			batch []SnowballObject
+			size  int64
+		)
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			objs := make(chan SnowballObject, len(batch))
+			for _, obj := range batch {
+				objs <- obj
+			}
+			close(objs)
+			err := c.PutObjectsSnowball(ctx, bucketName, SnowballOptions{InMemory: true, Compress: opts.SnowballCompress}, objs)
+			for _, obj := range batch {
+				resultCh <- PutObjectResult{
+					Key:  obj.Key,
+					Info: UploadInfo{Bucket: bucketName, Key: obj.Key, Size: obj.Size},
+					Err:  err,
+				}
+			}
+			batch, size = nil, 0
+		}
+		defer flush()
+
+		for {
+			obj, ok := nextObjectUpload(ctx, objects)
+			if !ok {
+				return
+			}
+			// The archive needs the size of the objects up front.
+			var buf bytes.Buffer
+			data, err := readObjectUpload(&buf, obj, maxSize)
+			if closer, ok := obj.Content.(io.Closer); ok {
+				closer.Close()
+			}
+			if err == nil && data == nil {
+				err = errEntityTooLarge(int64(buf.Len()), maxSize, bucketName, obj.Key)
+			}
+			if err != nil {
+				resultCh <- PutObjectResult{Key: obj.Key, Err: err}
+				continue
+			}
+			batch = append(batch, SnowballObject{
+				Key:     obj.Key,
+				Size:    int64(len(data)),
+				Content: bytes.NewReader(data),
+				Headers: obj.Opts.Header(),
+			})
+			if size += int64(len(data)); size >= maxSize {
+				flush()
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func sendObjectUploads(n int) <-chan ObjectUpload {
+	objects := make(chan ObjectUpload)
+	go func() {
+		defer close(objects)
+		for i := range n {
+			content := fmt.Sprintf("content %d", i)
+			size := int64(len(content))
+			if i%2 == 1 {
+				size = -1
+			}
+			objects <- ObjectUpload{Key: fmt.Sprintf("object-%02d", i), Content: strings.NewReader(content), Size: size}
+		}
+	}()
+	return objects
+}
+
+func TestPutObjects(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	var keys []string
+	for res := range clnt.PutObjects(ctx, "bucket", sendObjectUploads(10), PutObjectsOptions{NumWorkers: 3}) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if res.Info.Key != res.Key || res.Info.ETag == "" {
+			t.Fatalf("unexpected result %+v", res)
+		}
+		keys = append(keys, res.Key)
+	}
+	if len(keys) != 10 {
+		t.Fatalf("expected 10 results, got %d", len(keys))
+	}
+	for i := range 10 {
+		data, _, err := clnt.GetObjectBytes(ctx, "bucket", fmt.Sprintf("object-%02d", i), GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf("content %d", i); string(data) != want {
+			t.Fatalf("expected %q, got %q", want, data)
+		}
+	}
+
+	short := make(chan ObjectUpload, 1)
+	short <- ObjectUpload{Key: "short", Content: strings.NewReader("abc"), Size: 10}
+	close(short)
+	for res := range clnt.PutObjects(ctx, "bucket", short, PutObjectsOptions{}) {
+		if ToErrorResponse(res.Err).Code != "UnexpectedEOF" {
+			t.Fatalf("expected UnexpectedEOF, got %v", res.Err)
+		}
+	}
+}
+
+func TestPutObjectsSnowball(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	var results int
+	for res := range clnt.PutObjects(ctx, "bucket", sendObjectUploads(10), PutObjectsOptions{
+		NumWorkers:   1,
+		Snowball:     true,
+		SnowballSize: 50,
+	}) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		results++
+	}
+	if results != 10 {
+		t.Fatalf("expected 10 results, got %d", results)
+	}
+
+	// The test server stores the archives as is.
+	var names []string
+	var archives int
+	for obj := range clnt.ListObjects(ctx, "bucket", ListObjectsOptions{Prefix: "snowball-upload-"}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+		archives++
+		data, _, err := clnt.GetObjectBytes(ctx, "bucket", obj.Key, GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tr := tar.NewReader(bytes.NewReader(data))
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, hdr.Name)
+		}
+	}
+	// 50 bytes are reached after 6 objects of 9 bytes.
+	if archives != 2 || len(names) != 10 {
+		t.Fatalf("expected 10 objects in 2 archives, got %d in %d", len(names), archives)
+	}
+	slices.Sort(names)
+	if names[0] != "object-00" || names[9] != "object-09" {
+		t.Fatalf("unexpected objects %v", names)
+	}
+}
@@ -101,6 +101,68 @@ type readSeekCloser interface {
 	io.Seeker
 }
 
+// SnowballWriter writes objects to a tar archive in the format
+// expected by MinIO when uploading with PutObjectOptions.AutoExtract.
+// Version IDs and headers of the objects are preserved.
+type SnowballWriter struct {
+	tw *tar.Writer
+}
+
+// NewSnowballWriter returns a SnowballWriter writing to w.
+func NewSnowballWriter(w io.Writer) *SnowballWriter {
+	return &SnowballWriter{tw: tar.NewWriter(w)}
+}
+
+// Add writes obj to the archive, exactly obj.Size bytes are read
+// from obj.Content. obj.Close is not called.
+func (s *SnowballWriter) Add(obj SnowballObject) error {
+	_, err := s.write(obj)
+	return err
+}
+
+// Close finishes the archive, it does not close the underlying writer.
+func (s *SnowballWriter) Close() error {
+	return s.tw.Close()
+}
+
+// write adds obj to the archive. The returned count is negative if
+// the archive header could not be written, the archive is unusable
+// in that case.
+func (s *SnowballWriter) write(obj SnowballObject) (int64, error) {
+	// Trim accidental slash prefix.
+	obj.Key = strings.TrimPrefix(obj.Key, "/")
+	header := tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     obj.Key,
+		Size:     obj.Size,
+		ModTime:  obj.ModTime,
+		Format:   tar.FormatPAX,
+	}
+	if header.ModTime.IsZero() {
+		header.ModTime = time.Now().UTC()
+	}
+
+	header.PAXRecords = make(map[string]string)
+	if obj.VersionID != "" {
+		header.PAXRecords["minio.versionId"] = obj.VersionID
+	}
+	for k, vals := range obj.Headers {
+		header.PAXRecords["minio.metadata."+k] = strings.Join(vals, ",")
+	}
+
+	if err := s.tw.WriteHeader(&header); err != nil {
+		return -1, err
+	}
+	n, err := io.Copy(s.tw, obj.Content)
+	if err != nil {
+		return n, err
+	}
+	if n != obj.Size {
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, nil
+}
+
 // PutObjectsSnowball will put multiple objects with a single put call.
 // A (compressed) TAR file will be created which will contain multiple objects.
 // The key for each object will be used for the destination in the specified bucket.
@@ -160,7 +222,7 @@ func (c Client) PutObjectsSnowball(ctx context.Context, bucketName string, opts
 		defer s2c.Close()
 		tmpWriter = s2c
 	}
-	t := tar.NewWriter(tmpWriter)
+	sw := NewSnowballWriter(tmpWriter)
 
 objectLoop:
 	for {
@@ -177,51 +239,19 @@ objectLoop:
 				closeObj = obj.Close
 			}
 
-			// Trim accidental slash prefix.
-			obj.Key = strings.TrimPrefix(obj.Key, "/")
-			header := tar.Header{
-				Typeflag: tar.TypeReg,
-				Name:     obj.Key,
-				Size:     obj.Size,
-				ModTime:  obj.ModTime,
-				Format:   tar.FormatPAX,
-			}
-			if header.ModTime.IsZero() {
-				header.ModTime = time.Now().UTC()
-			}
-
-			header.PAXRecords = make(map[string]string)
-			if obj.VersionID != "" {
-				header.PAXRecords["minio.versionId"] = obj.VersionID
-			}
-			for k, vals := range obj.Headers {
-				header.PAXRecords["minio.metadata."+k] = strings.Join(vals, ",")
-			}
-
-			if err := t.WriteHeader(&header); err != nil {
-				closeObj()
-				return err
-			}
-			n, err := io.Copy(t, obj.Content)
+			n, err := sw.write(obj)
 			if err != nil {
 				closeObj()
-				if opts.SkipErrs {
+				if opts.SkipErrs && n >= 0 {
 					continue
 				}
 				return err
 			}
-			if n != obj.Size {
-				closeObj()
-				if opts.SkipErrs {
-					continue
-				}
-				return io.ErrUnexpectedEOF
-			}
 			closeObj()
 		}
 	}
 	// Flush tar
-	err = t.Flush()
+	err = sw.tw.Flush()
 	if err != nil {
 		return err
 	}
@@ -230,10 +260,7 @@ objectLoop:
 	if err != nil {
 		return err
 	}
-	if opts.Opts.UserMetadata == nil {
-		opts.Opts.UserMetadata = map[string]string{}
-	}
-	opts.Opts.UserMetadata["X-Amz-Meta-Snowball-Auto-Extract"] = "true"
+	opts.Opts.AutoExtract = true
 	opts.Opts.DisableMultipart = true
 	rc, sz, err := getTmpReader()
 	if err != nil {
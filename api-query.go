@@ -0,0 +1,285 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+)
+
+// QueryOptions configures Query.
+type QueryOptions struct {
+	// Format of the object, detected from its content type and the
+	// extension of its name if empty.
+	Format SelectObjectType
+
+	// CompressionType of the object, detected from its content
+	// encoding and the extension of its name if empty.
+	CompressionType SelectCompressionType
+
+	// JSONType is the type of JSON objects, if empty LINES for .jsonl
+	// and .ndjson objects and JSON Lines content types and DOCUMENT
+	// otherwise.
+	JSONType JSONType
+
+	// CSVFileHeaderInfo is USE if empty, i.e. the columns are named by
+	// the first line of CSV objects. CSVFieldDelimiter is a tab for
+	// .tsv objects and a comma otherwise if empty.
+	CSVFileHeaderInfo CSVFileHeaderInfo
+	CSVFieldDelimiter string
+
+	ServerSideEncryption encrypt.ServerSide
+	ScanRange            *SelectScanRange
+}
+
+// Rows is the result of Query. Its cursor starts before the first
+// row, use Next to advance from row to row:
+//
+//	rows, err := client.Query(ctx, "mybucket", "data.csv", "SELECT * FROM S3Object s WHERE s.country = 'NL'", minio.QueryOptions{})
+//	if err != nil {
+//	    return err
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//	    fmt.Println(rows.Row()["name"])
+//	}
+//	return rows.Err()
+type Rows struct {
+	results *SelectResults
+	dec     *json.Decoder
+	row     map[string]interface{}
+	err     error
+}
+
+// Next prepares the next row, it returns false after the last row or
+// on errors, see Err.
+func (r *Rows) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	var row map[string]interface{}
+	if err := r.dec.Decode(&row); err != nil {
+		if !errors.Is(err, io.EOF) {
+			r.err = err
+		}
+		r.row = nil
+		return false
+	}
+	for k, v := range row {
+		row[k] = queryValue(v)
+	}
+	r.row = row
+	return true
+}
+
+// Row returns the current row by column name. Numbers are int64 or
+// float64, CSV columns are strings unless cast by the query.
+func (r *Rows) Row() map[string]interface{} {
+	return r.row
+}
+
+// Err returns the error which ended the iteration, if any.
+func (r *Rows) Err() error {
+	return r.err
+}
+
+// Stats returns the statistics of the query once all rows were read.
+func (r *Rows) Stats() *StatsMessage {
+	return r.results.Stats()
+}
+
+// Close stops the query.
+func (r *Rows) Close() error {
+	return r.results.Close()
+}
+
+// queryValue converts the numbers of a decoded row.
+func queryValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = queryValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = queryValue(e)
+		}
+	}
+	return v
+}
+
+// Query runs the SQL expression on a CSV, JSON or Parquet object with
+// SelectObjectContent and returns its rows. The format of the object
+// is detected from its content type and name unless set in opts.
+func (c *Client) Query(ctx context.Context, bucketName, objectName, sql string, opts QueryOptions) (*Rows, error) {
+	var contentType string
+	if opts.Format == "" || opts.CompressionType == "" {
+		info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{ServerSideEncryption: opts.ServerSideEncryption})
+		if err != nil {
+			return nil, err
+		}
+		contentType = info.ContentType
+		format, compression := detectSelectFormat(objectName, contentType, info.Metadata.Get("Content-Encoding"))
+		if opts.Format == "" {
+			opts.Format = format
+		}
+		if opts.CompressionType == "" {
+			opts.CompressionType = compression
+		}
+		if opts.Format == "" {
+			return nil, errInvalidArgument("Cannot detect the format of " + objectName + ", set QueryOptions.Format")
+		}
+	}
+
+	selectOpts := SelectObjectOptions{
+		ServerSideEncryption: opts.ServerSideEncryption,
+		Expression:           sql,
+		ExpressionType:       QueryExpressionTypeSQL,
+		InputSerialization: SelectObjectInputSerialization{
+			CompressionType: opts.CompressionType,
+		},
+		OutputSerialization: SelectObjectOutputSerialization{
+			JSON: &JSONOutputOptions{RecordDelimiter: SelectRecordDelimiterNewline},
+		},
+		ScanRange: opts.ScanRange,
+	}
+	ext, _ := objectExtension(objectName)
+	switch opts.Format {
+	case SelectObjectTypeCSV:
+		csv := &CSVInputOptions{}
+		csv.SetFileHeaderInfo(CSVFileHeaderInfoUse)
+		if opts.CSVFileHeaderInfo != "" {
+			csv.SetFileHeaderInfo(opts.CSVFileHeaderInfo)
+		}
+		switch {
+		case opts.CSVFieldDelimiter != "":
+			csv.SetFieldDelimiter(opts.CSVFieldDelimiter)
+		case ext == ".tsv":
+			csv.SetFieldDelimiter("\t")
+		}
+		selectOpts.InputSerialization.CSV = csv
+	case SelectObjectTypeJSON:
+		typ := opts.JSONType
+		if typ == "" {
+			typ = JSONDocumentType
+			if mediaType, _, _ := mime.ParseMediaType(contentType); ext == ".jsonl" || ext == ".ndjson" || jsonLinesContentTypes[mediaType] {
+				typ = JSONLinesType
+			}
+		}
+		selectOpts.InputSerialization.JSON = &JSONInputOptions{Type: typ}
+	case SelectObjectTypeParquet:
+		selectOpts.InputSerialization.Parquet = &ParquetInputOptions{}
+	default:
+		return nil, errInvalidArgument("Unsupported query format " + string(opts.Format))
+	}
+
+	results, err := c.SelectObjectContent(ctx, bucketName, objectName, selectOpts)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(results)
+	dec.UseNumber()
+	return &Rows{results: results, dec: dec}, nil
+}
+
+// compressionExtensions maps the extensions of compressed objects to
+// their compression type.
+var compressionExtensions = map[string]SelectCompressionType{
+	".gz":     SelectCompressionGZIP,
+	".gzip":   SelectCompressionGZIP,
+	".bz2":    SelectCompressionBZIP,
+	".zst":    SelectCompressionZSTD,
+	".lz4":    SelectCompressionLZ4,
+	".s2":     SelectCompressionS2,
+	".sz":     SelectCompressionSNAPPY,
+	".snappy": SelectCompressionSNAPPY,
+}
+
+// formatExtensions maps extensions and content types to formats.
+var (
+	formatExtensions = map[string]SelectObjectType{
+		".csv":     SelectObjectTypeCSV,
+		".tsv":     SelectObjectTypeCSV,
+		".json":    SelectObjectTypeJSON,
+		".jsonl":   SelectObjectTypeJSON,
+		".ndjson":  SelectObjectTypeJSON,
+		".parquet": SelectObjectTypeParquet,
+	}
+	formatContentTypes = map[string]SelectObjectType{
+		"text/csv":                       SelectObjectTypeCSV,
+		"application/csv":                SelectObjectTypeCSV,
+		"text/tab-separated-values":      SelectObjectTypeCSV,
+		"application/json":               SelectObjectTypeJSON,
+		"application/x-ndjson":           SelectObjectTypeJSON,
+		"application/jsonl":              SelectObjectTypeJSON,
+		"application/x-jsonlines":        SelectObjectTypeJSON,
+		"application/vnd.apache.parquet": SelectObjectTypeParquet,
+		"application/x-parquet":          SelectObjectTypeParquet,
+	}
+)
+
+// objectExtension returns the lower case extension of an object name
+// and its compression, e.g. ".csv" and GZIP for "logs/2025.csv.gz".
+func objectExtension(objectName string) (string, SelectCompressionType) {
+	name := strings.ToLower(objectName)
+	if c, ok := compressionExtensions[path.Ext(name)]; ok {
+		return path.Ext(strings.TrimSuffix(name, path.Ext(name))), c
+	}
+	return path.Ext(name), ""
+}
+
+// jsonLinesContentTypes are the content types of JSON Lines.
+var jsonLinesContentTypes = map[string]bool{
+	"application/x-ndjson":    true,
+	"application/jsonl":       true,
+	"application/x-jsonlines": true,
+}
+
+// detectSelectFormat returns the format and compression of an object
+// from its content type and content encoding, or the extensions of its
+// name.
+func detectSelectFormat(objectName, contentType, contentEncoding string) (SelectObjectType, SelectCompressionType) {
+	ext, compression := objectExtension(objectName)
+	if compression == "" {
+		compression = SelectCompressionNONE
+		if strings.EqualFold(contentEncoding, "gzip") {
+			compression = SelectCompressionGZIP
+		}
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if format, ok := formatContentTypes[mediaType]; ok {
+			return format, compression
+		}
+	}
+	return formatExtensions[ext], compression
+}
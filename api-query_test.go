@@ -0,0 +1,143 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/eventstream"
+)
+
+// selectEvent encodes an event of a SelectObjectContent response.
+func selectEvent(event string, payload []byte) []byte {
+	contentType := "application/octet-stream"
+	if event == "Stats" || event == "Progress" {
+		contentType = "text/xml"
+	}
+	var buf bytes.Buffer
+	eventstream.NewEncoder(&buf).Encode(eventstream.Message{
+		Headers: eventstream.Headers{
+			{Name: eventstream.MessageTypeHeader, Value: "event"},
+			{Name: eventstream.EventTypeHeader, Value: event},
+			{Name: eventstream.ContentTypeHeader, Value: contentType},
+		},
+		Payload: payload,
+	})
+	return buf.Bytes()
+}
+
+func TestQuery(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var request string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !r.URL.Query().Has("select") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		request = string(body)
+		w.Write(selectEvent("Records", []byte(`{"name":"a","n":1}`+"\n"+`{"name":"b","n":2.5,"tags":{"x":3}}`+"\n")))
+		w.Write(selectEvent("End", nil))
+	}))
+	defer proxy.Close()
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	put := func(name, contentType string) {
+		t.Helper()
+		if _, err := clnt.PutObject(ctx, "bucket", name, strings.NewReader("x"), 1, PutObjectOptions{ContentType: contentType}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("data.tsv.gz", "application/octet-stream")
+	put("events", "application/x-ndjson")
+	put("unknown", "")
+
+	rows, err := clnt.Query(ctx, "bucket", "data.tsv.gz", "SELECT * FROM S3Object", QueryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]interface{}
+	for rows.Next() {
+		got = append(got, rows.Row())
+	}
+	if err = rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if len(got) != 2 || got[0]["n"] != int64(1) || got[1]["n"] != 2.5 || got[1]["tags"].(map[string]interface{})["x"] != int64(3) {
+		t.Fatalf("unexpected rows %v", got)
+	}
+	for _, want := range []string{"<CompressionType>GZIP</CompressionType>", "<FileHeaderInfo>USE</FileHeaderInfo>", "<FieldDelimiter>&#x9;</FieldDelimiter>", "<OutputSerialization><JSON>"} {
+		if !strings.Contains(request, want) {
+			t.Fatalf("expected %q in %s", want, request)
+		}
+	}
+
+	if rows, err = clnt.Query(ctx, "bucket", "events", "SELECT * FROM S3Object", QueryOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if !strings.Contains(request, "<JSON><Type>LINES</Type></JSON>") || !strings.Contains(request, "<CompressionType>NONE</CompressionType>") {
+		t.Fatalf("unexpected request %s", request)
+	}
+
+	if _, err = clnt.Query(ctx, "bucket", "unknown", "SELECT * FROM S3Object", QueryOptions{}); err == nil {
+		t.Fatal("expected an undetected format to fail")
+	}
+	if rows, err = clnt.Query(ctx, "bucket", "unknown", "SELECT * FROM S3Object", QueryOptions{Format: SelectObjectTypeParquet, CompressionType: SelectCompressionNONE}); err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if !strings.Contains(request, "<Parquet></Parquet>") {
+		t.Fatalf("unexpected request %s", request)
+	}
+}
+
+func TestDetectSelectFormat(t *testing.T) {
+	testCases := []struct {
+		name, contentType, encoding string
+		format                      SelectObjectType
+		compression                 SelectCompressionType
+	}{
+		{"a.csv", "", "", SelectObjectTypeCSV, SelectCompressionNONE},
+		{"a.JSON.bz2", "", "", SelectObjectTypeJSON, SelectCompressionBZIP},
+		{"a.parquet", "binary/octet-stream", "", SelectObjectTypeParquet, SelectCompressionNONE},
+		{"a", "text/csv; charset=utf-8", "gzip", SelectObjectTypeCSV, SelectCompressionGZIP},
+		{"a.txt", "application/json", "", SelectObjectTypeJSON, SelectCompressionNONE},
+		{"a.txt", "text/plain", "", "", SelectCompressionNONE},
+	}
+	for _, tc := range testCases {
+		format, compression := detectSelectFormat(tc.name, tc.contentType, tc.encoding)
+		if format != tc.format || compression != tc.compression {
+			t.Errorf("%s: expected %s/%s, got %s/%s", tc.name, tc.format, tc.compression, format, compression)
+		}
+	}
+}
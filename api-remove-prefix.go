@@ -0,0 +1,129 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// RemovePrefixOptions represents options for RemovePrefix call
+type RemovePrefixOptions struct {
+	// AllVersions removes all versions and delete markers instead
+	// of only the latest versions. On versioned buckets removing the
+	// latest version creates a delete marker.
+	AllVersions bool
+
+	// DryRun lists the objects which would be removed without
+	// removing anything.
+	DryRun bool
+
+	GovernanceBypass bool
+
+	// OnProgress, if set, is called after each object with the
+	// number of objects removed and failed so far. In dry-run mode
+	// removed counts the objects which would be removed.
+	OnProgress func(removed, failed int)
+}
+
+// RemovePrefixResult is the outcome of RemovePrefix.
+type RemovePrefixResult struct {
+	// Removed is the number of objects, or versions, removed.
+	Removed int
+
+	// Objects lists the objects which would be removed, it is
+	// only filled in dry-run mode.
+	Objects []ObjectToDelete
+
+	// Failed lists the objects which could not be removed.
+	Failed []RemoveObjectError
+}
+
+// RemovePrefix removes all objects below prefix, recursively, using
+// batched multi-object delete requests. An empty prefix removes all
+// objects of the bucket. Failures to remove individual objects are
+// reported in the result, the returned error is set if listing fails.
+func (c *Client) RemovePrefix(ctx context.Context, bucketName, prefix string, opts RemovePrefixOptions) (RemovePrefixResult, error) {
+	var result RemovePrefixResult
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return result, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		return result, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := func() {
+		if opts.OnProgress != nil {
+			opts.OnProgress(result.Removed, len(result.Failed))
+		}
+	}
+
+	listCh := c.ListObjects(ctx, bucketName, ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: opts.AllVersions,
+	})
+
+	if opts.DryRun {
+		for info := range listCh {
+			if info.Err != nil {
+				return result, info.Err
+			}
+			result.Objects = append(result.Objects, ObjectToDelete{Key: info.Key, VersionID: info.VersionID})
+			result.Removed++
+			progress()
+		}
+		return result, nil
+	}
+
+	var listErr error
+	objectsCh := make(chan ObjectToDelete, 1)
+	go func() {
+		defer close(objectsCh)
+		for info := range listCh {
+			if info.Err != nil {
+				listErr = info.Err
+				return
+			}
+			objectsCh <- ObjectToDelete{Key: info.Key, VersionID: info.VersionID}
+		}
+	}()
+
+	resultCh := make(chan RemoveObjectResult, 1)
+	go c.removeObjects(ctx, bucketName, objectsCh, resultCh, RemoveObjectsOptions{GovernanceBypass: opts.GovernanceBypass})
+	for res := range resultCh {
+		if res.Err != nil {
+			result.Failed = append(result.Failed, RemoveObjectError{
+				ObjectName: res.ObjectName,
+				VersionID:  res.ObjectVersionID,
+				Err:        res.Err,
+			})
+		} else {
+			result.Removed++
+		}
+		progress()
+	}
+	if listErr != nil {
+		return result, listErr
+	}
+	return result, ctx.Err()
+}
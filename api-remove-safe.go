@@ -0,0 +1,108 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ObjectLockError is returned by VerifyGovernanceBypass and
+// SafeRemoveObject when the object lock of a version does not allow
+// to remove it.
+type ObjectLockError struct {
+	Bucket    string
+	Object    string
+	VersionID string
+	Lock      ObjectLockInfo
+}
+
+func (e *ObjectLockError) Error() string {
+	name := e.Bucket + "/" + e.Object
+	if e.VersionID != "" {
+		name += " (version " + e.VersionID + ")"
+	}
+	if e.Lock.LegalHold == LegalHoldEnabled {
+		return fmt.Sprintf("object %s is under legal hold", name)
+	}
+	return fmt.Sprintf("object %s is retained in %s mode until %s", name, e.Lock.Mode, e.Lock.RetainUntilDate.Format(time.RFC3339))
+}
+
+// VerifyGovernanceBypass checks that removing a version of an object
+// with RemoveObjectOptions.GovernanceBypass would at most bypass a
+// GOVERNANCE retention. An *ObjectLockError is returned for versions
+// under COMPLIANCE retention or legal hold, which cannot be bypassed.
+func (c *Client) VerifyGovernanceBypass(ctx context.Context, bucketName, objectName, versionID string) (ObjectLockInfo, error) {
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{VersionID: versionID})
+	if err != nil {
+		return ObjectLockInfo{}, err
+	}
+	lock := info.ObjectLock
+	if lock.IsLocked() && !lock.CanBypassGovernance() {
+		return lock, &ObjectLockError{Bucket: bucketName, Object: objectName, VersionID: versionID, Lock: lock}
+	}
+	return lock, nil
+}
+
+// SafeRemoveObjectOptions configures SafeRemoveObject.
+type SafeRemoveObjectOptions struct {
+	VersionID string
+
+	// GovernanceBypass allows to remove a version under GOVERNANCE
+	// retention.
+	GovernanceBypass bool
+
+	// ConfirmBypass is called before a GOVERNANCE retention is
+	// bypassed, the version is only removed if it returns true.
+	ConfirmBypass func(bucketName, objectName string, lock ObjectLockInfo) bool
+}
+
+// SafeRemoveObject removes an object like RemoveObject, but checks the
+// object lock of the version first: versions under legal hold or
+// COMPLIANCE retention, and versions under GOVERNANCE retention unless
+// the bypass is allowed and confirmed, are not removed and an
+// *ObjectLockError is returned. Without opts.VersionID a delete marker
+// is created, which the object lock does not prevent.
+func (c *Client) SafeRemoveObject(ctx context.Context, bucketName, objectName string, opts SafeRemoveObjectOptions) error {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return err
+	}
+	if opts.VersionID == "" {
+		return c.RemoveObject(ctx, bucketName, objectName, RemoveObjectOptions{})
+	}
+
+	lock, err := c.VerifyGovernanceBypass(ctx, bucketName, objectName, opts.VersionID)
+	if err != nil {
+		return err
+	}
+	bypass := lock.CanBypassGovernance()
+	if bypass && (!opts.GovernanceBypass || (opts.ConfirmBypass != nil && !opts.ConfirmBypass(bucketName, objectName, lock))) {
+		return &ObjectLockError{Bucket: bucketName, Object: objectName, VersionID: opts.VersionID, Lock: lock}
+	}
+	return c.RemoveObject(ctx, bucketName, objectName, RemoveObjectOptions{
+		VersionID:        opts.VersionID,
+		GovernanceBypass: bypass,
+	})
+}
@@ -0,0 +1,106 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSafeRemoveObject(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	put := func(name string, opts PutObjectOptions) string {
+		t.Helper()
+		info, err := clnt.PutObject(ctx, "bucket", name, strings.NewReader("data"), 4, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.VersionID
+	}
+	compliance := put("compliance", PutObjectOptions{Mode: Compliance, RetainUntilDate: until})
+	held := put("held", PutObjectOptions{LegalHold: LegalHoldEnabled})
+	governance := put("governance", PutObjectOptions{Mode: Governance, RetainUntilDate: until})
+	plain := put("plain", PutObjectOptions{})
+
+	for name, versionID := range map[string]string{"compliance": compliance, "held": held} {
+		err := clnt.SafeRemoveObject(ctx, "bucket", name, SafeRemoveObjectOptions{VersionID: versionID, GovernanceBypass: true})
+		var lockErr *ObjectLockError
+		if !errors.As(err, &lockErr) || lockErr.Object != name {
+			t.Fatalf("%s: expected ObjectLockError, got %v", name, err)
+		}
+	}
+
+	// Governance retention needs an allowed and confirmed bypass.
+	err := clnt.SafeRemoveObject(ctx, "bucket", "governance", SafeRemoveObjectOptions{VersionID: governance})
+	var lockErr *ObjectLockError
+	if !errors.As(err, &lockErr) || lockErr.Lock.Mode != Governance || !lockErr.Lock.RetainUntilDate.Equal(until) {
+		t.Fatalf("expected ObjectLockError, got %v", err)
+	}
+	confirmed := 0
+	opts := SafeRemoveObjectOptions{
+		VersionID:        governance,
+		GovernanceBypass: true,
+		ConfirmBypass: func(bucketName, objectName string, lock ObjectLockInfo) bool {
+			confirmed++
+			return confirmed > 1
+		},
+	}
+	if err = clnt.SafeRemoveObject(ctx, "bucket", "governance", opts); !errors.As(err, &lockErr) {
+		t.Fatalf("expected ObjectLockError, got %v", err)
+	}
+	if err = clnt.SafeRemoveObject(ctx, "bucket", "governance", opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "governance", StatObjectOptions{VersionID: governance}); err == nil {
+		t.Fatal("expected governance version to be removed")
+	}
+
+	if err = clnt.SafeRemoveObject(ctx, "bucket", "plain", SafeRemoveObjectOptions{VersionID: plain}); err != nil {
+		t.Fatal(err)
+	}
+	// Delete markers are not prevented by the object lock.
+	if err = clnt.SafeRemoveObject(ctx, "bucket", "compliance", SafeRemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestObjectLockInfoRetention(t *testing.T) {
+	now := time.Now()
+	lock := ObjectLockInfo{Mode: Governance, RetainUntilDate: now.Add(time.Hour)}
+	if !lock.RetainedAt(now) || lock.RetainedAt(now.Add(2*time.Hour)) || !lock.CanBypassGovernance() {
+		t.Fatalf("unexpected retention of %+v", lock)
+	}
+	lock.LegalHold = LegalHoldEnabled
+	if lock.CanBypassGovernance() {
+		t.Fatal("legal hold cannot be bypassed")
+	}
+	if (ObjectLockInfo{Mode: Compliance, RetainUntilDate: now.Add(time.Hour)}).CanBypassGovernance() {
+		t.Fatal("compliance retention cannot be bypassed")
+	}
+	if (ObjectLockInfo{}).RetainedAt(now) {
+		t.Fatal("expected no retention")
+	}
+}
@@ -40,14 +40,42 @@ type BucketOptions = RemoveBucketOptions
 // useful when endpoint is MinIO
 type RemoveBucketOptions struct {
 	ForceDelete bool
+
+	// GovernanceBypass and OnProgress apply when ForceDelete is set
+	// and the server does not support MinIO's force delete, see
+	// RemovePrefixOptions.
+	GovernanceBypass bool
+	OnProgress       func(removed, failed int)
 }
 
 // RemoveBucketWithOptions deletes the bucket name.
 //
 // All objects (including all object versions and delete markers)
 // in the bucket will be deleted forcibly if bucket options set
-// ForceDelete to 'true'.
+// ForceDelete to 'true'. Servers other than MinIO ignore the force
+// delete header, the objects are then removed with RemovePrefix
+// before the bucket is deleted again.
 func (c *Client) RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) error {
+	err := c.removeBucketWithOptions(ctx, bucketName, opts.ForceDelete)
+	if err == nil || !opts.ForceDelete || ToErrorResponse(err).Code != "BucketNotEmpty" {
+		return err
+	}
+
+	result, err := c.RemovePrefix(ctx, bucketName, "", RemovePrefixOptions{
+		AllVersions:      true,
+		GovernanceBypass: opts.GovernanceBypass,
+		OnProgress:       opts.OnProgress,
+	})
+	if err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return result.Failed[0].Err
+	}
+	return c.removeBucketWithOptions(ctx, bucketName, false)
+}
+
+func (c *Client) removeBucketWithOptions(ctx context.Context, bucketName string, forceDelete bool) error {
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
@@ -55,7 +83,7 @@ func (c *Client) RemoveBucketWithOptions(ctx context.Context, bucketName string,
 
 	// Build headers.
 	headers := make(http.Header)
-	if opts.ForceDelete {
+	if forceDelete {
 		headers.Set(minIOForceDelete, "true")
 	}
 
@@ -233,7 +261,7 @@ type RemoveObjectResult struct {
 }
 
 // generateRemoveMultiObjects - generate the XML request for remove multi objects request
-func generateRemoveMultiObjectsRequest(objects []ObjectInfo) []byte {
+func generateRemoveMultiObjectsRequest(objects []ObjectToDelete) []byte {
 	delObjects := []deleteObject{}
 	for _, obj := range objects {
 		delObjects = append(delObjects, deleteObject{
@@ -288,8 +316,27 @@ func processRemoveMultiObjectsResponse(body io.Reader, resultCh chan<- RemoveObj
 // RemoveObjectsOptions represents options specified by user for RemoveObjects call
 type RemoveObjectsOptions struct {
 	GovernanceBypass bool
+
+	// OnDeleted is called by RemoveObjects for every object removed
+	// successfully, the error channel only reports failures.
+	OnDeleted func(result RemoveObjectResult)
+}
+
+// ObjectToDelete identifies an object, or one of its versions, to be
+// removed by RemoveObjectsList.
+type ObjectToDelete struct {
+	Key       string
+	VersionID string
+
+	// GovernanceBypass removes the object even if it is locked in
+	// governance mode, objects are batched by this flag.
+	GovernanceBypass bool
 }
 
+// DeleteResult is the result of removing a single object with
+// RemoveObjectsList.
+type DeleteResult = RemoveObjectResult
+
 // RemoveObjects removes multiple objects from a bucket while
 // it is possible to specify objects versions which are received from
 // objectsCh. Remove failures are sent back via error channel.
@@ -314,12 +361,15 @@ func (c *Client) RemoveObjects(ctx context.Context, bucketName string, objectsCh
 	}
 
 	resultCh := make(chan RemoveObjectResult, 1)
-	go c.removeObjects(ctx, bucketName, objectsCh, resultCh, opts)
+	go c.removeObjects(ctx, bucketName, objectsToDelete(objectsCh), resultCh, opts)
 	go func() {
 		defer close(errorCh)
 		for res := range resultCh {
 			// Send only errors to the error channel
 			if res.Err == nil {
+				if opts.OnDeleted != nil {
+					opts.OnDeleted(res)
+				}
 				continue
 			}
 			errorCh <- RemoveObjectError{
@@ -333,6 +383,56 @@ func (c *Client) RemoveObjects(ctx context.Context, bucketName string, objectsCh
 	return errorCh
 }
 
+// RemoveObjectsList removes the given objects from a bucket and
+// returns the result for each of them, failures to remove an object
+// are reported in its result. The returned error is set only if the
+// request could not be made at all.
+func (c *Client) RemoveObjectsList(ctx context.Context, bucketName string, objects []ObjectToDelete, opts RemoveObjectsOptions) ([]DeleteResult, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+
+	objectsCh := make(chan ObjectToDelete, len(objects))
+	for _, object := range objects {
+		objectsCh <- object
+	}
+	close(objectsCh)
+
+	resultCh := make(chan RemoveObjectResult, 1)
+	go c.removeObjects(ctx, bucketName, objectsCh, resultCh, opts)
+
+	var (
+		results []DeleteResult
+		err     error
+	)
+	for res := range resultCh {
+		// Results without an object name are failures of the request.
+		if res.ObjectName == "" && res.Err != nil {
+			if err == nil {
+				err = res.Err
+			}
+			continue
+		}
+		results = append(results, res)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, ctx.Err()
+}
+
+// objectsToDelete converts a channel of ObjectInfo to ObjectToDelete.
+func objectsToDelete(objectsCh <-chan ObjectInfo) <-chan ObjectToDelete {
+	ch := make(chan ObjectToDelete, 1)
+	go func() {
+		defer close(ch)
+		for object := range objectsCh {
+			ch <- ObjectToDelete{Key: object.Key, VersionID: object.VersionID}
+		}
+	}()
+	return ch
+}
+
 // RemoveObjectsWithResult removes multiple objects from a bucket while
 // it is possible to specify objects versions which are received from
 // objectsCh. Remove results, successes and failures are sent back via
@@ -357,7 +457,7 @@ func (c *Client) RemoveObjectsWithResult(ctx context.Context, bucketName string,
 		return resultCh
 	}
 
-	go c.removeObjects(ctx, bucketName, objectsCh, resultCh, opts)
+	go c.removeObjects(ctx, bucketName, objectsToDelete(objectsCh), resultCh, opts)
 	return resultCh
 }
 
@@ -382,96 +482,96 @@ func hasInvalidXMLChar(str string) bool {
 }
 
 // Generate and call MultiDelete S3 requests based on entries received from objectsCh
-func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, resultCh chan<- RemoveObjectResult, opts RemoveObjectsOptions) {
-	maxEntries := 1000
-	finish := false
-	urlValues := make(url.Values)
-	urlValues.Set("delete", "")
+func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectToDelete, resultCh chan<- RemoveObjectResult, opts RemoveObjectsOptions) {
+	const maxEntries = 1000
 
 	// Close result channel when Multi delete finishes.
 	defer close(resultCh)
 
-	// Loop over entries by 1000 and call MultiDelete requests
-	for !finish {
-		count := 0
-		var batch []ObjectInfo
-
-		// Try to gather 1000 entries
-		for object := range objectsCh {
-			if hasInvalidXMLChar(object.Key) {
-				// Use single DELETE so the object name will be in the request URL instead of the multi-delete XML document.
-				removeResult := c.removeObject(ctx, bucketName, object.Key, RemoveObjectOptions{
-					VersionID:        object.VersionID,
-					GovernanceBypass: opts.GovernanceBypass,
-				})
-				if err := removeResult.Err; err != nil {
-					// Version does not exist is not an error ignore and continue.
-					switch ToErrorResponse(err).Code {
-					case "InvalidArgument", "NoSuchVersion":
-						continue
-					}
-					resultCh <- removeResult
+	// Governance bypass is a request header, so objects are batched
+	// by it: batches[1] holds the objects bypassing governance.
+	var batches [2][]ObjectToDelete
+	for object := range objectsCh {
+		bypass := object.GovernanceBypass || opts.GovernanceBypass
+		if hasInvalidXMLChar(object.Key) {
+			// Use single DELETE so the object name will be in the request URL instead of the multi-delete XML document.
+			removeResult := c.removeObject(ctx, bucketName, object.Key, RemoveObjectOptions{
+				VersionID:        object.VersionID,
+				GovernanceBypass: bypass,
+			})
+			if err := removeResult.Err; err != nil {
+				// Version does not exist is not an error ignore and continue.
+				switch ToErrorResponse(err).Code {
+				case "InvalidArgument", "NoSuchVersion":
+					continue
 				}
-
-				resultCh <- removeResult
-				continue
 			}
+			resultCh <- removeResult
+			continue
+		}
 
-			batch = append(batch, object)
-			if count++; count >= maxEntries {
-				break
-			}
+		i := 0
+		if bypass {
+			i = 1
 		}
-		if count == 0 {
-			// Multi Objects Delete API doesn't accept empty object list, quit immediately
-			break
+		batches[i] = append(batches[i], object)
+		if len(batches[i]) >= maxEntries {
+			c.removeObjectsBatch(ctx, bucketName, batches[i], bypass, resultCh)
+			batches[i] = nil
 		}
-		if count < maxEntries {
-			// We didn't have 1000 entries, so this is the last batch
-			finish = true
+	}
+	for i, batch := range batches {
+		if len(batch) > 0 {
+			// Multi Objects Delete API doesn't accept empty object list.
+			c.removeObjectsBatch(ctx, bucketName, batch, i == 1, resultCh)
 		}
+	}
+}
 
-		// Build headers.
-		headers := make(http.Header)
-		if opts.GovernanceBypass {
-			// Set the bypass goverenance retention header
-			headers.Set(amzBypassGovernance, "true")
-		}
+// removeObjectsBatch removes up to 1000 objects with a single MultiDelete request.
+func (c *Client) removeObjectsBatch(ctx context.Context, bucketName string, batch []ObjectToDelete, governanceBypass bool, resultCh chan<- RemoveObjectResult) {
+	urlValues := make(url.Values)
+	urlValues.Set("delete", "")
 
-		// Generate remove multi objects XML request
-		removeBytes := generateRemoveMultiObjectsRequest(batch)
-		// Execute POST on bucket to remove objects.
-		resp, err := c.executeMethod(ctx, http.MethodPost, requestMetadata{
-			bucketName:       bucketName,
-			queryValues:      urlValues,
-			contentBody:      bytes.NewReader(removeBytes),
-			contentLength:    int64(len(removeBytes)),
-			contentMD5Base64: sumMD5Base64(removeBytes),
-			contentSHA256Hex: sum256Hex(removeBytes),
-			customHeader:     headers,
-		})
-		if resp != nil {
-			if resp.StatusCode != http.StatusOK {
-				e := httpRespToErrorResponse(resp, bucketName, "")
-				resultCh <- RemoveObjectResult{ObjectName: "", Err: e}
-			}
+	// Build headers.
+	headers := make(http.Header)
+	if governanceBypass {
+		// Set the bypass goverenance retention header
+		headers.Set(amzBypassGovernance, "true")
+	}
+
+	// Generate remove multi objects XML request
+	removeBytes := generateRemoveMultiObjectsRequest(batch)
+	// Execute POST on bucket to remove objects.
+	resp, err := c.executeMethod(ctx, http.MethodPost, requestMetadata{
+		bucketName:       bucketName,
+		queryValues:      urlValues,
+		contentBody:      bytes.NewReader(removeBytes),
+		contentLength:    int64(len(removeBytes)),
+		contentMD5Base64: sumMD5Base64(removeBytes),
+		contentSHA256Hex: sum256Hex(removeBytes),
+		customHeader:     headers,
+	})
+	defer closeResponse(resp)
+	if resp != nil {
+		if resp.StatusCode != http.StatusOK {
+			e := httpRespToErrorResponse(resp, bucketName, "")
+			resultCh <- RemoveObjectResult{ObjectName: "", Err: e}
 		}
-		if err != nil {
-			for _, b := range batch {
-				resultCh <- RemoveObjectResult{
-					ObjectName:      b.Key,
-					ObjectVersionID: b.VersionID,
-					Err:             err,
-				}
+	}
+	if err != nil {
+		for _, b := range batch {
+			resultCh <- RemoveObjectResult{
+				ObjectName:      b.Key,
+				ObjectVersionID: b.VersionID,
+				Err:             err,
 			}
-			continue
 		}
-
-		// Process multiobjects remove xml response
-		processRemoveMultiObjectsResponse(resp.Body, resultCh)
-
-		closeResponse(resp)
+		return
 	}
+
+	// Process multiobjects remove xml response
+	processRemoveMultiObjectsResponse(resp.Body, resultCh)
 }
 
 // RemoveIncompleteUpload aborts an partially uploaded object.
@@ -0,0 +1,222 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestRemoveObjectsList(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Record the governance bypass header of each multi-delete request.
+	var (
+		mu       sync.Mutex
+		bypasses []string
+	)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Query().Has("delete") {
+			mu.Lock()
+			bypasses = append(bypasses, r.Header.Get(amzBypassGovernance))
+			mu.Unlock()
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions []string
+	for i := 0; i < 2; i++ {
+		info, err := clnt.PutObject(ctx, "bucket", "versioned", strings.NewReader("x"), 1, PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, info.VersionID)
+	}
+	putTestObjects(t, clnt, map[string]string{"a": "a", "b": "b"})
+
+	results, err := clnt.RemoveObjectsList(ctx, "bucket", []ObjectToDelete{
+		{Key: "versioned", VersionID: versions[0]},
+		{Key: "a", GovernanceBypass: true},
+		{Key: "b"},
+	}, RemoveObjectsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, res := range results {
+		if res.Err != nil {
+			t.Fatalf("unexpected error for %s: %v", res.ObjectName, res.Err)
+		}
+		keys = append(keys, res.ObjectName)
+	}
+	sort.Strings(keys)
+	if got := strings.Join(keys, ","); got != "a,b,versioned" {
+		t.Fatalf("unexpected results %s", got)
+	}
+	sort.Strings(bypasses)
+	if got := strings.Join(bypasses, ","); got != ",true" {
+		t.Fatalf("expected one batch with and one without governance bypass, got %q", got)
+	}
+
+	// Only the removed version is gone.
+	if _, err = clnt.StatObject(ctx, "bucket", "versioned", StatObjectOptions{VersionID: versions[0]}); err == nil {
+		t.Fatal("expected removed version to be gone")
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "versioned", StatObjectOptions{VersionID: versions[1]}); err != nil {
+		t.Fatal(err)
+	}
+
+	// RemoveObjects reports successes through OnDeleted.
+	objectsCh := make(chan ObjectInfo, 1)
+	objectsCh <- ObjectInfo{Key: "versioned", VersionID: versions[1]}
+	close(objectsCh)
+	var deleted []string
+	for rerr := range clnt.RemoveObjects(ctx, "bucket", objectsCh, RemoveObjectsOptions{
+		OnDeleted: func(res RemoveObjectResult) { deleted = append(deleted, res.ObjectVersionID) },
+	}) {
+		t.Fatal(rerr.Err)
+	}
+	if len(deleted) != 1 || deleted[0] != versions[1] {
+		t.Fatalf("unexpected deleted versions %v", deleted)
+	}
+}
+
+func TestRemovePrefix(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"dir/a": "a", "dir/sub/b": "b", "other": "o"})
+	putTestObjects(t, clnt, map[string]string{"dir/a": "a2"})
+
+	var calls int
+	res, err := clnt.RemovePrefix(ctx, "bucket", "dir/", RemovePrefixOptions{
+		AllVersions: true,
+		DryRun:      true,
+		OnProgress:  func(removed, failed int) { calls++ },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Removed != 3 || len(res.Objects) != 3 || calls != 3 {
+		t.Fatalf("unexpected dry-run result %+v, %d progress calls", res, calls)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "dir/a", StatObjectOptions{}); err != nil {
+		t.Fatalf("dry-run removed objects: %v", err)
+	}
+
+	res, err = clnt.RemovePrefix(ctx, "bucket", "dir/", RemovePrefixOptions{AllVersions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Removed != 3 || len(res.Failed) != 0 || res.Objects != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	var remaining []string
+	for info := range clnt.ListObjects(ctx, "bucket", ListObjectsOptions{Recursive: true, WithVersions: true}) {
+		if info.Err != nil {
+			t.Fatal(info.Err)
+		}
+		remaining = append(remaining, info.Key)
+	}
+	if got := strings.Join(remaining, ","); got != "other" {
+		t.Fatalf("unexpected remaining objects %s", got)
+	}
+}
+
+func TestRemoveBucketForceFallback(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Behave like a server without the force delete extension.
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.Header.Get(minIOForceDelete) == "true" {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`<Error><Code>BucketNotEmpty</Code><Message>The bucket you tried to delete is not empty</Message></Error>`))
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"a": "a", "dir/b": "b"})
+	if err = clnt.RemoveObject(ctx, "bucket", "a", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = clnt.RemoveBucket(ctx, "bucket"); ToErrorResponse(err).Code != "BucketNotEmpty" {
+		t.Fatalf("expected BucketNotEmpty, got %v", err)
+	}
+	var removed int
+	err = clnt.RemoveBucketWithOptions(ctx, "bucket", RemoveBucketOptions{
+		ForceDelete: true,
+		OnProgress:  func(n, _ int) { removed = n },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Two versions and a delete marker.
+	if removed != 3 {
+		t.Fatalf("expected 3 removed versions, got %d", removed)
+	}
+	found, err := clnt.BucketExists(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Fatal("expected bucket to be removed")
+	}
+}
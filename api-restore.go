@@ -21,8 +21,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 	"github.com/jie123108/minio-go/v7/pkg/tags"
@@ -180,3 +182,116 @@ func (c *Client) RestoreObject(ctx context.Context, bucketName, objectName, vers
 	}
 	return nil
 }
+
+// IsArchived returns true if the object is stored in an archive storage
+// class, i.e. GLACIER or DEEP_ARCHIVE, and can only be read once it was
+// restored with RestoreObject.
+func (o ObjectInfo) IsArchived() bool {
+	switch o.StorageClass {
+	case "GLACIER", "DEEP_ARCHIVE":
+		return true
+	}
+	return false
+}
+
+// IsRestored returns true if a restored copy of the object is available.
+func (o ObjectInfo) IsRestored() bool {
+	if o.Restore == nil || o.Restore.OngoingRestore {
+		return false
+	}
+	return o.Restore.ExpiryTime.IsZero() || time.Now().Before(o.Restore.ExpiryTime)
+}
+
+// IsObjectRestored returns true if the object data can be read, that is
+// the object is either not archived or a restored copy is available.
+func (c *Client) IsObjectRestored(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (bool, error) {
+	info, err := c.StatObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return false, err
+	}
+	return !info.IsArchived() || info.IsRestored(), nil
+}
+
+// ErrObjectArchived is returned by GetObject for objects which must be
+// restored with RestoreObject before they can be read. ToErrorResponse
+// returns the underlying InvalidObjectState error.
+type ErrObjectArchived struct {
+	ErrorResponse
+
+	// StorageClass of the object, e.g. GLACIER, empty if unknown.
+	StorageClass string
+	// Restore is the status of a requested restore, nil if none is
+	// in progress.
+	Restore *RestoreInfo
+}
+
+// Error returns the error message including the restore hint.
+func (e ErrObjectArchived) Error() string {
+	if e.Restore != nil && e.Restore.OngoingRestore {
+		return fmt.Sprintf("Object %s/%s is archived and its restore is still in progress", e.BucketName, e.Key)
+	}
+	if e.StorageClass != "" {
+		return fmt.Sprintf("Object %s/%s is archived in storage class %s, restore it with RestoreObject first", e.BucketName, e.Key, e.StorageClass)
+	}
+	return fmt.Sprintf("Object %s/%s is archived, restore it with RestoreObject first", e.BucketName, e.Key)
+}
+
+// Unwrap returns the underlying error response.
+func (e ErrObjectArchived) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// objectArchivedError returns the ErrObjectArchived for a GET failing
+// with InvalidObjectState, looking up the storage class and restore
+// status of the object.
+func (c *Client) objectArchivedError(ctx context.Context, bucketName, objectName string, opts GetObjectOptions, errResp ErrorResponse) error {
+	archived := ErrObjectArchived{ErrorResponse: errResp}
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{
+		VersionID:            opts.VersionID,
+		ServerSideEncryption: opts.ServerSideEncryption,
+	})
+	if err == nil {
+		archived.StorageClass = info.StorageClass
+		archived.Restore = info.Restore
+	}
+	return archived
+}
+
+// maxRestorePollInterval caps the backoff of WaitForRestore.
+const maxRestorePollInterval = 15 * time.Minute
+
+// WaitForRestore polls the object until its restore completed and
+// returns its info once it can be read. The interval between polls
+// starts at pollInterval, defaulting to one minute, and doubles up to
+// 15 minutes. An error is returned if no restore was requested.
+func (c *Client) WaitForRestore(ctx context.Context, bucketName, objectName, versionID string, pollInterval time.Duration) (ObjectInfo, error) {
+	if pollInterval <= 0 {
+		pollInterval = time.Minute
+	}
+	maxInterval := maxRestorePollInterval
+	if pollInterval > maxInterval {
+		maxInterval = pollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for interval := pollInterval; ; interval = min(2*interval, maxInterval) {
+		select {
+		case <-ctx.Done():
+			return ObjectInfo{}, ctx.Err()
+		case <-timer.C:
+		}
+
+		info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{VersionID: versionID})
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		if !info.IsArchived() || info.IsRestored() {
+			return info, nil
+		}
+		if info.Restore == nil || !info.Restore.OngoingRestore {
+			return ObjectInfo{}, errInvalidArgument(fmt.Sprintf("No restore of %s/%s is in progress.", bucketName, objectName))
+		}
+		timer.Reset(interval)
+	}
+}
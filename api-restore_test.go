@@ -0,0 +1,136 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRestoreArchivedObject(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"archived", "slow"} {
+		_, err := clnt.PutObject(ctx, "bucket", name, strings.NewReader("data"), 4, PutObjectOptions{StorageClass: "GLACIER"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	putTestObjects(t, clnt, map[string]string{"standard": "data"})
+
+	readAll := func(name string) (string, error) {
+		obj, err := clnt.GetObject(ctx, "bucket", name, GetObjectOptions{})
+		if err != nil {
+			return "", err
+		}
+		defer obj.Close()
+		data, err := io.ReadAll(obj)
+		return string(data), err
+	}
+
+	_, err := readAll("archived")
+	var archived ErrObjectArchived
+	if !errors.As(err, &archived) || archived.StorageClass != "GLACIER" || archived.Restore != nil {
+		t.Fatalf("expected archived object error, got %#v", err)
+	}
+	if ToErrorResponse(err).Code != "InvalidObjectState" {
+		t.Fatalf("expected InvalidObjectState, got %v", ToErrorResponse(err))
+	}
+	for name, want := range map[string]bool{"archived": false, "standard": true} {
+		restored, err := clnt.IsObjectRestored(ctx, "bucket", name, StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if restored != want {
+			t.Errorf("%s: expected restored %v", name, want)
+		}
+	}
+
+	req := RestoreRequest{}
+	req.SetDays(2)
+	if err = clnt.RestoreObject(ctx, "bucket", "archived", "", req); err != nil {
+		t.Fatal(err)
+	}
+	info, err := clnt.StatObject(ctx, "bucket", "archived", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.IsArchived() || !info.IsRestored() || info.Restore.ExpiryTime.Before(time.Now().Add(47*time.Hour)) {
+		t.Fatalf("unexpected restore status %+v", info.Restore)
+	}
+	if data, err := readAll("archived"); err != nil || data != "data" {
+		t.Fatalf("expected restored data, got %q, %v", data, err)
+	}
+
+	// Restores in progress are reported as such.
+	srv.RestoreDelay = time.Hour
+	if err = clnt.RestoreObject(ctx, "bucket", "slow", "", req); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RestoreObject(ctx, "bucket", "slow", "", req); ToErrorResponse(err).Code != "RestoreAlreadyInProgress" {
+		t.Fatalf("expected RestoreAlreadyInProgress, got %v", err)
+	}
+	_, err = readAll("slow")
+	if !errors.As(err, &archived) || archived.Restore == nil || !archived.Restore.OngoingRestore {
+		t.Fatalf("expected ongoing restore, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "in progress") {
+		t.Fatalf("unexpected message %q", err)
+	}
+}
+
+func TestWaitForRestore(t *testing.T) {
+	srv, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	srv.RestoreDelay = 50 * time.Millisecond
+
+	_, err := clnt.PutObject(ctx, "bucket", "archived", strings.NewReader("data"), 4, PutObjectOptions{StorageClass: "DEEP_ARCHIVE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.WaitForRestore(ctx, "bucket", "archived", "", time.Millisecond); ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected error without a restore, got %v", err)
+	}
+
+	req := RestoreRequest{}
+	req.SetDays(1)
+	if err = clnt.RestoreObject(ctx, "bucket", "archived", "", req); err != nil {
+		t.Fatal(err)
+	}
+
+	// Give up before the restore completes.
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err = clnt.WaitForRestore(shortCtx, "bucket", "archived", "", time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	start := time.Now()
+	info, err := clnt.WaitForRestore(ctx, "bucket", "archived", "", 5*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.IsRestored() || time.Since(start) > time.Second {
+		t.Fatalf("unexpected restore status %+v after %v", info.Restore, time.Since(start))
+	}
+}
@@ -0,0 +1,300 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ScopedClient is a handle on the objects under a prefix of a bucket,
+// e.g. the objects of one tenant handed to the request handlers of a
+// multi-tenant service. Its object APIs mirror those of Client, object
+// names are relative to the prefix and requests for other buckets, or
+// for object names with "." or ".." path segments, are refused with
+// an AccessDenied error before any request is made.
+//
+// The scope is enforced by the client only, as defense in depth along
+// with bucket and IAM policies, not as a replacement for them.
+type ScopedClient struct {
+	client *Client
+	bucket string
+	prefix string
+}
+
+// Scoped returns a ScopedClient restricted to the objects of the bucket
+// whose names start with the prefix. The prefix is used as is, usually
+// it ends with a "/".
+func Scoped(c *Client, bucketName, prefix string) *ScopedClient {
+	return &ScopedClient{
+		client: c,
+		bucket: bucketName,
+		prefix: prefix,
+	}
+}
+
+// Bucket returns the bucket of the scope.
+func (s *ScopedClient) Bucket() string {
+	return s.bucket
+}
+
+// Prefix returns the object name prefix of the scope.
+func (s *ScopedClient) Prefix() string {
+	return s.prefix
+}
+
+// errOutOfScope returns the error for requests outside of the scope.
+func (s *ScopedClient) errOutOfScope(bucketName, objectName string) error {
+	return ErrorResponse{
+		StatusCode: http.StatusForbidden,
+		Code:       "AccessDenied",
+		Message:    "Access outside of the scope " + s.bucket + "/" + s.prefix + " is denied.",
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
+// objectName returns the full object name of an object of the scope.
+func (s *ScopedClient) objectName(bucketName, objectName string) (string, error) {
+	if bucketName != s.bucket {
+		return "", s.errOutOfScope(bucketName, objectName)
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return "", err
+	}
+	// Some servers resolve dot segments, which would allow
+	// names leaving the prefix.
+	for _, segment := range strings.Split(objectName, "/") {
+		if segment == "." || segment == ".." {
+			return "", s.errOutOfScope(bucketName, objectName)
+		}
+	}
+	return s.prefix + objectName, nil
+}
+
+// relativeName returns the object name relative to the prefix.
+func (s *ScopedClient) relativeName(objectName string) string {
+	return strings.TrimPrefix(objectName, s.prefix)
+}
+
+// PutObject is like Client.PutObject for an object of the scope.
+func (s *ScopedClient) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64,
+	opts PutObjectOptions,
+) (UploadInfo, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	info, err := s.client.PutObject(ctx, bucketName, name, reader, objectSize, opts)
+	info.Key = s.relativeName(info.Key)
+	return info, err
+}
+
+// FPutObject is like Client.FPutObject for an object of the scope.
+func (s *ScopedClient) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (UploadInfo, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	info, err := s.client.FPutObject(ctx, bucketName, name, filePath, opts)
+	info.Key = s.relativeName(info.Key)
+	return info, err
+}
+
+// GetObject is like Client.GetObject for an object of the scope. The
+// ObjectInfo returned by Stat of the object holds its full name.
+func (s *ScopedClient) GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.GetObject(ctx, bucketName, name, opts)
+}
+
+// FGetObject is like Client.FGetObject for an object of the scope.
+func (s *ScopedClient) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	return s.client.FGetObject(ctx, bucketName, name, filePath, opts)
+}
+
+// StatObject is like Client.StatObject for an object of the scope.
+func (s *ScopedClient) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	info, err := s.client.StatObject(ctx, bucketName, name, opts)
+	info.Key = s.relativeName(info.Key)
+	return info, err
+}
+
+// RemoveObject is like Client.RemoveObject for an object of the scope.
+func (s *ScopedClient) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	return s.client.RemoveObject(ctx, bucketName, name, opts)
+}
+
+// RemoveObjects is like Client.RemoveObjects for objects of the scope.
+// Objects outside of the scope are not removed, but reported on the
+// error channel.
+func (s *ScopedClient) RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError {
+	errorCh := make(chan RemoveObjectError, 1)
+	if bucketName != s.bucket || objectsCh == nil {
+		defer close(errorCh)
+		err := s.errOutOfScope(bucketName, "")
+		if objectsCh == nil {
+			err = errInvalidArgument("Objects channel cannot be nil")
+		}
+		errorCh <- RemoveObjectError{Err: err}
+		return errorCh
+	}
+
+	if onDeleted := opts.OnDeleted; onDeleted != nil {
+		opts.OnDeleted = func(res RemoveObjectResult) {
+			res.ObjectName = s.relativeName(res.ObjectName)
+			onDeleted(res)
+		}
+	}
+
+	scopedCh := make(chan ObjectInfo)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer close(scopedCh)
+		for object := range objectsCh {
+			name, err := s.objectName(bucketName, object.Key)
+			if err != nil {
+				select {
+				case errorCh <- RemoveObjectError{ObjectName: object.Key, VersionID: object.VersionID, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			object.Key = name
+			select {
+			case scopedCh <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for rerr := range s.client.RemoveObjects(ctx, bucketName, scopedCh, opts) {
+			rerr.ObjectName = s.relativeName(rerr.ObjectName)
+			errorCh <- rerr
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(errorCh)
+	}()
+	return errorCh
+}
+
+// ListObjects is like Client.ListObjects for the objects of the scope,
+// opts.Prefix and opts.StartAfter are relative to the prefix of the
+// scope as are the names of the listed objects.
+func (s *ScopedClient) ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	objectCh := make(chan ObjectInfo, 1)
+	if bucketName != s.bucket {
+		defer close(objectCh)
+		objectCh <- ObjectInfo{Err: s.errOutOfScope(bucketName, "")}
+		return objectCh
+	}
+
+	opts.Prefix = s.prefix + opts.Prefix
+	if opts.StartAfter != "" {
+		opts.StartAfter = s.prefix + opts.StartAfter
+	}
+	go func() {
+		defer close(objectCh)
+		for object := range s.client.ListObjects(ctx, bucketName, opts) {
+			object.Key = s.relativeName(object.Key)
+			select {
+			case objectCh <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return objectCh
+}
+
+// CopyObject is like Client.CopyObject with source and destination
+// objects of the scope. Sources read by another client are refused.
+func (s *ScopedClient) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
+	if src.Client != nil && src.Client != s.client {
+		return UploadInfo{}, s.errOutOfScope(src.Bucket, src.Object)
+	}
+	var err error
+	if src.Object, err = s.objectName(src.Bucket, src.Object); err != nil {
+		return UploadInfo{}, err
+	}
+	if dst.Object, err = s.objectName(dst.Bucket, dst.Object); err != nil {
+		return UploadInfo{}, err
+	}
+	info, err := s.client.CopyObject(ctx, dst, src)
+	info.Key = s.relativeName(info.Key)
+	return info, err
+}
+
+// PresignedGetObject is like Client.PresignedGetObject for an object
+// of the scope.
+func (s *ScopedClient) PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.PresignedGetObject(ctx, bucketName, name, expires, reqParams)
+}
+
+// PresignedHeadObject is like Client.PresignedHeadObject for an object
+// of the scope.
+func (s *ScopedClient) PresignedHeadObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.PresignedHeadObject(ctx, bucketName, name, expires, reqParams)
+}
+
+// PresignedPutObject is like Client.PresignedPutObject for an object
+// of the scope.
+func (s *ScopedClient) PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (*url.URL, error) {
+	name, err := s.objectName(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.PresignedPutObject(ctx, bucketName, name, expires)
+}
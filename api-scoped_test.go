@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestScopedClient(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{
+		"tenant-a/doc.txt":     "a",
+		"tenant-a/dir/one.txt": "one",
+		"tenant-b/doc.txt":     "b",
+	})
+	ctx := context.Background()
+	scoped := Scoped(clnt, "bucket", "tenant-a/")
+
+	info, err := scoped.PutObject(ctx, "bucket", "new.txt", strings.NewReader("new"), 3, PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Key != "new.txt" {
+		t.Fatalf("expected relative key new.txt, got %s", info.Key)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "tenant-a/new.txt", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := scoped.GetObject(ctx, "bucket", "doc.txt", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || string(data) != "a" {
+		t.Fatalf("expected tenant-a/doc.txt, got %q, %v", data, err)
+	}
+
+	var names []string
+	for object := range scoped.ListObjects(ctx, "bucket", ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil {
+			t.Fatal(object.Err)
+		}
+		names = append(names, object.Key)
+	}
+	sort.Strings(names)
+	if expected := []string{"dir/one.txt", "doc.txt", "new.txt"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected objects %v, got %v", expected, names)
+	}
+
+	if _, err = scoped.CopyObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "copy.txt"}, CopySrcOptions{Bucket: "bucket", Object: "doc.txt"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "tenant-a/copy.txt", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests outside of the scope are refused.
+	outOfScope := []error{}
+	_, err = scoped.StatObject(ctx, "other", "doc.txt", StatObjectOptions{})
+	outOfScope = append(outOfScope, err)
+	_, err = scoped.StatObject(ctx, "bucket", "../tenant-b/doc.txt", StatObjectOptions{})
+	outOfScope = append(outOfScope, err)
+	_, err = scoped.PresignedGetObject(ctx, "bucket", "dir/./../../tenant-b/doc.txt", 0, nil)
+	outOfScope = append(outOfScope, err)
+	_, err = scoped.CopyObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "stolen.txt"}, CopySrcOptions{Bucket: "bucket", Object: "../tenant-b/doc.txt"})
+	outOfScope = append(outOfScope, err)
+	for object := range scoped.ListObjects(ctx, "other", ListObjectsOptions{}) {
+		outOfScope = append(outOfScope, object.Err)
+	}
+	for i, err := range outOfScope {
+		if ToErrorResponse(err).Code != "AccessDenied" {
+			t.Errorf("Test %d: expected AccessDenied, got %v", i+1, err)
+		}
+	}
+
+	objectsCh := make(chan ObjectInfo, 3)
+	objectsCh <- ObjectInfo{Key: "doc.txt"}
+	objectsCh <- ObjectInfo{Key: "../tenant-b/doc.txt"}
+	objectsCh <- ObjectInfo{Key: "new.txt"}
+	close(objectsCh)
+	var removeErrs []RemoveObjectError
+	for rerr := range scoped.RemoveObjects(ctx, "bucket", objectsCh, RemoveObjectsOptions{}) {
+		removeErrs = append(removeErrs, rerr)
+	}
+	if len(removeErrs) != 1 || removeErrs[0].ObjectName != "../tenant-b/doc.txt" || ToErrorResponse(removeErrs[0].Err).Code != "AccessDenied" {
+		t.Fatalf("expected only the out of scope object to fail, got %v", removeErrs)
+	}
+	for _, name := range []string{"tenant-a/doc.txt", "tenant-a/new.txt"} {
+		if _, err = clnt.StatObject(ctx, "bucket", name, StatObjectOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+			t.Errorf("expected %s to be removed, got %v", name, err)
+		}
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "tenant-b/doc.txt", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+)
+
+// ParallelSelectOptions configures SelectObjectContentParallel.
+type ParallelSelectOptions struct {
+	// NumWorkers is the number of ranges selected concurrently, 4 if
+	// zero.
+	NumWorkers int
+
+	// RangeSize is the size of the scan ranges, 64MiB if zero.
+	RangeSize int64
+}
+
+// ParallelSelectResults is the merged output of
+// SelectObjectContentParallel.
+type ParallelSelectResults struct {
+	pipeReader *io.PipeReader
+	cancel     context.CancelFunc
+
+	mu    sync.Mutex
+	stats StatsMessage
+}
+
+// Read reads the records of the ranges in the order of the object.
+func (r *ParallelSelectResults) Read(b []byte) (int, error) {
+	return r.pipeReader.Read(b)
+}
+
+// Close stops the selects of the ranges.
+func (r *ParallelSelectResults) Close() error {
+	r.cancel()
+	return r.pipeReader.Close()
+}
+
+// Stats returns the statistics summed over the ranges read so far,
+// i.e. of the whole select once all records were read.
+func (r *ParallelSelectResults) Stats() *StatsMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.stats
+	return &stats
+}
+
+// SelectObjectContentParallel runs SelectObjectContent on consecutive
+// scan ranges of an uncompressed CSV or JSON Lines object concurrently
+// and merges their records, which is much faster than a single select
+// over large objects. Every record is returned once, by the range it
+// starts in, and records are returned in the order of the object. The
+// output of at most popts.NumWorkers ranges is buffered in memory.
+//
+// Aggregate queries, e.g. COUNT(*), return one result per range.
+func (c *Client) SelectObjectContentParallel(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions, popts ParallelSelectOptions) (*ParallelSelectResults, error) {
+	if opts.ScanRange != nil {
+		return nil, errInvalidArgument("Scan ranges are set by SelectObjectContentParallel")
+	}
+	// Validate the options as they will be sent.
+	ranged := opts
+	ranged.ScanRange = &SelectScanRange{End: 1}
+	if err := ranged.validate(); err != nil {
+		return nil, err
+	}
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{ServerSideEncryption: opts.ServerSideEncryption})
+	if err != nil {
+		return nil, err
+	}
+	rangeSize := popts.RangeSize
+	if rangeSize <= 0 {
+		rangeSize = 64 << 20
+	}
+	workers := popts.NumWorkers
+	if workers <= 0 {
+		workers = 4
+	}
+	ranges := (info.Size + rangeSize - 1) / rangeSize
+
+	type rangeResult struct {
+		buf   bytes.Buffer
+		stats *StatsMessage
+		err   error
+	}
+	selectRange := func(ctx context.Context, i int64) *rangeResult {
+		o := opts
+		o.ScanRange = &SelectScanRange{}
+		o.ScanRange.SetStart(i * rangeSize)
+		o.ScanRange.SetEnd(min((i+1)*rangeSize, info.Size) - 1)
+		res := &rangeResult{}
+		results, err := c.SelectObjectContent(ctx, bucketName, objectName, o)
+		if err != nil {
+			res.err = err
+			return res
+		}
+		defer results.Close()
+		if _, res.err = io.Copy(&res.buf, results); res.err == nil {
+			res.stats = results.Stats()
+		}
+		return res
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pipeReader, pipeWriter := io.Pipe()
+	results := &ParallelSelectResults{pipeReader: pipeReader, cancel: cancel}
+	go func() {
+		defer cancel()
+		var (
+			pending []chan *rangeResult
+			next    int64
+		)
+		start := func() {
+			ch := make(chan *rangeResult, 1)
+			pending = append(pending, ch)
+			go func(i int64) { ch <- selectRange(ctx, i) }(next)
+			next++
+		}
+		for next < ranges && len(pending) < workers {
+			start()
+		}
+		for len(pending) > 0 {
+			res := <-pending[0]
+			pending = pending[1:]
+			if res.err != nil {
+				pipeWriter.CloseWithError(res.err)
+				return
+			}
+			if _, err := res.buf.WriteTo(pipeWriter); err != nil {
+				return
+			}
+			if res.stats != nil {
+				results.mu.Lock()
+				results.stats.BytesScanned += res.stats.BytesScanned
+				results.stats.BytesProcessed += res.stats.BytesProcessed
+				results.stats.BytesReturned += res.stats.BytesReturned
+				results.mu.Unlock()
+			}
+			if next < ranges {
+				start()
+			}
+		}
+		pipeWriter.Close()
+	}()
+	return results, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestSelectObjectContentParallel(t *testing.T) {
+	var lines []string
+	for i := range 100 {
+		lines = append(lines, fmt.Sprintf(`{"id":%d}`, i))
+	}
+	data := strings.Join(lines, "\n") + "\n"
+
+	srv, _ := newTestServerClient(t)
+	var selects atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !r.URL.Query().Has("select") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		selects.Add(1)
+		var req struct {
+			ScanRange struct{ Start, End int64 }
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, &req); err != nil {
+			t.Error(err)
+		}
+		// Records are returned by the range they start in.
+		var out strings.Builder
+		offset := int64(0)
+		for _, line := range lines {
+			if offset >= req.ScanRange.Start && offset <= req.ScanRange.End {
+				out.WriteString(line + "\n")
+			}
+			offset += int64(len(line)) + 1
+		}
+		w.Write(selectEvent("Records", []byte(out.String())))
+		n := req.ScanRange.End - req.ScanRange.Start + 1
+		w.Write(selectEvent("Stats", []byte(fmt.Sprintf("<Stats><BytesScanned>%d</BytesScanned><BytesReturned>%d</BytesReturned></Stats>", n, out.Len()))))
+		w.Write(selectEvent("End", nil))
+	}))
+	defer proxy.Close()
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = clnt.PutObject(ctx, "bucket", "data.jsonl", strings.NewReader(data), int64(len(data)), PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := SelectObjectOptions{
+		Expression:         "SELECT * FROM S3Object",
+		ExpressionType:     QueryExpressionTypeSQL,
+		InputSerialization: SelectObjectInputSerialization{JSON: &JSONInputOptions{Type: JSONLinesType}},
+		OutputSerialization: SelectObjectOutputSerialization{
+			JSON: &JSONOutputOptions{},
+		},
+	}
+	results, err := clnt.SelectObjectContentParallel(ctx, "bucket", "data.jsonl", opts, ParallelSelectOptions{NumWorkers: 3, RangeSize: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results.Close()
+	if string(got) != data {
+		t.Fatalf("unexpected records %q", got)
+	}
+	ranges := (int64(len(data)) + 99) / 100
+	if int64(selects.Load()) != ranges {
+		t.Fatalf("expected %d selects, got %d", ranges, selects.Load())
+	}
+	if stats := results.Stats(); stats.BytesScanned != int64(len(data)) || stats.BytesReturned != int64(len(data)) {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	// Compressed objects cannot be split.
+	opts.InputSerialization.CompressionType = SelectCompressionGZIP
+	if _, err = clnt.SelectObjectContentParallel(ctx, "bucket", "data.jsonl", opts, ParallelSelectOptions{}); err == nil {
+		t.Fatal("expected compressed input to fail")
+	}
+}
@@ -20,18 +20,15 @@ package minio
 import (
 	"bytes"
 	"context"
-	"encoding/binary"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"hash"
-	"hash/crc32"
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/eventstream"
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 )
 
@@ -90,7 +87,16 @@ const (
 	JSONLinesType    JSONType = "LINES"
 )
 
-// ParquetInputOptions parquet input specific options
+// Record delimiters of the CSV and JSON serializations. JSON output
+// delimited by newlines, the default, is JSON Lines.
+const (
+	SelectRecordDelimiterNewline = "\n"
+	SelectRecordDelimiterCRLF    = "\r\n"
+)
+
+// ParquetInputOptions parquet input specific options. Parquet objects
+// have no options, they must not be compressed as a whole, their
+// columns are compressed by the format.
 type ParquetInputOptions struct{}
 
 // CSVInputOptions csv input specific options
@@ -343,6 +349,27 @@ type SelectObjectInputSerialization struct {
 	JSON            *JSONInputOptions     `xml:"JSON,omitempty"`
 }
 
+// validate checks that exactly one format is set and that it can be
+// read with the compression type.
+func (i SelectObjectInputSerialization) validate() error {
+	formats := 0
+	for _, set := range []bool{i.Parquet != nil, i.CSV != nil, i.JSON != nil} {
+		if set {
+			formats++
+		}
+	}
+	if formats != 1 {
+		return errInvalidArgument("Exactly one of CSV, JSON or Parquet input serialization must be set")
+	}
+	if i.Parquet != nil && i.CompressionType != "" && i.CompressionType != SelectCompressionNONE {
+		return errInvalidArgument("Parquet input does not support compression type " + string(i.CompressionType))
+	}
+	if i.CSV != nil && i.CSV.recordDelimiterSet && !validRecordDelimiter(i.CSV.RecordDelimiter) {
+		return errInvalidArgument("Record delimiter must be one or two characters")
+	}
+	return nil
+}
+
 // SelectObjectOutputSerialization - output serialization parameters.
 type SelectObjectOutputSerialization struct {
 	CSV  *CSVOutputOptions  `xml:"CSV,omitempty"`
@@ -360,6 +387,99 @@ type SelectObjectOptions struct {
 	RequestProgress      struct {
 		Enabled bool
 	}
+	ScanRange *SelectScanRange `xml:"ScanRange,omitempty"`
+}
+
+// validate checks the options before they are sent.
+func (o SelectObjectOptions) validate() error {
+	in := o.InputSerialization
+	if err := in.validate(); err != nil {
+		return err
+	}
+	out := o.OutputSerialization
+	if out.CSV != nil && out.JSON != nil {
+		return errInvalidArgument("Only one of CSV or JSON output serialization can be set")
+	}
+	if (out.CSV != nil && out.CSV.recordDelimiterSet && !validRecordDelimiter(out.CSV.RecordDelimiter)) ||
+		(out.JSON != nil && out.JSON.recordDelimiterSet && !validRecordDelimiter(out.JSON.RecordDelimiter)) {
+		return errInvalidArgument("Record delimiter must be one or two characters")
+	}
+	if o.ScanRange != nil {
+		// Scan ranges split records at their delimiters, which
+		// requires uncompressed CSV or JSON Lines.
+		if in.Parquet != nil || (in.JSON != nil && in.JSON.Type != JSONLinesType) {
+			return errInvalidArgument("Scan ranges are only supported for CSV and JSON Lines input")
+		}
+		if in.CompressionType != "" && in.CompressionType != SelectCompressionNONE {
+			return errInvalidArgument("Scan ranges are not supported for compressed input")
+		}
+		return o.ScanRange.validate()
+	}
+	return nil
+}
+
+// validRecordDelimiter returns true if d is a valid record delimiter.
+func validRecordDelimiter(d string) bool {
+	n := len([]rune(d))
+	return n == 1 || n == 2
+}
+
+// SelectScanRange is the range of bytes of an object scanned by
+// SelectObjectContent. Records starting in the range are processed
+// in full, so ranges splitting an object can be queried in parallel.
+// Without Start the range is the last End bytes of the object, without
+// End it extends to the end of the object.
+type SelectScanRange struct {
+	Start    int64
+	End      int64
+	startSet bool
+	endSet   bool
+}
+
+// SetStart sets the first byte of the scan range.
+func (r *SelectScanRange) SetStart(start int64) {
+	r.Start = start
+	r.startSet = true
+}
+
+// SetEnd sets the last byte of the scan range, inclusive.
+func (r *SelectScanRange) SetEnd(end int64) {
+	r.End = end
+	r.endSet = true
+}
+
+func (r SelectScanRange) validate() error {
+	start, end := r.Start != 0 || r.startSet, r.End != 0 || r.endSet
+	switch {
+	case !start && !end:
+		return errInvalidArgument("Scan range requires a start or an end")
+	case r.Start < 0 || r.End < 0:
+		return errInvalidArgument("Scan range cannot be negative")
+	case start && end && r.End < r.Start:
+		return errInvalidArgument("Scan range end cannot be before its start")
+	}
+	return nil
+}
+
+// MarshalXML - produces the xml representation of the SelectScanRange struct
+func (r SelectScanRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if r.Start != 0 || r.startSet {
+		if err := e.EncodeElement(r.Start, xml.StartElement{Name: xml.Name{Local: "Start"}}); err != nil {
+			return err
+		}
+	}
+
+	if r.End != 0 || r.endSet {
+		if err := e.EncodeElement(r.End, xml.StartElement{Name: xml.Name{Local: "End"}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
 }
 
 // Header returns the http.Header representation of the SelectObject options.
@@ -382,13 +502,6 @@ const (
 	SelectObjectTypeParquet SelectObjectType = "Parquet"
 )
 
-// preludeInfo is used for keeping track of necessary information from the
-// prelude.
-type preludeInfo struct {
-	totalLen  uint32
-	headerLen uint32
-}
-
 // SelectResults is used for the streaming responses from the server.
 type SelectResults struct {
 	pipeReader *io.PipeReader
@@ -446,6 +559,9 @@ func (c *Client) SelectObjectContent(ctx context.Context, bucketName, objectName
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
 		return nil, err
 	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 
 	selectReqBytes, err := xml.Marshal(opts)
 	if err != nil {
@@ -517,239 +633,48 @@ func (s *SelectResults) Progress() *ProgressMessage {
 // several events that are sent through the eventstream.
 func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 	go func() {
+		defer closeResponse(s.resp)
+		dec := eventstream.NewDecoder(s.resp.Body)
 		for {
-			var prelude preludeInfo
-			headers := make(http.Header)
-			var err error
-
-			// Create CRC code
-			crc := crc32.New(crc32.IEEETable)
-			crcReader := io.TeeReader(s.resp.Body, crc)
-
-			// Extract the prelude(12 bytes) into a struct to extract relevant information.
-			prelude, err = processPrelude(crcReader, crc)
+			msg, err := dec.Decode()
 			if err != nil {
 				pipeWriter.CloseWithError(err)
-				closeResponse(s.resp)
 				return
 			}
 
-			// Extract the headers(variable bytes) into a struct to extract relevant information
-			if prelude.headerLen > 0 {
-				if err = extractHeader(io.LimitReader(crcReader, int64(prelude.headerLen)), headers); err != nil {
-					pipeWriter.CloseWithError(err)
-					closeResponse(s.resp)
-					return
-				}
-			}
-
-			// Get the actual payload length so that the appropriate amount of
-			// bytes can be read or parsed.
-			payloadLen := prelude.PayloadLen()
-
-			m := messageType(headers.Get("message-type"))
-
-			switch m {
+			switch messageType(msg.Headers.String(eventstream.MessageTypeHeader)) {
 			case errorMsg:
-				pipeWriter.CloseWithError(errors.New(headers.Get("error-code") + ":\"" + headers.Get("error-message") + "\""))
-				closeResponse(s.resp)
+				pipeWriter.CloseWithError(errors.New(msg.Headers.String(eventstream.ErrorCodeHeader) + ":\"" + msg.Headers.String(eventstream.ErrorMessageHeader) + "\""))
 				return
 			case commonMsg:
 				// Get content-type of the payload.
-				c := contentType(headers.Get("content-type"))
-
-				// Get event type of the payload.
-				e := eventType(headers.Get("event-type"))
+				c := contentType(msg.Headers.String(eventstream.ContentTypeHeader))
 
 				// Handle all supported events.
-				switch e {
+				switch e := eventType(msg.Headers.String(eventstream.EventTypeHeader)); e {
 				case endEvent:
 					pipeWriter.Close()
-					closeResponse(s.resp)
 					return
 				case recordsEvent:
-					if _, err = io.Copy(pipeWriter, io.LimitReader(crcReader, payloadLen)); err != nil {
+					if _, err = pipeWriter.Write(msg.Payload); err != nil {
 						pipeWriter.CloseWithError(err)
-						closeResponse(s.resp)
 						return
 					}
-				case progressEvent:
-					switch c {
-					case xmlContent:
-						if err = xmlDecoder(io.LimitReader(crcReader, payloadLen), s.progress); err != nil {
-							pipeWriter.CloseWithError(err)
-							closeResponse(s.resp)
-							return
-						}
-					default:
-						pipeWriter.CloseWithError(fmt.Errorf("Unexpected content-type %s sent for event-type %s", c, progressEvent))
-						closeResponse(s.resp)
+				case progressEvent, statsEvent:
+					if c != xmlContent {
+						pipeWriter.CloseWithError(fmt.Errorf("Unexpected content-type %s sent for event-type %s", c, e))
 						return
 					}
-				case statsEvent:
-					switch c {
-					case xmlContent:
-						if err = xmlDecoder(io.LimitReader(crcReader, payloadLen), s.stats); err != nil {
-							pipeWriter.CloseWithError(err)
-							closeResponse(s.resp)
-							return
-						}
-					default:
-						pipeWriter.CloseWithError(fmt.Errorf("Unexpected content-type %s sent for event-type %s", c, statsEvent))
-						closeResponse(s.resp)
+					var v interface{} = s.stats
+					if e == progressEvent {
+						v = s.progress
+					}
+					if err = xmlDecoder(bytes.NewReader(msg.Payload), v); err != nil {
+						pipeWriter.CloseWithError(err)
 						return
 					}
 				}
 			}
-
-			// Ensures that the full message's CRC is correct and
-			// that the message is not corrupted
-			if err := checkCRC(s.resp.Body, crc.Sum32()); err != nil {
-				pipeWriter.CloseWithError(err)
-				closeResponse(s.resp)
-				return
-			}
 		}
 	}()
 }
-
-// PayloadLen is a function that calculates the length of the payload.
-func (p preludeInfo) PayloadLen() int64 {
-	return int64(p.totalLen - p.headerLen - 16)
-}
-
-// processPrelude is the function that reads the 12 bytes of the prelude and
-// ensures the CRC is correct while also extracting relevant information into
-// the struct,
-func processPrelude(prelude io.Reader, crc hash.Hash32) (preludeInfo, error) {
-	var err error
-	pInfo := preludeInfo{}
-
-	// reads total length of the message (first 4 bytes)
-	pInfo.totalLen, err = extractUint32(prelude)
-	if err != nil {
-		return pInfo, err
-	}
-
-	// reads total header length of the message (2nd 4 bytes)
-	pInfo.headerLen, err = extractUint32(prelude)
-	if err != nil {
-		return pInfo, err
-	}
-
-	// checks that the CRC is correct (3rd 4 bytes)
-	preCRC := crc.Sum32()
-	if err := checkCRC(prelude, preCRC); err != nil {
-		return pInfo, err
-	}
-
-	return pInfo, nil
-}
-
-// extracts the relevant information from the Headers.
-func extractHeader(body io.Reader, myHeaders http.Header) error {
-	for {
-		// extracts the first part of the header,
-		headerTypeName, err := extractHeaderType(body)
-		if err != nil {
-			// Since end of file, we have read all of our headers
-			if err == io.EOF {
-				break
-			}
-			return err
-		}
-
-		// reads the 7 present in the header and ignores it.
-		extractUint8(body)
-
-		headerValueName, err := extractHeaderValue(body)
-		if err != nil {
-			return err
-		}
-
-		myHeaders.Set(headerTypeName, headerValueName)
-	}
-	return nil
-}
-
-// extractHeaderType extracts the first half of the header message, the header type.
-func extractHeaderType(body io.Reader) (string, error) {
-	// extracts 2 bit integer
-	headerNameLen, err := extractUint8(body)
-	if err != nil {
-		return "", err
-	}
-	// extracts the string with the appropriate number of bytes
-	headerName, err := extractString(body, int(headerNameLen))
-	if err != nil {
-		return "", err
-	}
-	return strings.TrimPrefix(headerName, ":"), nil
-}
-
-// extractsHeaderValue extracts the second half of the header message, the
-// header value
-func extractHeaderValue(body io.Reader) (string, error) {
-	bodyLen, err := extractUint16(body)
-	if err != nil {
-		return "", err
-	}
-	bodyName, err := extractString(body, int(bodyLen))
-	if err != nil {
-		return "", err
-	}
-	return bodyName, nil
-}
-
-// extracts a string from byte array of a particular number of bytes.
-func extractString(source io.Reader, lenBytes int) (string, error) {
-	myVal := make([]byte, lenBytes)
-	_, err := source.Read(myVal)
-	if err != nil {
-		return "", err
-	}
-	return string(myVal), nil
-}
-
-// extractUint32 extracts a 4 byte integer from the byte array.
-func extractUint32(r io.Reader) (uint32, error) {
-	buf := make([]byte, 4)
-	_, err := readFull(r, buf)
-	if err != nil {
-		return 0, err
-	}
-	return binary.BigEndian.Uint32(buf), nil
-}
-
-// extractUint16 extracts a 2 byte integer from the byte array.
-func extractUint16(r io.Reader) (uint16, error) {
-	buf := make([]byte, 2)
-	_, err := readFull(r, buf)
-	if err != nil {
-		return 0, err
-	}
-	return binary.BigEndian.Uint16(buf), nil
-}
-
-// extractUint8 extracts a 1 byte integer from the byte array.
-func extractUint8(r io.Reader) (uint8, error) {
-	buf := make([]byte, 1)
-	_, err := readFull(r, buf)
-	if err != nil {
-		return 0, err
-	}
-	return buf[0], nil
-}
-
-// checkCRC ensures that the CRC matches with the one from the reader.
-func checkCRC(r io.Reader, expect uint32) error {
-	msgCRC, err := extractUint32(r)
-	if err != nil {
-		return err
-	}
-
-	if msgCRC != expect {
-		return fmt.Errorf("Checksum Mismatch, MessageCRC of 0x%X does not equal expected CRC of 0x%X", msgCRC, expect)
-	}
-	return nil
-}
@@ -0,0 +1,94 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestSelectObjectOptionsXML(t *testing.T) {
+	opts := SelectObjectOptions{
+		Expression:     "select * from s3object",
+		ExpressionType: QueryExpressionTypeSQL,
+		InputSerialization: SelectObjectInputSerialization{
+			Parquet: &ParquetInputOptions{},
+		},
+		OutputSerialization: SelectObjectOutputSerialization{
+			JSON: &JSONOutputOptions{RecordDelimiter: SelectRecordDelimiterNewline},
+		},
+	}
+	if err := opts.validate(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := xml.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<Parquet></Parquet>", "<JSON><RecordDelimiter>&#xA;</RecordDelimiter></JSON>"} {
+		if !strings.Contains(string(b), want) {
+			t.Fatalf("expected %q in %s", want, b)
+		}
+	}
+	if strings.Contains(string(b), "ScanRange") {
+		t.Fatalf("unexpected scan range in %s", b)
+	}
+
+	opts.InputSerialization = SelectObjectInputSerialization{JSON: &JSONInputOptions{Type: JSONLinesType}}
+	opts.ScanRange = &SelectScanRange{}
+	opts.ScanRange.SetStart(0)
+	opts.ScanRange.SetEnd(1023)
+	if err = opts.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = xml.Marshal(opts); err != nil {
+		t.Fatal(err)
+	}
+	if want := "<ScanRange><Start>0</Start><End>1023</End></ScanRange></SelectObjectContentRequest>"; !strings.HasSuffix(string(b), want) {
+		t.Fatalf("expected %q in %s", want, b)
+	}
+}
+
+func TestSelectObjectOptionsValidate(t *testing.T) {
+	csv := SelectObjectInputSerialization{CSV: &CSVInputOptions{}}
+	testCases := []struct {
+		name string
+		opts SelectObjectOptions
+	}{
+		{"no input", SelectObjectOptions{}},
+		{"two inputs", SelectObjectOptions{InputSerialization: SelectObjectInputSerialization{CSV: &CSVInputOptions{}, JSON: &JSONInputOptions{}}}},
+		{"compressed parquet", SelectObjectOptions{InputSerialization: SelectObjectInputSerialization{Parquet: &ParquetInputOptions{}, CompressionType: SelectCompressionGZIP}}},
+		{"long delimiter", SelectObjectOptions{InputSerialization: csv, OutputSerialization: SelectObjectOutputSerialization{JSON: &JSONOutputOptions{RecordDelimiter: "abc", recordDelimiterSet: true}}}},
+		{"parquet range", SelectObjectOptions{InputSerialization: SelectObjectInputSerialization{Parquet: &ParquetInputOptions{}}, ScanRange: &SelectScanRange{End: 10}}},
+		{"document range", SelectObjectOptions{InputSerialization: SelectObjectInputSerialization{JSON: &JSONInputOptions{Type: JSONDocumentType}}, ScanRange: &SelectScanRange{End: 10}}},
+		{"compressed range", SelectObjectOptions{InputSerialization: SelectObjectInputSerialization{CSV: &CSVInputOptions{}, CompressionType: SelectCompressionGZIP}, ScanRange: &SelectScanRange{End: 10}}},
+		{"empty range", SelectObjectOptions{InputSerialization: csv, ScanRange: &SelectScanRange{}}},
+		{"reversed range", SelectObjectOptions{InputSerialization: csv, ScanRange: &SelectScanRange{Start: 10, End: 5}}},
+	}
+	for _, tc := range testCases {
+		if err := tc.opts.validate(); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+
+	// The last bytes of an object.
+	if err := (SelectObjectOptions{InputSerialization: csv, ScanRange: &SelectScanRange{End: 10}}).validate(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -19,7 +19,9 @@ package minio
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/jie123108/minio-go/v7/pkg/s3utils"
 )
@@ -56,6 +58,92 @@ func (c *Client) BucketExists(ctx context.Context, bucketName string) (bool, err
 	return true, nil
 }
 
+// BucketStatus is the result of BucketExistsDetailed.
+type BucketStatus struct {
+	// Exists is true if the bucket exists, whether it is
+	// accessible or not.
+	Exists bool
+	// Owned is true if the bucket is accessible with the
+	// credentials of the client. Existing buckets which are
+	// not, usually belong to another account.
+	Owned bool
+	// Region of the bucket if the server reported it, also
+	// for buckets which are not accessible.
+	Region string
+}
+
+// BucketExistsDetailed is like BucketExists, but distinguishes missing
+// buckets (404) from existing buckets which cannot be accessed (403) or
+// live in another region (301), instead of failing for the latter.
+func (c *Client) BucketExistsDetailed(ctx context.Context, bucketName string) (BucketStatus, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return BucketStatus{}, err
+	}
+
+	// Execute HEAD on bucketName.
+	resp, err := c.executeMethod(ctx, http.MethodHead, requestMetadata{
+		bucketName:       bucketName,
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		// The bucket location lookup may fail before the HEAD request.
+		switch errResp := ToErrorResponse(err); errResp.Code {
+		case "NoSuchBucket":
+			return BucketStatus{}, nil
+		case "AccessDenied":
+			return BucketStatus{Exists: true, Region: errResp.Region}, nil
+		}
+		return BucketStatus{}, err
+	}
+
+	status := BucketStatus{Region: resp.Header.Get(amzBucketRegion)}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		status.Exists, status.Owned = true, true
+	case http.StatusNotFound:
+		status.Region = ""
+	case http.StatusForbidden, http.StatusMovedPermanently:
+		status.Exists = true
+	default:
+		return BucketStatus{}, httpRespToErrorResponse(resp, bucketName, "")
+	}
+	return status, nil
+}
+
+// IsBucketEmpty returns true if the bucket has neither objects nor
+// object versions, including delete markers. Only a single key is
+// listed.
+func (c *Client) IsBucketEmpty(ctx context.Context, bucketName string) (bool, error) {
+	opts := ListObjectsOptions{WithVersions: true, Recursive: true, Limit: 1}
+	empty, err := c.isListingEmpty(ctx, bucketName, opts)
+	if ToErrorResponse(err).Code == "NotImplemented" {
+		// Servers without versioning support list objects only.
+		opts.WithVersions = false
+		empty, err = c.isListingEmpty(ctx, bucketName, opts)
+	}
+	return empty, err
+}
+
+func (c *Client) isListingEmpty(ctx context.Context, bucketName string, opts ListObjectsOptions) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	listCh := c.ListObjects(ctx, bucketName, opts)
+	defer func() {
+		// Drain the channel so the listing goroutine can exit.
+		cancel()
+		for range listCh {
+		}
+	}()
+	for info := range listCh {
+		if info.Err != nil {
+			return false, info.Err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
 // StatObject verifies if object exists, you have permission to access it
 // and returns information about the object.
 func (c *Client) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error) {
@@ -98,6 +186,9 @@ func (c *Client) StatObject(ctx context.Context, bucketName, objectName string,
 	if resp != nil {
 		deleteMarker := resp.Header.Get(amzDeleteMarker) == "true"
 		replicationReady := resp.Header.Get(minioTgtReplicationReady) == "true"
+		if resp.StatusCode == http.StatusNotModified {
+			return notModified(bucketName, objectName, resp)
+		}
 		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
 			if resp.StatusCode == http.StatusMethodNotAllowed && opts.VersionID != "" && deleteMarker {
 				errResp := ErrorResponse{
@@ -122,3 +213,56 @@ func (c *Client) StatObject(ctx context.Context, bucketName, objectName string,
 
 	return ToObjectInfo(bucketName, objectName, resp.Header)
 }
+
+// ObjectNotModified is returned by StatObject when an If-None-Match or
+// If-Modified-Since condition, e.g. set with SetMatchETagExcept or
+// SetModified, tells that the object was not modified. It carries the
+// validators returned by the server to refresh a cached copy, which
+// are also set in the ObjectInfo returned along.
+type ObjectNotModified struct {
+	ErrorResponse
+
+	ETag         string
+	LastModified time.Time
+	VersionID    string
+	Expires      time.Time
+	CacheControl string
+}
+
+// Error returns the error message naming the object.
+func (e ObjectNotModified) Error() string {
+	return fmt.Sprintf("Object %s/%s was not modified", e.BucketName, e.Key)
+}
+
+// Unwrap returns the underlying error response.
+func (e ObjectNotModified) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// notModified returns the validators of a 304 Not Modified response.
+func notModified(bucketName, objectName string, resp *http.Response) (ObjectInfo, error) {
+	e := ObjectNotModified{
+		ErrorResponse: ErrorResponse{
+			StatusCode: resp.StatusCode,
+			Code:       "NotModified",
+			Message:    "Not Modified",
+			BucketName: bucketName,
+			Key:        objectName,
+			RequestID:  resp.Header.Get("x-amz-request-id"),
+			HostID:     resp.Header.Get("x-amz-id-2"),
+			Server:     resp.Header.Get("Server"),
+		},
+		ETag:         trimEtag(resp.Header.Get("ETag")),
+		VersionID:    resp.Header.Get(amzVersionID),
+		CacheControl: resp.Header.Get("Cache-Control"),
+	}
+	e.LastModified, _ = parseRFC7231Time(resp.Header.Get("Last-Modified"))
+	e.Expires, _ = parseRFC7231Time(resp.Header.Get("Expires"))
+	return ObjectInfo{
+		Key:          objectName,
+		ETag:         e.ETag,
+		LastModified: e.LastModified,
+		VersionID:    e.VersionID,
+		Expires:      e.Expires,
+	}, e
+}
@@ -0,0 +1,237 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestBucketExistsDetailed(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Answer HEAD requests for some buckets the way S3 does for
+	// buckets of other accounts or regions.
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			switch strings.Trim(r.URL.Path, "/") {
+			case "foreign":
+				w.Header().Set(amzBucketRegion, "eu-west-1")
+				w.WriteHeader(http.StatusForbidden)
+				return
+			case "elsewhere":
+				w.Header().Set(amzBucketRegion, "ap-south-1")
+				w.WriteHeader(http.StatusMovedPermanently)
+				return
+			}
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		bucket string
+		want   BucketStatus
+	}{
+		{"bucket", BucketStatus{Exists: true, Owned: true, Region: srv.Region}},
+		{"missing", BucketStatus{}},
+		{"foreign", BucketStatus{Exists: true, Region: "eu-west-1"}},
+		{"elsewhere", BucketStatus{Exists: true, Region: "ap-south-1"}},
+	}
+	for i, tc := range testCases {
+		got, err := clnt.BucketExistsDetailed(ctx, tc.bucket)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if got != tc.want {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestIsBucketEmpty(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	empty, err := clnt.IsBucketEmpty(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !empty {
+		t.Fatal("expected new bucket to be empty")
+	}
+
+	// A delete marker alone keeps a versioned bucket from being empty.
+	if err = clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"a": "a", "b": "b"})
+	info, err := clnt.StatObject(ctx, "bucket", "a", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RemoveObject(ctx, "bucket", "a", RemoveObjectOptions{VersionID: info.VersionID}); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RemoveObject(ctx, "bucket", "b", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	empty, err = clnt.IsBucketEmpty(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty {
+		t.Fatal("expected bucket with versions not to be empty")
+	}
+
+	if _, err = clnt.IsBucketEmpty(ctx, "missing"); ToErrorResponse(err).Code != "NoSuchBucket" {
+		t.Fatalf("expected NoSuchBucket, got %v", err)
+	}
+}
+
+func TestStatObjectLock(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	until := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	_, err := clnt.PutObject(ctx, "bucket", "locked", strings.NewReader("data"), 4, PutObjectOptions{
+		Mode:            Compliance,
+		RetainUntilDate: until,
+		LegalHold:       LegalHoldEnabled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	putTestObjects(t, clnt, map[string]string{"plain": "data"})
+
+	info, err := clnt.StatObject(ctx, "bucket", "locked", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock := info.ObjectLock
+	if lock.Mode != Compliance || !lock.RetainUntilDate.Equal(until) || lock.LegalHold != LegalHoldEnabled {
+		t.Fatalf("unexpected object lock %+v", lock)
+	}
+	if d := lock.RemainingRetention(); d <= 47*time.Hour || d > 48*time.Hour || !lock.IsLocked() {
+		t.Fatalf("unexpected remaining retention %v", d)
+	}
+
+	info, err = clnt.StatObject(ctx, "bucket", "plain", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ObjectLock != (ObjectLockInfo{}) || info.ObjectLock.RemainingRetention() != 0 || info.ObjectLock.IsLocked() {
+		t.Fatalf("expected no object lock, got %+v", info.ObjectLock)
+	}
+
+	// Expired retention does not lock the object anymore.
+	expired := ObjectLockInfo{Mode: Governance, RetainUntilDate: time.Now().Add(-time.Hour)}
+	if expired.RemainingRetention() != 0 || expired.IsLocked() {
+		t.Fatalf("expected expired retention, got %+v", expired)
+	}
+}
+
+func TestResponseHeader(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	up, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{
+		UserMetadata: map[string]string{"color": "red"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up.ResponseHeader().Get(amzVersionID) != up.VersionID || up.ResponseHeader().Get("Date") != "" {
+		t.Fatalf("unexpected upload response header %v", up.ResponseHeader())
+	}
+	// UploadInfo stays comparable.
+	if up == (UploadInfo{}) {
+		t.Fatal("unexpected empty upload info")
+	}
+
+	info, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := info.ResponseHeader
+	if h.Get("X-Amz-Meta-Color") != "red" || h.Get(amzVersionID) != up.VersionID || h.Get("Content-Length") != "" {
+		t.Fatalf("unexpected stat response header %v", h)
+	}
+}
+
+func TestStatObjectNotModified(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"obj": "cached"})
+	ctx := context.Background()
+
+	info, err := clnt.StatObject(ctx, "bucket", "obj", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, set := range []func(*StatObjectOptions) error{
+		func(opts *StatObjectOptions) error { return opts.SetMatchETagExcept(info.ETag) },
+		func(opts *StatObjectOptions) error { return opts.SetModified(info.LastModified) },
+	} {
+		opts := StatObjectOptions{}
+		if err = set(&opts); err != nil {
+			t.Fatal(err)
+		}
+		validators, err := clnt.StatObject(ctx, "bucket", "obj", opts)
+		var notModified ObjectNotModified
+		if !errors.As(err, &notModified) {
+			t.Fatalf("Test %d: expected ObjectNotModified, got %v", i+1, err)
+		}
+		if notModified.ETag != info.ETag || !notModified.LastModified.Equal(info.LastModified) || ToErrorResponse(err).StatusCode != http.StatusNotModified {
+			t.Fatalf("Test %d: unexpected validators %+v", i+1, notModified)
+		}
+		if validators.ETag != info.ETag || !validators.LastModified.Equal(info.LastModified) {
+			t.Fatalf("Test %d: unexpected object info %+v", i+1, validators)
+		}
+	}
+
+	// Modified objects are returned as usual.
+	opts := StatObjectOptions{}
+	opts.SetMatchETagExcept("0123456789abcdef0123456789abcdef")
+	if _, err = clnt.StatObject(ctx, "bucket", "obj", opts); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// RemoteTierType is the kind of storage of a remote tier.
+type RemoteTierType string
+
+// Kinds of storage of remote tiers.
+const (
+	RemoteTierS3    RemoteTierType = "s3"
+	RemoteTierAzure RemoteTierType = "azure"
+	RemoteTierGCS   RemoteTierType = "gcs"
+	RemoteTierMinIO RemoteTierType = "minio"
+)
+
+// tierConfigVersion is the version of the tier configuration format.
+const tierConfigVersion = "v1"
+
+// TierS3 is a remote tier on AWS S3 or an S3 compatible service.
+// Either AccessKey and SecretKey or AWSRole must be set.
+type TierS3 struct {
+	Name         string `json:",omitempty"`
+	Endpoint     string `json:",omitempty"`
+	AccessKey    string `json:",omitempty"`
+	SecretKey    string `json:",omitempty"`
+	Bucket       string `json:",omitempty"`
+	Prefix       string `json:",omitempty"`
+	Region       string `json:",omitempty"`
+	StorageClass string `json:",omitempty"`
+
+	// AWSRole uses the credentials of the environment of the MinIO
+	// server, e.g. its EC2 instance profile or web identity.
+	AWSRole                     bool   `json:",omitempty"`
+	AWSRoleWebIdentityTokenFile string `json:",omitempty"`
+	AWSRoleARN                  string `json:",omitempty"`
+	AWSRoleSessionName          string `json:",omitempty"`
+	AWSRoleDurationSeconds      int    `json:",omitempty"`
+}
+
+// ServicePrincipalAuth are the Azure service principal credentials of
+// a remote tier on Azure.
+type ServicePrincipalAuth struct {
+	TenantID     string `json:",omitempty"`
+	ClientID     string `json:",omitempty"`
+	ClientSecret string `json:",omitempty"`
+}
+
+// TierAzure is a remote tier on Azure Blob Storage, with a storage
+// account key or a service principal.
+type TierAzure struct {
+	Name         string               `json:",omitempty"`
+	Endpoint     string               `json:",omitempty"`
+	AccountName  string               `json:",omitempty"`
+	AccountKey   string               `json:",omitempty"`
+	Bucket       string               `json:",omitempty"`
+	Prefix       string               `json:",omitempty"`
+	Region       string               `json:",omitempty"`
+	StorageClass string               `json:",omitempty"`
+	SPAuth       ServicePrincipalAuth `json:",omitempty"`
+}
+
+// TierGCS is a remote tier on Google Cloud Storage.
+type TierGCS struct {
+	Name     string `json:",omitempty"`
+	Endpoint string `json:",omitempty"`
+	// Creds is the JSON service account key file, base64 encoded with
+	// the URL encoding.
+	Creds        string `json:",omitempty"`
+	Bucket       string `json:",omitempty"`
+	Prefix       string `json:",omitempty"`
+	Region       string `json:",omitempty"`
+	StorageClass string `json:",omitempty"`
+}
+
+// TierMinIO is a remote tier on another MinIO deployment.
+type TierMinIO struct {
+	Name      string `json:",omitempty"`
+	Endpoint  string `json:",omitempty"`
+	AccessKey string `json:",omitempty"`
+	SecretKey string `json:",omitempty"`
+	Bucket    string `json:",omitempty"`
+	Prefix    string `json:",omitempty"`
+	Region    string `json:",omitempty"`
+}
+
+// TierConfig is the configuration of a remote tier of MinIO, the
+// target of lifecycle transitions with the name of the tier as
+// storage class. The target matching Type must be set.
+type TierConfig struct {
+	Version string         `json:",omitempty"`
+	Type    RemoteTierType `json:",omitempty"`
+	Name    string         `json:",omitempty"`
+	S3      *TierS3        `json:",omitempty"`
+	Azure   *TierAzure     `json:",omitempty"`
+	GCS     *TierGCS       `json:",omitempty"`
+	MinIO   *TierMinIO     `json:",omitempty"`
+}
+
+// validate checks the configuration and sets the name of its target.
+func (cfg *TierConfig) validate() error {
+	if cfg.Name == "" || cfg.Name != strings.ToUpper(cfg.Name) {
+		return errInvalidArgument("Tier name must be set and in uppercase.")
+	}
+	var ok bool
+	switch cfg.Type {
+	case RemoteTierS3:
+		if ok = cfg.S3 != nil; ok {
+			cfg.S3.Name = cfg.Name
+		}
+	case RemoteTierAzure:
+		if ok = cfg.Azure != nil; ok {
+			cfg.Azure.Name = cfg.Name
+		}
+	case RemoteTierGCS:
+		if ok = cfg.GCS != nil; ok {
+			cfg.GCS.Name = cfg.Name
+		}
+	case RemoteTierMinIO:
+		if ok = cfg.MinIO != nil; ok {
+			cfg.MinIO.Name = cfg.Name
+		}
+	default:
+		return errInvalidArgument("Unsupported tier type " + string(cfg.Type) + ".")
+	}
+	if !ok {
+		return errInvalidArgument("Tier " + cfg.Name + " has no " + string(cfg.Type) + " configuration.")
+	}
+	if cfg.Version == "" {
+		cfg.Version = tierConfigVersion
+	}
+	return nil
+}
+
+// TierCreds are the new credentials of a remote tier, see EditTier.
+type TierCreds struct {
+	AccessKey string `json:"access,omitempty"`
+	SecretKey string `json:"secret,omitempty"`
+
+	AWSRole                     bool   `json:"awsrole"`
+	AWSRoleWebIdentityTokenFile string `json:"awsroleWebIdentity,omitempty"`
+	AWSRoleARN                  string `json:"awsroleARN,omitempty"`
+
+	AzSP ServicePrincipalAuth `json:"azSP,omitempty"`
+
+	// CredsJSON is the JSON service account key file of GCS tiers.
+	CredsJSON []byte `json:"creds,omitempty"`
+}
+
+// executeTierMethod sends a tier admin API request, encrypting the
+// JSON encoded body, which carries credentials, with the secret key
+// of the client.
+func (c *Client) executeTierMethod(ctx context.Context, method, path string, body interface{}, v interface{}) error {
+	var data []byte
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		creds, err := c.GetCreds()
+		if err != nil {
+			return err
+		}
+		if data, err = encryptAdminData(creds.SecretAccessKey, buf); err != nil {
+			return err
+		}
+	}
+	return c.executeAdminMethod(ctx, method, path, nil, data, v)
+}
+
+// AddTier adds a remote tier. This is a MinIO extension of the admin
+// API and requires admin credentials.
+func (c *Client) AddTier(ctx context.Context, cfg TierConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	return c.executeTierMethod(ctx, http.MethodPut, "tier", cfg, nil)
+}
+
+// ListTiers returns the remote tiers, without their secrets.
+func (c *Client) ListTiers(ctx context.Context) ([]TierConfig, error) {
+	var tiers []TierConfig
+	if err := c.executeAdminMethod(ctx, http.MethodGet, "tier", nil, nil, &tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// EditTier replaces the credentials of a remote tier, e.g. after they
+// were rotated.
+func (c *Client) EditTier(ctx context.Context, tierName string, creds TierCreds) error {
+	if tierName == "" {
+		return errInvalidArgument("Tier name cannot be empty.")
+	}
+	return c.executeTierMethod(ctx, http.MethodPost, "tier/"+tierName, creds, nil)
+}
+
+// RemoveTier removes a remote tier. MinIO refuses to remove tiers
+// holding transitioned objects.
+func (c *Client) RemoveTier(ctx context.Context, tierName string) error {
+	if tierName == "" {
+		return errInvalidArgument("Tier name cannot be empty.")
+	}
+	return c.executeAdminMethod(ctx, http.MethodDelete, "tier/"+tierName, nil, nil, nil)
+}
+
+// VerifyTier checks that MinIO can reach the remote tier with its
+// credentials.
+func (c *Client) VerifyTier(ctx context.Context, tierName string) error {
+	if tierName == "" {
+		return errInvalidArgument("Tier name cannot be empty.")
+	}
+	return c.executeAdminMethod(ctx, http.MethodGet, "tier/"+tierName, nil, nil, nil)
+}
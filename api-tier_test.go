@@ -0,0 +1,126 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTiers(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	tiers, err := clnt.ListTiers(ctx)
+	if err != nil || len(tiers) != 0 {
+		t.Fatalf("expected no tiers, got %v, %v", tiers, err)
+	}
+
+	// Payloads longer than an encryption fragment are split.
+	credsJSON := []byte(`{"type":"service_account","private_key":"` + strings.Repeat("k", 20<<10) + `"}`)
+	for _, cfg := range []TierConfig{
+		{Name: "WARM", Type: RemoteTierS3, S3: &TierS3{Endpoint: "https://s3.amazonaws.com", AccessKey: "access", SecretKey: "secret", Bucket: "warm", Region: "us-east-1"}},
+		{Name: "COLD", Type: RemoteTierGCS, GCS: &TierGCS{Creds: base64.URLEncoding.EncodeToString(credsJSON), Bucket: "cold"}},
+	} {
+		if err = clnt.AddTier(ctx, cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = clnt.AddTier(ctx, TierConfig{Name: "WARM", Type: RemoteTierMinIO, MinIO: &TierMinIO{Endpoint: "https://minio:9000", Bucket: "warm"}})
+	if ToErrorResponse(err).Code != "XMinioAdminTierAlreadyExists" {
+		t.Fatalf("expected XMinioAdminTierAlreadyExists, got %v", err)
+	}
+	for i, cfg := range []TierConfig{
+		{Name: "warm", Type: RemoteTierS3, S3: &TierS3{}},
+		{Name: "AZ", Type: RemoteTierAzure, S3: &TierS3{}},
+		{Name: "OTHER", Type: "ftp"},
+	} {
+		if err = clnt.AddTier(ctx, cfg); ToErrorResponse(err).Code != "InvalidArgument" {
+			t.Errorf("Test %d: expected InvalidArgument, got %v", i+1, err)
+		}
+	}
+
+	tiers, err = clnt.ListTiers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].Name < tiers[j].Name })
+	if len(tiers) != 2 || tiers[1].Name != "WARM" || tiers[1].S3 == nil || tiers[1].S3.Bucket != "warm" || tiers[1].S3.Name != "WARM" {
+		t.Fatalf("unexpected tiers %+v", tiers)
+	}
+	if tiers[1].S3.SecretKey != "REDACTED" || tiers[0].GCS == nil || tiers[0].GCS.Creds != "REDACTED" {
+		t.Fatalf("expected secrets to be redacted, got %+v %+v", tiers[0].GCS, tiers[1].S3)
+	}
+
+	if err = clnt.EditTier(ctx, "WARM", TierCreds{AccessKey: "rotated", SecretKey: "rotated-secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if tiers, err = clnt.ListTiers(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, tier := range tiers {
+		if tier.Name == "WARM" && tier.S3.AccessKey != "rotated" {
+			t.Fatalf("expected rotated access key, got %+v", tier.S3)
+		}
+	}
+
+	if err = clnt.VerifyTier(ctx, "WARM"); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RemoveTier(ctx, "WARM"); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.VerifyTier(ctx, "WARM"); ToErrorResponse(err).Code != "XMinioAdminTierNotFound" {
+		t.Fatalf("expected XMinioAdminTierNotFound, got %v", err)
+	}
+}
+
+func TestEncryptAdminData(t *testing.T) {
+	// SHA-256 of the payloads encrypted by sio-go, as done by the
+	// EncryptData function of madmin, with the same salt and nonce.
+	testCases := []struct {
+		size int
+		sum  string
+	}{
+		{0, "062b0c903f958cf82b7cebbe243349f53b7296ff55d06ff9394ac1a4f3d29c92"},
+		{1, "54156eeaf0347f735c3e079e5756500089efa294919e7583ae7d10a6bc88bc4c"},
+		{16384, "45596948566614221b8130fac163486fd742d32ecb2e20eaf838aa009c911ff1"},
+		{16385, "4853acf945e3d1e3dee5bd44482d7edd9c787ec1668bdc18126f0a0cfbe6392d"},
+		{40000, "04dece0e0a6700e3a7047993f500ee18b7cad3db3e50a5bcbe561332891c427b"},
+	}
+	for i, testCase := range testCases {
+		header := make([]byte, adminSaltSize+1+adminNonceSize)
+		for j := range header {
+			header[j] = byte(j)
+		}
+		data := make([]byte, testCase.size)
+		for j := range data {
+			data[j] = byte(j % 251)
+		}
+		ciphertext, err := sealAdminData("secret", header, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sum := sum256Hex(ciphertext); sum != testCase.sum {
+			t.Errorf("Test %d: expected SHA-256 %s, got %s", i+1, testCase.sum, sum)
+		}
+	}
+}
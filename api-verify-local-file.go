@@ -0,0 +1,168 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jie123108/minio-go/v7/pkg/etag"
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// ErrLocalFileMismatch is returned by VerifyLocalFile if the local file
+// differs from the object.
+type ErrLocalFileMismatch struct {
+	BucketName string
+	ObjectName string
+	FilePath   string
+
+	// Property which differs, "size", "ETag" or the name of a checksum
+	// like "CRC32C".
+	Property string
+	Object   string
+	Local    string
+}
+
+// Error returns the error message naming the property which differs.
+func (e ErrLocalFileMismatch) Error() string {
+	return fmt.Sprintf("Local file %s does not match object %s/%s: %s is %s, expected %s",
+		e.FilePath, e.BucketName, e.ObjectName, e.Property, e.Local, e.Object)
+}
+
+// VerifyLocalFile verifies the local file has the content of the object,
+// e.g. to verify backups, without downloading it. The sizes, the ETags,
+// unless the object is encrypted with SSE-C or SSE-KMS, and the
+// checksums of the object are compared, reading the file once. The
+// ETags and composite checksums of multipart objects are computed with
+// the part boundaries listed by ObjectParts. ErrLocalFileMismatch is
+// returned if the file differs.
+func (c *Client) VerifyLocalFile(ctx context.Context, bucketName, objectName, filePath string) error {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return err
+	}
+
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{Checksum: true})
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	mismatch := func(property, object, local string) error {
+		return ErrLocalFileMismatch{
+			BucketName: bucketName,
+			ObjectName: objectName,
+			FilePath:   filePath,
+			Property:   property,
+			Object:     object,
+			Local:      local,
+		}
+	}
+	if st.Size() != info.Size {
+		return mismatch("size", strconv.FormatInt(info.Size, 10), strconv.FormatInt(st.Size(), 10))
+	}
+
+	checksums := objectChecksums(info)
+	composite := false
+	for _, checksum := range checksums {
+		composite = composite || strings.Contains(checksum, "-")
+	}
+
+	// The parts are only needed for multipart ETags and composite checksums.
+	parts := etag.Parts(info.ETag)
+	partSizes := []int64{info.Size}
+	if parts > 0 || composite {
+		objectParts, err := c.ObjectParts(ctx, bucketName, objectName, GetObjectOptions{VersionID: info.VersionID})
+		if err != nil {
+			return err
+		}
+		if parts > 0 && len(objectParts) != parts {
+			return fmt.Errorf("Object %s/%s has %d parts, %d listed", bucketName, objectName, parts, len(objectParts))
+		}
+		partSizes = partSizes[:0]
+		for _, part := range objectParts {
+			partSizes = append(partSizes, part.Size)
+		}
+	}
+
+	full := make(map[ChecksumType]hash.Hash, len(checksums))
+	partHashers := make(map[ChecksumType]hash.Hash, len(checksums))
+	partSums := make(map[ChecksumType][]byte, len(checksums))
+	for t := range checksums {
+		full[t], partHashers[t] = t.Hasher(), t.Hasher()
+	}
+	partETags := make([]string, 0, len(partSizes))
+	for _, size := range partSizes {
+		partMD5 := md5.New()
+		writers := []io.Writer{partMD5}
+		for t := range checksums {
+			partHashers[t].Reset()
+			writers = append(writers, full[t], partHashers[t])
+		}
+		if _, err = io.CopyN(io.MultiWriter(writers...), f, size); err != nil {
+			return err
+		}
+		partETags = append(partETags, hex.EncodeToString(partMD5.Sum(nil)))
+		for t := range checksums {
+			partSums[t] = partHashers[t].Sum(partSums[t])
+		}
+	}
+
+	if !encryptedETag(info) {
+		localETag := partETags[0]
+		if parts > 0 {
+			if localETag, err = etag.Multipart(partETags); err != nil {
+				return err
+			}
+		}
+		if !etag.Equal(info.ETag, localETag) {
+			return mismatch("ETag", info.ETag, localETag)
+		}
+	}
+
+	for t, checksum := range checksums {
+		local := t.EncodeToString(full[t].Sum(nil))
+		if strings.Contains(checksum, "-") {
+			h := t.Hasher()
+			h.Write(partSums[t])
+			local = t.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(partSizes))
+		}
+		if checksum != local {
+			return mismatch(t.String(), checksum, local)
+		}
+	}
+	return nil
+}
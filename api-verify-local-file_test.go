@@ -0,0 +1,93 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestVerifyLocalFile(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:           credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:          srv.Region,
+		TrailingHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	const partSize = 5 << 20
+	data := bytes.Repeat([]byte("0123456789"), (2*partSize+10)/10)
+	uploads := map[string]PutObjectOptions{
+		"multipart":       {PartSize: partSize, Checksum: ChecksumCRC32C},
+		"multipart-full":  {PartSize: partSize, Checksum: ChecksumFullObjectCRC32},
+		"multipart-plain": {PartSize: partSize},
+	}
+	for name, opts := range uploads {
+		if _, err = clnt.PutObject(ctx, "bucket", name, bytes.NewReader(data), int64(len(data)), opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	small := data[:1000]
+	if _, err = clnt.PutObject(ctx, "bucket", "single", bytes.NewReader(small), int64(len(small)), PutObjectOptions{Checksum: ChecksumSHA256}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	writeFile := func(name string, content []byte) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	dataPath := writeFile("data", data)
+	smallPath := writeFile("small", small)
+	for name := range uploads {
+		if err = clnt.VerifyLocalFile(ctx, "bucket", name, dataPath); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	if err = clnt.VerifyLocalFile(ctx, "bucket", "single", smallPath); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := bytes.Clone(data)
+	changed[partSize+1] = 'x'
+	changedPath := writeFile("changed", changed)
+	var mismatch ErrLocalFileMismatch
+	if err = clnt.VerifyLocalFile(ctx, "bucket", "multipart", changedPath); !errors.As(err, &mismatch) || mismatch.Property != "ETag" {
+		t.Fatalf("expected ETag mismatch, got %v", err)
+	}
+	if err = clnt.VerifyLocalFile(ctx, "bucket", "single", dataPath); !errors.As(err, &mismatch) || mismatch.Property != "size" {
+		t.Fatalf("expected size mismatch, got %v", err)
+	}
+	if err = clnt.VerifyLocalFile(ctx, "bucket", "missing", dataPath); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
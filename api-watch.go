@@ -0,0 +1,232 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/notification"
+)
+
+// WatchEventType is the kind of change reported by WatchPrefix.
+type WatchEventType string
+
+const (
+	// WatchObjectCreated is reported for new and overwritten objects.
+	WatchObjectCreated WatchEventType = "created"
+	// WatchObjectRemoved is reported for removed objects.
+	WatchObjectRemoved WatchEventType = "removed"
+)
+
+// WatchEvent is a change of an object below the watched prefix.
+type WatchEvent struct {
+	Type      WatchEventType
+	Key       string
+	ETag      string
+	Size      int64
+	VersionID string
+	// Time of the change, for polled changes the time it was noticed.
+	Time time.Time
+
+	// Polled is set if the change was found by listing the prefix.
+	Polled bool
+
+	// Err is set if listening or listing failed. Watching continues
+	// until the context is done.
+	Err error
+}
+
+// WatchPrefixOptions configures WatchPrefix.
+type WatchPrefixOptions struct {
+	// PollInterval is the interval of listings when the server does
+	// not support bucket notifications, defaults to 10 seconds.
+	PollInterval time.Duration
+	// ForcePolling always lists the prefix instead of listening
+	// for bucket notifications.
+	ForcePolling bool
+}
+
+// WatchPrefix reports objects created and removed below prefix until
+// ctx is done. It listens for bucket notifications on MinIO and falls
+// back to listing the prefix periodically and comparing the listings
+// on servers without notification support, e.g. AWS S3. Polling only
+// notices the latest state, an object overwritten and removed between
+// two listings is not reported at all. Changes are missed while the
+// listener reconnects after an error.
+func (c *Client) WatchPrefix(ctx context.Context, bucketName, prefix string, opts WatchPrefixOptions) <-chan WatchEvent {
+	eventCh := make(chan WatchEvent, 1)
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 10 * time.Second
+	}
+
+	go func() {
+		defer close(eventCh)
+		// Listening ends on errors, reconnect after a while.
+		for !opts.ForcePolling && c.watchNotifications(ctx, bucketName, prefix, eventCh) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opts.PollInterval):
+			}
+		}
+		c.watchListings(ctx, bucketName, prefix, opts.PollInterval, eventCh)
+	}()
+	return eventCh
+}
+
+// sendWatchEvent sends ev unless ctx is done.
+func sendWatchEvent(ctx context.Context, eventCh chan<- WatchEvent, ev WatchEvent) bool {
+	select {
+	case eventCh <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// notificationUnsupported returns true if err tells that the server
+// does not implement listening for bucket notifications.
+func notificationUnsupported(err error) bool {
+	errResp := ToErrorResponse(err)
+	switch errResp.Code {
+	case "APINotSupported", "NotImplemented", "MethodNotAllowed":
+		return true
+	}
+	return errResp.StatusCode == http.StatusNotImplemented
+}
+
+// watchNotifications translates bucket notifications into events until
+// listening fails or ctx is done. It returns false, without sending any
+// event, if the server does not support them.
+func (c *Client) watchNotifications(ctx context.Context, bucketName, prefix string, eventCh chan<- WatchEvent) bool {
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	infoCh := c.ListenBucketNotification(listenCtx, bucketName, prefix, "", []string{
+		string(notification.ObjectCreatedAll),
+		string(notification.ObjectRemovedAll),
+	})
+	defer func() {
+		// Drain the channel so the listening goroutine can exit.
+		cancel()
+		for range infoCh {
+		}
+	}()
+
+	received := false
+	for info := range infoCh {
+		if info.Err != nil {
+			if !received && notificationUnsupported(info.Err) {
+				return false
+			}
+			if !sendWatchEvent(ctx, eventCh, WatchEvent{Err: info.Err}) {
+				return true
+			}
+			continue
+		}
+		received = true
+		for _, record := range info.Records {
+			ev := WatchEvent{
+				ETag:      trimEtag(record.S3.Object.ETag),
+				Size:      record.S3.Object.Size,
+				VersionID: record.S3.Object.VersionID,
+			}
+			switch {
+			case strings.HasPrefix(record.EventName, "s3:ObjectCreated:"):
+				ev.Type = WatchObjectCreated
+			case strings.HasPrefix(record.EventName, "s3:ObjectRemoved:"):
+				ev.Type = WatchObjectRemoved
+			default:
+				continue
+			}
+			// Keys of notifications are URL encoded.
+			ev.Key = record.S3.Object.Key
+			if key, err := url.QueryUnescape(ev.Key); err == nil {
+				ev.Key = key
+			}
+			ev.Time, _ = time.Parse(time.RFC3339Nano, record.EventTime)
+			if !sendWatchEvent(ctx, eventCh, ev) {
+				return true
+			}
+		}
+	}
+	return true
+}
+
+// watchListings lists the prefix every interval and reports the
+// differences between consecutive listings.
+func (c *Client) watchListings(ctx context.Context, bucketName, prefix string, interval time.Duration, eventCh chan<- WatchEvent) {
+	list := func() (map[string]ObjectInfo, error) {
+		objects := make(map[string]ObjectInfo)
+		for info := range c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if info.Err != nil {
+				return nil, info.Err
+			}
+			objects[info.Key] = info
+		}
+		return objects, nil
+	}
+
+	var known map[string]ObjectInfo
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		current, err := list()
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			if !sendWatchEvent(ctx, eventCh, WatchEvent{Err: err, Polled: true}) {
+				return
+			}
+		case known == nil:
+			// The first listing is the baseline.
+			known = current
+		default:
+			now := time.Now().UTC()
+			for key, info := range current {
+				if old, found := known[key]; found && old.ETag == info.ETag && old.LastModified.Equal(info.LastModified) {
+					continue
+				}
+				ev := WatchEvent{Type: WatchObjectCreated, Key: key, ETag: info.ETag, Size: info.Size, Time: now, Polled: true}
+				if !sendWatchEvent(ctx, eventCh, ev) {
+					return
+				}
+			}
+			for key, info := range known {
+				if _, found := current[key]; found {
+					continue
+				}
+				ev := WatchEvent{Type: WatchObjectRemoved, Key: key, ETag: info.ETag, Time: now, Polled: true}
+				if !sendWatchEvent(ctx, eventCh, ev) {
+					return
+				}
+			}
+			known = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestWatchPrefixPolling(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	putTestObjects(t, clnt, map[string]string{"p/a": "a", "p/keep": "k"})
+
+	// The test server has no bucket notifications.
+	eventCh := clnt.WatchPrefix(ctx, "bucket", "p/", WatchPrefixOptions{PollInterval: 10 * time.Millisecond})
+	defer func() {
+		cancel()
+		for range eventCh {
+		}
+	}()
+
+	// Wait for the baseline listing.
+	time.Sleep(50 * time.Millisecond)
+	putTestObjects(t, clnt, map[string]string{"p/b": "b", "other": "o"})
+	if err := clnt.RemoveObject(ctx, "bucket", "p/a", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]WatchEventType)
+	for len(got) < 2 {
+		ev, ok := <-eventCh
+		if !ok {
+			t.Fatal("watch ended early")
+		}
+		if ev.Err != nil {
+			t.Fatal(ev.Err)
+		}
+		if !ev.Polled {
+			t.Fatalf("expected polled event, got %+v", ev)
+		}
+		got[ev.Key] = ev.Type
+	}
+	if got["p/b"] != WatchObjectCreated || got["p/a"] != WatchObjectRemoved {
+		t.Fatalf("unexpected events %v", got)
+	}
+}
+
+func TestWatchPrefixNotifications(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("events") {
+			io.WriteString(w, `{"Records":[{"eventName":"s3:ObjectCreated:Put","eventTime":"2025-01-02T03:04:05.000Z","s3":{"object":{"key":"p%2Fnew+file","size":3,"eTag":"abc"}}}]}`+"\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	eventCh := clnt.WatchPrefix(ctx, "bucket", "p/", WatchPrefixOptions{})
+	ev := <-eventCh
+	cancel()
+	for range eventCh {
+	}
+	want := WatchEvent{
+		Type: WatchObjectCreated,
+		Key:  "p/new file",
+		ETag: "abc",
+		Size: 3,
+		Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	if ev != want {
+		t.Fatalf("expected %+v, got %+v", want, ev)
+	}
+}
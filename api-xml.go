@@ -0,0 +1,107 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// DoXMLAPI calls an S3 API this client does not wrap yet, e.g. a new
+// sub-resource of buckets or objects selected by query:
+//
+//	var cfg MyNewConfiguration
+//	_, err := client.DoXMLAPI(ctx, http.MethodGet, "mybucket", "", url.Values{"newfeature": {""}}, nil, &cfg)
+//
+// The request is signed and retried like all requests of the client,
+// and errors are returned as ErrorResponse. reqBody is sent as is if
+// it is a []byte or a string and XML encoded otherwise, no body is
+// sent if it is nil. The response body is stored in respBody if it is
+// a *[]byte and XML decoded into it otherwise, unless respBody is nil.
+// The headers of the response are returned.
+func (c *Client) DoXMLAPI(ctx context.Context, method, bucketName, objectName string, query url.Values, reqBody, respBody interface{}) (http.Header, error) {
+	// Input validation.
+	if bucketName != "" {
+		if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+			return nil, err
+		}
+	}
+	if objectName != "" {
+		if bucketName == "" {
+			return nil, errInvalidArgument("Object name requires a bucket name")
+		}
+		if err := s3utils.CheckValidObjectName(objectName); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata := requestMetadata{
+		bucketName:       bucketName,
+		objectName:       objectName,
+		queryValues:      query,
+		contentSHA256Hex: emptySHA256Hex,
+	}
+	if reqBody != nil {
+		var body []byte
+		switch v := reqBody.(type) {
+		case []byte:
+			body = v
+		case string:
+			body = []byte(v)
+		default:
+			var err error
+			if body, err = xml.Marshal(v); err != nil {
+				return nil, err
+			}
+		}
+		metadata.contentBody = bytes.NewReader(body)
+		metadata.contentLength = int64(len(body))
+		metadata.contentMD5Base64 = sumMD5Base64(body)
+		metadata.contentSHA256Hex = sum256Hex(body)
+	}
+
+	resp, err := c.executeMethod(ctx, method, metadata)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return resp.Header, httpRespToErrorResponse(resp, bucketName, objectName)
+	}
+
+	switch v := respBody.(type) {
+	case nil:
+	case *[]byte:
+		if *v, err = io.ReadAll(resp.Body); err != nil {
+			return resp.Header, err
+		}
+	default:
+		// Empty responses leave respBody unchanged.
+		if err = xmlDecoder(resp.Body, v); err != nil && !errors.Is(err, io.EOF) {
+			return resp.Header, err
+		}
+	}
+	return resp.Header, nil
+}
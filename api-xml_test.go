@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDoXMLAPI(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"object": "data"})
+
+	type tag struct {
+		Key   string
+		Value string
+	}
+	type tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Tags    []tag    `xml:"TagSet>Tag"`
+	}
+	query := url.Values{"tagging": {""}}
+
+	in := tagging{Tags: []tag{{Key: "team", Value: "red"}}}
+	if _, err := clnt.DoXMLAPI(ctx, http.MethodPut, "bucket", "object", query, in, nil); err != nil {
+		t.Fatal(err)
+	}
+	var out tagging
+	if _, err := clnt.DoXMLAPI(ctx, http.MethodGet, "bucket", "object", query, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Tags) != 1 || out.Tags[0].Key != "team" || out.Tags[0].Value != "red" {
+		t.Fatalf("unexpected tags %+v", out)
+	}
+
+	// Raw bodies.
+	body := `<Tagging><TagSet><Tag><Key>stage</Key><Value>prod</Value></Tag></TagSet></Tagging>`
+	if _, err := clnt.DoXMLAPI(ctx, http.MethodPut, "bucket", "", query, body, nil); err != nil {
+		t.Fatal(err)
+	}
+	var raw []byte
+	if _, err := clnt.DoXMLAPI(ctx, http.MethodGet, "bucket", "", query, nil, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(raw), "<Key>stage</Key>") {
+		t.Fatalf("unexpected body %s", raw)
+	}
+
+	h, err := clnt.DoXMLAPI(ctx, http.MethodDelete, "bucket", "object", query, nil, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Get("X-Amz-Request-Id") == "" {
+		t.Fatalf("expected response headers, got %v", h)
+	}
+
+	if _, err = clnt.DoXMLAPI(ctx, http.MethodGet, "missing", "", query, nil, &out); ToErrorResponse(err).Code != "NoSuchBucket" {
+		t.Fatalf("expected NoSuchBucket, got %v", err)
+	}
+	if _, err = clnt.DoXMLAPI(ctx, http.MethodGet, "", "object", query, nil, nil); err == nil {
+		t.Fatal("expected an object without bucket to fail")
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand"
 	"net"
 	"net/http"
@@ -76,6 +77,7 @@ type Client struct {
 	isTraceEnabled  bool
 	traceErrorsOnly bool
 	traceOutput     io.Writer
+	traceOpts       TraceOptions
 
 	// S3 specific accelerated endpoint.
 	s3AccelerateEndpoint string
@@ -85,6 +87,18 @@ type Client struct {
 	// Region endpoint
 	region string
 
+	// Region of the environment for AWS endpoints without a region,
+	// see regionHint.
+	regionHint *regionHint
+
+	// Object lock configurations of buckets, see
+	// GetBucketObjectLockConfiguration.
+	objectLockCache *objectLockCache
+
+	// Endpoints of buckets, see BucketResolver.
+	bucketResolver      BucketResolver
+	bucketEndpointCache *bucketEndpointCache
+
 	// Random seed.
 	random *rand.Rand
 
@@ -99,10 +113,39 @@ type Client struct {
 	md5Hasher    func() md5simd.Hasher
 	sha256Hasher func() md5simd.Hasher
 
-	healthStatus int32
+	healthStatus  int32
+	healthMonitor atomic.Value // *healthMonitor of the running health check
+
+	// Moving average of the part upload throughput in bytes per
+	// second, see observePartUpload.
+	uploadThroughput int64
 
 	trailingHeaderSupport bool
 	maxRetries            int
+
+	// Limits the requests in flight per priority, nil if unlimited.
+	scheduler *requestScheduler
+
+	// Adaptive limit of the request rate, nil if disabled.
+	rateLimiter *rateLimiter
+
+	// Concurrent identical GET requests, nil if not shared.
+	sharedGets *sharedGets
+
+	// Whether responses other than object data may be compressed.
+	transportCompression bool
+
+	// Decides whether failed requests are retried, nil for the default.
+	retryPolicy RetryPolicy
+
+	// Account expected to own the buckets of requests, if set.
+	bucketOwner string
+
+	// Custom checksums supported by the server.
+	checksumSupport *checksumSupport
+
+	// Structured request log, nil if disabled.
+	logger *slog.Logger
 }
 
 // Options for New method
@@ -139,6 +182,14 @@ type Options struct {
 	// implementation.
 	BucketLookupViaURL func(u url.URL, bucketName string) BucketLookupType
 
+	// BucketResolver resolves the endpoint of buckets served by other
+	// clusters than the endpoint, e.g. a DNSBucketResolver for MinIO
+	// federation. Resolved endpoints are cached for a few minutes.
+	// With a resolver set, redirects of bucket requests to another
+	// host with the same scheme are followed and cached the same way,
+	// except for requests carrying SSE-C keys.
+	BucketResolver BucketResolver
+
 	// TrailingHeaders indicates server support of trailing headers.
 	// Only supported for v4 signatures.
 	TrailingHeaders bool
@@ -150,6 +201,56 @@ type Options struct {
 	// Number of times a request is retried. Defaults to 10 retries if this option is not configured.
 	// Set to 1 to disable retries.
 	MaxRetries int
+
+	// MaxRequestsInFlight limits the number of concurrent requests per
+	// priority, see WithPriority. Further requests wait for a request
+	// of their priority to finish, i.e. for its response body to be
+	// closed. Priorities without a positive limit are not limited.
+	MaxRequestsInFlight map[Priority]int
+
+	// AdaptiveRateLimit limits the rate of the requests of the client,
+	// across all goroutines, once the server throttles them with 503
+	// (e.g. SlowDown) or 429 responses. The rate is halved on every
+	// throttling and increases gradually while none happens until the
+	// requests are no longer limited, so large worker pools don't keep
+	// overloading the server with retries. See RequestRate.
+	AdaptiveRateLimit bool
+
+	// ShareConcurrentGets makes concurrent identical GET requests, i.e.
+	// for the same object, version and range, send a single request
+	// whose response is shared, saving egress for hot objects. The
+	// response body is buffered until all callers sharing it have read
+	// it, callers only join while the first MiB of the body is read.
+	// Conditional requests, requests of encrypted objects
+	// and requests with other headers or parameters are not shared.
+	ShareConcurrentGets bool
+
+	// TransportCompression accepts gzip compressed responses, which are
+	// decompressed transparently, for requests whose responses are
+	// documents like listings, configurations or S3 Select results.
+	// Object data is always requested uncompressed, so its
+	// Content-Length and checksums are the ones of the object. This
+	// applies regardless of the DisableCompression of the Transport.
+	TransportCompression bool
+
+	// RetryPolicy, if set, decides whether failed requests are retried
+	// and after which delay, e.g. to honor ErrorResponse.RetryAfter of
+	// throttled requests. MaxRetries still bounds the number of attempts.
+	RetryPolicy RetryPolicy
+
+	// ExpectedBucketOwner is the account ID expected to own the buckets
+	// of all requests, sent as x-amz-expected-bucket-owner. Requests to
+	// buckets of another account fail with AccessDenied, e.g. to not
+	// write to a bucket of the same name in another account. It can be
+	// overridden per call with WithExpectedBucketOwner.
+	ExpectedBucketOwner string
+
+	// Logger receives a structured entry per API request with its
+	// operation, bucket, object, status, duration, retries and bytes
+	// transferred. Failed requests are logged at warning level,
+	// successful ones at debug level along with their headers, whose
+	// credentials are redacted. Unlike TraceOn no bodies are logged.
+	Logger *slog.Logger
 }
 
 // Global constants.
@@ -279,9 +380,17 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 		}
 	}
 	clnt.region = opts.Region
+	// For AWS endpoints without a region buckets are assumed to be
+	// in the region of the environment, see guessBucketLocation.
+	if clnt.region == "" && s3utils.IsAmazonEndpoint(*clnt.endpointURL) {
+		clnt.regionHint = &regionHint{}
+	}
 
 	// Instantiate bucket location cache.
 	clnt.bucketLocCache = newBucketLocationCache()
+	clnt.objectLockCache = newObjectLockCache()
+	clnt.bucketResolver = opts.BucketResolver
+	clnt.bucketEndpointCache = newBucketEndpointCache()
 
 	// Introduce a new locked random seed.
 	clnt.random = rand.New(&lockedRandSource{src: rand.NewSource(time.Now().UTC().UnixNano())})
@@ -311,6 +420,15 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 		clnt.maxRetries = opts.MaxRetries
 	}
 
+	clnt.scheduler = newRequestScheduler(opts.MaxRequestsInFlight)
+	clnt.rateLimiter = newRateLimiter(opts.AdaptiveRateLimit)
+	clnt.sharedGets = newSharedGets(opts.ShareConcurrentGets)
+	clnt.transportCompression = opts.TransportCompression
+	clnt.logger = opts.Logger
+	clnt.retryPolicy = opts.RetryPolicy
+	clnt.bucketOwner = opts.ExpectedBucketOwner
+	clnt.checksumSupport = newChecksumSupport()
+
 	// Return.
 	return clnt, nil
 }
@@ -324,17 +442,12 @@ func (c *Client) SetAppInfo(appName, appVersion string) {
 	}
 }
 
-// TraceOn - enable HTTP tracing.
+// TraceOn - enable HTTP tracing. The full requests and responses are
+// dumped to outputStream, see Options.Logger for structured logs of
+// the requests without their bodies or credentials, and
+// TraceOnWithOptions to filter and sample the traced requests.
 func (c *Client) TraceOn(outputStream io.Writer) {
-	// if outputStream is nil then default to os.Stdout.
-	if outputStream == nil {
-		outputStream = os.Stdout
-	}
-	// Sets a new output stream.
-	c.traceOutput = outputStream
-
-	// Enable tracing.
-	c.isTraceEnabled = true
+	c.TraceOnWithOptions(TraceOptions{Output: outputStream})
 }
 
 // TraceErrorsOnlyOn - same as TraceOn, but only errors will be traced.
@@ -354,6 +467,7 @@ func (c *Client) TraceOff() {
 	// Disable tracing.
 	c.isTraceEnabled = false
 	c.traceErrorsOnly = false
+	c.traceOpts = TraceOptions{}
 }
 
 // SetS3TransferAccelerate - turns s3 accelerated endpoint on or off for all your
@@ -419,7 +533,7 @@ func (c *Client) IsOnline() bool {
 
 // sets online healthStatus to offline
 func (c *Client) markOffline() {
-	atomic.CompareAndSwapInt32(&c.healthStatus, online, offline)
+	c.setHealth(online, offline)
 }
 
 // IsOffline returns true if healthcheck enabled and client is offline
@@ -432,55 +546,7 @@ func (c *Client) IsOffline() bool {
 // Returns a context cancellation function, to stop the health check,
 // and an error if health check is already started.
 func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, error) {
-	if atomic.LoadInt32(&c.healthStatus) != unknown {
-		return nil, fmt.Errorf("health check is running")
-	}
-	if hcDuration < 1*time.Second {
-		return nil, fmt.Errorf("health check duration should be at least 1 second")
-	}
-	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-health-")
-	ctx, cancelFn := context.WithCancel(context.Background())
-	atomic.StoreInt32(&c.healthStatus, offline)
-	{
-		// Change to online, if we can connect.
-		gctx, gcancel := context.WithTimeout(ctx, 3*time.Second)
-		_, err := c.getBucketLocation(gctx, probeBucketName)
-		gcancel()
-		if !IsNetworkOrHostDown(err, false) {
-			switch ToErrorResponse(err).Code {
-			case "NoSuchBucket", "AccessDenied", "":
-				atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
-			}
-		}
-	}
-
-	go func(duration time.Duration) {
-		timer := time.NewTimer(duration)
-		defer timer.Stop()
-		for {
-			select {
-			case <-ctx.Done():
-				atomic.StoreInt32(&c.healthStatus, unknown)
-				return
-			case <-timer.C:
-				// Do health check the first time and ONLY if the connection is marked offline
-				if c.IsOffline() {
-					gctx, gcancel := context.WithTimeout(context.Background(), 3*time.Second)
-					_, err := c.getBucketLocation(gctx, probeBucketName)
-					gcancel()
-					if !IsNetworkOrHostDown(err, false) {
-						switch ToErrorResponse(err).Code {
-						case "NoSuchBucket", "AccessDenied", "":
-							atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
-						}
-					}
-				}
-
-				timer.Reset(duration)
-			}
-		}
-	}(hcDuration)
-	return cancelFn, nil
+	return c.HealthCheckWithOptions(HealthCheckOptions{Interval: hcDuration})
 }
 
 // requestMetadata - is container for all the values to make a request.
@@ -505,6 +571,14 @@ type requestMetadata struct {
 	streamSha256     bool
 	addCrc           *ChecksumType
 	trailer          http.Header // (http.Request).Trailer. Requires v4 signature.
+
+	// If set newRequest may guess the bucket location, for requests
+	// which can be retried in another region, see guessBucketLocation.
+	guessLocation bool
+
+	// If set the request is sent to this MinIO admin API, see
+	// executeAdminMethod.
+	adminPath string
 }
 
 // dumpHTTP - dump HTTP request and response.
@@ -540,7 +614,11 @@ func (c *Client) dumpHTTP(req *http.Request, resp *http.Response) error {
 	if resp.StatusCode != http.StatusOK &&
 		resp.StatusCode != http.StatusPartialContent &&
 		resp.StatusCode != http.StatusNoContent {
-		respTrace, err = httputil.DumpResponse(resp, true)
+		if c.traceOpts.MaxBodyBytes > 0 {
+			respTrace, err = dumpResponseLimited(resp, c.traceOpts.MaxBodyBytes)
+		} else {
+			respTrace, err = httputil.DumpResponse(resp, true)
+		}
 		if err != nil {
 			return err
 		}
@@ -569,12 +647,34 @@ func (c *Client) dumpHTTP(req *http.Request, resp *http.Response) error {
 
 // do - execute http request.
 func (c *Client) do(req *http.Request) (resp *http.Response, err error) {
+	// Waiting for the rate limiter or a slot only fails with the
+	// context, which tells nothing about the endpoint.
+	if err = c.rateLimiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	release, err := c.scheduler.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			release()
+		}
+	}()
+
 	defer func() {
 		if IsNetworkOrHostDown(err, false) {
 			c.markOffline()
 		}
 	}()
 
+	req, reportTimings := traceRequestTimings(req)
+	if reportTimings != nil {
+		defer reportTimings()
+	}
+
+	start := time.Now()
 	resp, err = c.httpClient.Do(req)
 	if err != nil {
 		// Handle this specifically for now until future Golang versions fix this issue properly.
@@ -595,16 +695,24 @@ func (c *Client) do(req *http.Request) (resp *http.Response, err error) {
 		msg := "Response is empty. " + reportIssue
 		return nil, errInvalidArgument(msg)
 	}
+	c.rateLimiter.observe(resp.StatusCode, time.Now())
+	c.decompressResponse(req, resp)
 
 	// If trace is enabled, dump http request and response,
 	// except when the traceErrorsOnly enabled and the response's status code is ok
-	if c.isTraceEnabled && (!c.traceErrorsOnly || resp.StatusCode != http.StatusOK) {
-		err = c.dumpHTTP(req, resp)
+	// or the request is filtered out by the trace options.
+	if c.isTraceEnabled && (!c.traceErrorsOnly || resp.StatusCode != http.StatusOK) && c.traceSelected(req, resp) {
+		if c.traceOpts.JSON {
+			err = c.dumpHTTPJSON(req, resp, time.Since(start))
+		} else {
+			err = c.dumpHTTP(req, resp)
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	resp.Body = releaseOnClose{ReadCloser: resp.Body, release: release}
 	return resp, nil
 }
 
@@ -627,6 +735,21 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 	var bodySeeker io.Seeker // Extracted seeker from io.Reader.
 	reqRetry := c.maxRetries // Indicates how many times we can retry the request
 
+	var (
+		attempts    int            // Number of requests sent, for the log.
+		lastReq     *http.Request  // Last request sent, for the log.
+		lastTimings RequestTimings // Timings of the last request, for the log.
+	)
+	if c.logger != nil {
+		start := time.Now()
+		ctx = WithRequestTimings(ctx, func(_ *http.Request, timings RequestTimings) {
+			lastTimings = timings
+		})
+		defer func() {
+			c.logRequest(ctx, method, metadata, lastReq, start, attempts, lastTimings, res, err)
+		}()
+	}
+
 	if metadata.contentBody != nil {
 		// Check if body is seekable then it is retryable.
 		bodySeeker, retryable = metadata.contentBody.(io.Seeker)
@@ -660,7 +783,11 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		metadata.trailer.Set(metadata.addCrc.Key(), base64.StdEncoding.EncodeToString(crc.Sum(nil)))
 	}
 
-	for range c.newRetryTimer(ctx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
+	// A wrong guess of the bucket location needs another attempt.
+	metadata.guessLocation = reqRetry > 1
+
+	var retryDelay time.Duration // Delay of the next retry set by the retry policy.
+	for range c.newRetryTimerWithDelay(ctx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter, &retryDelay) {
 		// Retry executes the following function body if request has an
 		// error until maxRetries have been exhausted, retry attempts are
 		// performed after waiting for a given period of time in a
@@ -684,11 +811,16 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 			return nil, err
 		}
+		c.setAcceptEncoding(req, method, metadata)
+		attempts++
+		lastReq = req
 
 		// Initiate the request.
 		res, err = c.do(req)
 		if err != nil {
-			if isRequestErrorRetryable(ctx, err) {
+			// The bucket may have moved, resolve it again.
+			c.bucketEndpointCache.Delete(metadata.bucketName)
+			if c.retryAttempt(method, metadata, attempts, reqRetry, err, isRequestErrorRetryable(ctx, err), &retryDelay) {
 				// Retry the request
 				continue
 			}
@@ -698,6 +830,10 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		// For any known successful http status, return quickly.
 		for _, httpStatus := range successStatus {
 			if httpStatus == res.StatusCode {
+				c.observeChecksum(method, metadata, res, "")
+				if metadata.guessLocation && metadata.bucketLocation == "" {
+					c.confirmBucketLocation(ctx, metadata.bucketName)
+				}
 				return res, nil
 			}
 		}
@@ -716,11 +852,25 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 		// For errors verify if its retryable otherwise fail quickly.
 		errResponse := ToErrorResponse(httpRespToErrorResponse(res, metadata.bucketName, metadata.objectName))
+		c.observeChecksum(method, metadata, res, errResponse.Code)
 
 		// Save the body back again.
 		errBodySeeker.Seek(0, 0) // Seek back to starting point.
 		res.Body = io.NopCloser(errBodySeeker)
 
+		// Follow redirects of bucket requests to another host, like
+		// the cluster of a MinIO federation serving the bucket. SSE-C
+		// keys are never sent to a host the client was not set up with.
+		if c.bucketResolver != nil && metadata.bucketName != "" && metadata.adminPath == "" && !hasSSECHeaders(metadata.customHeader) {
+			if endpoint := redirectEndpoint(res, metadata.bucketName); endpoint != nil {
+				if c.region == "" && errResponse.Region != "" {
+					c.bucketLocCache.Set(metadata.bucketName, errResponse.Region)
+				}
+				c.bucketEndpointCache.Set(metadata.bucketName, endpoint)
+				continue // Retry.
+			}
+		}
+
 		// Bucket region if set in error response and the error
 		// code dictates invalid region, we can retry the request
 		// with the new region.
@@ -734,6 +884,8 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 			case "InvalidRegion":
 				fallthrough
 			case "AccessDenied":
+				fallthrough
+			case "PermanentRedirect":
 				if errResponse.Region == "" {
 					// Region is empty we simply return the error.
 					return res, err
@@ -741,8 +893,9 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 				// Region is not empty figure out a way to
 				// handle this appropriately.
 				if metadata.bucketName != "" {
-					// Gather Cached location only if bucketName is present.
-					if location, cachedOk := c.bucketLocCache.Get(metadata.bucketName); cachedOk && location != errResponse.Region {
+					// Gather Cached location only if bucketName is present,
+					// a location which is not cached was guessed.
+					if location, cachedOk := c.bucketLocCache.Get(metadata.bucketName); !cachedOk || location != errResponse.Region {
 						c.bucketLocCache.Set(metadata.bucketName, errResponse.Region)
 						continue // Retry.
 					}
@@ -758,13 +911,9 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 			}
 		}
 
-		// Verify if error response code is retryable.
-		if isS3CodeRetryable(errResponse.Code) {
-			continue // Retry.
-		}
-
-		// Verify if http status code is retryable.
-		if isHTTPStatusRetryable(res.StatusCode) {
+		// Verify if error response code or http status code is retryable.
+		retryable := isS3CodeRetryable(errResponse.Code) || isHTTPStatusRetryable(res.StatusCode)
+		if c.retryAttempt(method, metadata, attempts, reqRetry, errResponse, retryable, &retryDelay) {
 			continue // Retry.
 		}
 
@@ -791,7 +940,11 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 	if location == "" {
 		if metadata.bucketName != "" {
 			// Gather location only if bucketName is present.
-			location, err = c.getBucketLocation(ctx, metadata.bucketName)
+			if metadata.guessLocation {
+				location, err = c.guessBucketLocation(ctx, metadata.bucketName)
+			} else {
+				location, err = c.getBucketLocation(ctx, metadata.bucketName)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -805,14 +958,24 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 	// We explicitly disallow MakeBucket calls to not use virtual DNS style,
 	// since the resolution may fail.
 	isMakeBucket := (metadata.objectName == "" && method == http.MethodPut && len(metadata.queryValues) == 0)
-	isVirtualHost := c.isVirtualHostStyleRequest(*c.endpointURL, metadata.bucketName) && !isMakeBucket
+	endpointURL := c.endpointURL
+	if metadata.adminPath == "" {
+		endpointURL, err = c.bucketEndpoint(ctx, metadata.bucketName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	isVirtualHost := c.isVirtualHostStyleRequest(*endpointURL, metadata.bucketName) && !isMakeBucket
 
 	// Construct a new target URL.
-	targetURL, err := c.makeTargetURL(metadata.bucketName, metadata.objectName, location,
+	targetURL, err := c.makeEndpointTargetURL(endpointURL, metadata.bucketName, metadata.objectName, location,
 		isVirtualHost, metadata.queryValues)
 	if err != nil {
 		return nil, err
 	}
+	if metadata.adminPath != "" {
+		targetURL.Path = minioAdminPrefix + metadata.adminPath
+	}
 
 	if c.httpTrace != nil {
 		ctx = httptrace.WithClientTrace(ctx, c.httpTrace)
@@ -878,6 +1041,9 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 	for k, v := range metadata.customHeader {
 		req.Header.Set(k, v[0])
 	}
+	if metadata.bucketName != "" && metadata.adminPath == "" {
+		c.setExpectedBucketOwner(req)
+	}
 
 	// Go net/http notoriously closes the request body.
 	// - The request Body, if non-nil, will be closed by the underlying Transport, even on errors.
@@ -956,9 +1122,14 @@ func (c *Client) setUserAgent(req *http.Request) {
 
 // makeTargetURL make a new target url.
 func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, isVirtualHostStyle bool, queryValues url.Values) (*url.URL, error) {
-	host := c.endpointURL.Host
+	return c.makeEndpointTargetURL(c.endpointURL, bucketName, objectName, bucketLocation, isVirtualHostStyle, queryValues)
+}
+
+// makeEndpointTargetURL make a new target url on the endpoint.
+func (c *Client) makeEndpointTargetURL(endpointURL *url.URL, bucketName, objectName, bucketLocation string, isVirtualHostStyle bool, queryValues url.Values) (*url.URL, error) {
+	host := endpointURL.Host
 	// For Amazon S3 endpoint, try to fetch location based endpoint.
-	if s3utils.IsAmazonEndpoint(*c.endpointURL) {
+	if s3utils.IsAmazonEndpoint(*endpointURL) {
 		if c.s3AccelerateEndpoint != "" && bucketName != "" {
 			// http://docs.aws.amazon.com/AmazonS3/latest/dev/transfer-acceleration.html
 			// Disable transfer acceleration for non-compliant bucket names.
@@ -971,7 +1142,7 @@ func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, is
 			host = c.s3AccelerateEndpoint
 		} else {
 			// Do not change the host if the endpoint URL is a FIPS S3 endpoint or a S3 PrivateLink interface endpoint
-			if !s3utils.IsAmazonFIPSEndpoint(*c.endpointURL) && !s3utils.IsAmazonPrivateLinkEndpoint(*c.endpointURL) {
+			if !s3utils.IsAmazonFIPSEndpoint(*endpointURL) && !s3utils.IsAmazonPrivateLinkEndpoint(*endpointURL) {
 				// Fetch new host based on the bucket location.
 				host = getS3Endpoint(bucketLocation, c.s3DualstackEnabled)
 			}
@@ -979,7 +1150,7 @@ func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, is
 	}
 
 	// Save scheme.
-	scheme := c.endpointURL.Scheme
+	scheme := endpointURL.Scheme
 
 	// Strip port 80 and 443 so we won't send these ports in Host header.
 	// The reason is that browsers and curl automatically remove :80 and :443
@@ -95,7 +95,23 @@ func (c *Client) getBucketLocation(ctx context.Context, bucketName string) (stri
 	if location, ok := c.bucketLocCache.Get(bucketName); ok {
 		return location, nil
 	}
+	return c.fetchBucketLocation(ctx, bucketName)
+}
 
+// LookupBucketLocation returns the location of the bucket as reported
+// by the server, bypassing the location cache and the region the
+// client is configured with, e.g. to find buckets which moved, and
+// caches it.
+func (c *Client) LookupBucketLocation(ctx context.Context, bucketName string) (string, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return "", err
+	}
+	return c.fetchBucketLocation(ctx, bucketName)
+}
+
+// fetchBucketLocation asks the server for the location of the bucket
+// and caches it.
+func (c *Client) fetchBucketLocation(ctx context.Context, bucketName string) (string, error) {
 	// Initialize a new request.
 	req, err := c.getBucketLocationRequest(ctx, bucketName)
 	if err != nil {
@@ -116,6 +132,59 @@ func (c *Client) getBucketLocation(ctx context.Context, bucketName string) (stri
 	return location, nil
 }
 
+// regionHint is the region of the environment the client runs in,
+// detected on first use with credentials.DetectRegion and shared by
+// all copies of the client.
+type regionHint struct {
+	once   sync.Once
+	region string
+}
+
+func (r *regionHint) get(ctx context.Context) string {
+	if r == nil {
+		return ""
+	}
+	r.once.Do(func() {
+		// Not canceled along with the first request, the lookups
+		// are bounded by their own timeouts.
+		r.region = credentials.DetectRegion(context.WithoutCancel(ctx), nil)
+	})
+	return r.region
+}
+
+// guessBucketLocation is like getBucketLocation, but for buckets not in
+// the cache the region of the environment is assumed, if any, instead
+// of asking the server. A wrong guess is corrected from the region the
+// server reports when rejecting the request, see executeMethod, so it
+// is only used for requests which can be retried. The guess is not
+// cached until the server accepts it, see confirmBucketLocation.
+func (c *Client) guessBucketLocation(ctx context.Context, bucketName string) (string, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return "", err
+	}
+	if c.region == "" && c.regionHint != nil {
+		if _, ok := c.bucketLocCache.Get(bucketName); !ok {
+			if region := c.regionHint.get(ctx); region != "" {
+				return region, nil
+			}
+		}
+	}
+	return c.getBucketLocation(ctx, bucketName)
+}
+
+// confirmBucketLocation caches the location guessed for bucketName by
+// guessBucketLocation once a request signed for it succeeded.
+func (c *Client) confirmBucketLocation(ctx context.Context, bucketName string) {
+	if bucketName == "" || c.region != "" || c.regionHint == nil {
+		return
+	}
+	if _, ok := c.bucketLocCache.Get(bucketName); !ok {
+		if region := c.regionHint.get(ctx); region != "" {
+			c.bucketLocCache.Set(bucketName, region)
+		}
+	}
+}
+
 // processes the getBucketLocation http response from the server.
 func processBucketLocationResponse(resp *http.Response, bucketName string) (bucketLocation string, err error) {
 	if resp != nil {
@@ -210,6 +279,7 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 
 	// Set UserAgent for the request.
 	c.setUserAgent(req)
+	c.setExpectedBucketOwner(req)
 
 	// Get credentials from the configured credentials provider.
 	value, err := c.credsProvider.GetWithContext(c.CredContext())
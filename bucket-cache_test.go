@@ -26,6 +26,7 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/jie123108/minio-go/v7/pkg/credentials"
@@ -358,3 +359,104 @@ func TestProcessBucketLocationResponse(t *testing.T) {
 		}
 	}
 }
+
+type regionRoundTripper struct {
+	region string
+	hosts  []string
+}
+
+// RoundTrip answers requests sent to the endpoint of another region
+// than the bucket's with a redirect, like AWS S3 does.
+func (r *regionRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.hosts = append(r.hosts, req.URL.Host)
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req,
+	}
+	if req.URL.Query().Has("location") {
+		resp.Body = io.NopCloser(strings.NewReader("<LocationConstraint>" + r.region + "</LocationConstraint>"))
+		return resp, nil
+	}
+	if req.URL.Host != "bucket.s3.dualstack."+r.region+".amazonaws.com" {
+		resp.StatusCode = http.StatusMovedPermanently
+		resp.Header.Set("x-amz-bucket-region", r.region)
+	}
+	return resp, nil
+}
+
+func TestGuessBucketLocation(t *testing.T) {
+	t.Setenv("AWS_REGION", "eu-west-1")
+
+	testCases := []struct {
+		bucketRegion string
+		hosts        []string
+	}{
+		{"eu-west-1", []string{"bucket.s3.dualstack.eu-west-1.amazonaws.com"}},
+		{"us-west-2", []string{"bucket.s3.dualstack.eu-west-1.amazonaws.com", "bucket.s3.dualstack.us-west-2.amazonaws.com"}},
+	}
+	for i, testCase := range testCases {
+		rt := &regionRoundTripper{region: testCase.bucketRegion}
+		c, err := New("s3.amazonaws.com", &Options{
+			Creds:     credentials.NewStaticV4("accessKey", "secretKey", ""),
+			Transport: rt,
+			Secure:    true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		found, err := c.BucketExists(context.Background(), "bucket")
+		if err != nil || !found {
+			t.Fatalf("Test %d: expected bucket to be found, got %v, %v", i+1, found, err)
+		}
+		if !reflect.DeepEqual(rt.hosts, testCase.hosts) {
+			t.Errorf("Test %d: expected requests to %v, got %v", i+1, testCase.hosts, rt.hosts)
+		}
+		if location, _ := c.bucketLocCache.Get("bucket"); location != testCase.bucketRegion {
+			t.Errorf("Test %d: expected cached location %s, got %s", i+1, testCase.bucketRegion, location)
+		}
+	}
+
+	// An explicit region is used as is.
+	c, err := New("s3.amazonaws.com", &Options{
+		Creds:  credentials.NewStaticV4("accessKey", "secretKey", ""),
+		Secure: true,
+		Region: "us-west-2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.regionHint != nil {
+		t.Fatal("expected no region detection with an explicit region")
+	}
+}
+
+func TestGuessBucketLocationRetryable(t *testing.T) {
+	t.Setenv("AWS_REGION", "eu-west-1")
+
+	rt := &regionRoundTripper{region: "us-west-2"}
+	c, err := New("s3.amazonaws.com", &Options{
+		Creds:     credentials.NewStaticV4("accessKey", "secretKey", ""),
+		Transport: rt,
+		Secure:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Guesses are only cached once the server accepts them.
+	if location, err := c.guessBucketLocation(context.Background(), "bucket"); err != nil || location != "eu-west-1" {
+		t.Fatalf("expected guessed location eu-west-1, got %s, %v", location, err)
+	}
+	if location, ok := c.bucketLocCache.Get("bucket"); ok {
+		t.Fatalf("expected the guess not to be cached, got %s", location)
+	}
+	// Requests which cannot be retried are not sent on a guess.
+	_, err = c.PutObject(context.Background(), "bucket", "object", io.MultiReader(strings.NewReader("data")), 4, PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hosts := []string{"bucket.s3.amazonaws.com", "bucket.s3.dualstack.us-west-2.amazonaws.com"}; !reflect.DeepEqual(rt.hosts, hosts) {
+		t.Errorf("expected requests to %v, got %v", hosts, rt.hosts)
+	}
+}
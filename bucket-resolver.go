@@ -0,0 +1,207 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// bucketEndpointCacheTTL is how long the endpoints of buckets, resolved
+// or learned from redirects, are cached.
+const bucketEndpointCacheTTL = 5 * time.Minute
+
+// BucketResolver resolves the endpoint serving a bucket, for deployments
+// where buckets live on different clusters behind a common namespace,
+// like MinIO federation where buckets are registered in etcd and
+// resolved through DNS.
+type BucketResolver interface {
+	// ResolveBucket returns the endpoint, i.e. the scheme and host, of
+	// the cluster serving the bucket, or nil for the client endpoint.
+	ResolveBucket(ctx context.Context, bucketName string) (*url.URL, error)
+}
+
+// BucketResolverFunc is a function implementing BucketResolver.
+type BucketResolverFunc func(ctx context.Context, bucketName string) (*url.URL, error)
+
+// ResolveBucket calls f(ctx, bucketName).
+func (f BucketResolverFunc) ResolveBucket(ctx context.Context, bucketName string) (*url.URL, error) {
+	return f(ctx, bucketName)
+}
+
+// DNSBucketResolver resolves buckets of a MinIO federation, where the
+// DNS name "<bucket>.<Domain>" of every bucket resolves to the addresses
+// of the cluster serving it. Buckets without DNS record are served by
+// the client endpoint. Requests are sent to the first address resolved,
+// so with TLS the certificates of the clusters must be valid for it.
+type DNSBucketResolver struct {
+	// Domain of the federation, i.e. MINIO_DOMAIN of the clusters.
+	Domain string
+
+	// Port of the clusters, defaults to the port of the scheme.
+	Port int
+
+	// Secure uses https to reach the clusters.
+	Secure bool
+
+	// Resolver used for the lookups, defaults to net.DefaultResolver.
+	Resolver *net.Resolver
+}
+
+// ResolveBucket looks up the addresses of the bucket.
+func (r *DNSBucketResolver) ResolveBucket(ctx context.Context, bucketName string) (*url.URL, error) {
+	resolver := r.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	addrs, err := resolver.LookupHost(ctx, bucketName+"."+strings.TrimPrefix(r.Domain, "."))
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	u := &url.URL{Scheme: "http", Host: addrs[0]}
+	if r.Secure {
+		u.Scheme = "https"
+	}
+	if r.Port != 0 {
+		u.Host = net.JoinHostPort(addrs[0], strconv.Itoa(r.Port))
+	} else if strings.Contains(addrs[0], ":") {
+		u.Host = "[" + addrs[0] + "]"
+	}
+	return u, nil
+}
+
+// bucketEndpointCache holds the endpoints of buckets served elsewhere
+// than the client endpoint.
+type bucketEndpointCache struct {
+	sync.Mutex
+	items map[string]bucketEndpointCacheItem
+}
+
+type bucketEndpointCacheItem struct {
+	endpoint *url.URL
+	expires  time.Time
+}
+
+func newBucketEndpointCache() *bucketEndpointCache {
+	return &bucketEndpointCache{
+		items: make(map[string]bucketEndpointCacheItem),
+	}
+}
+
+// Get - Returns the endpoint of the bucket if cached and not expired.
+func (r *bucketEndpointCache) Get(bucketName string) (endpoint *url.URL, ok bool) {
+	r.Lock()
+	defer r.Unlock()
+	item, ok := r.items[bucketName]
+	if !ok || time.Now().After(item.expires) {
+		return nil, false
+	}
+	return item.endpoint, true
+}
+
+// Set - Caches the endpoint of the bucket for bucketEndpointCacheTTL.
+func (r *bucketEndpointCache) Set(bucketName string, endpoint *url.URL) {
+	r.Lock()
+	defer r.Unlock()
+	r.items[bucketName] = bucketEndpointCacheItem{endpoint: endpoint, expires: time.Now().Add(bucketEndpointCacheTTL)}
+}
+
+// Delete - Deletes a bucket name from cache.
+func (r *bucketEndpointCache) Delete(bucketName string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.items, bucketName)
+}
+
+// bucketEndpoint returns the endpoint serving the bucket: the one
+// learned from a redirect, the one resolved by the bucket resolver, or
+// the client endpoint.
+func (c *Client) bucketEndpoint(ctx context.Context, bucketName string) (*url.URL, error) {
+	if bucketName == "" {
+		return c.endpointURL, nil
+	}
+	if endpoint, ok := c.bucketEndpointCache.Get(bucketName); ok {
+		return endpoint, nil
+	}
+	if c.bucketResolver == nil {
+		return c.endpointURL, nil
+	}
+	endpoint, err := c.bucketResolver.ResolveBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil {
+		endpoint = c.endpointURL
+	}
+	c.bucketEndpointCache.Set(bucketName, endpoint)
+	return endpoint, nil
+}
+
+// redirectEndpoint returns the endpoint a bucket request is redirected
+// to, or nil if the response is not a redirect to another host with
+// the same scheme. The bucket is removed from the host of virtual host
+// style requests, the style is chosen again for the new endpoint.
+func redirectEndpoint(res *http.Response, bucketName string) *url.URL {
+	switch res.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return nil
+	}
+	req := res.Request
+	location := res.Header.Get("Location")
+	if req == nil || location == "" {
+		return nil
+	}
+	u, err := req.URL.Parse(location)
+	if err != nil || u.Host == "" || u.Host == req.URL.Host || u.Scheme != req.URL.Scheme {
+		return nil
+	}
+	host := u.Host
+	if strings.HasPrefix(req.URL.Host, bucketName+".") {
+		host = strings.TrimPrefix(host, bucketName+".")
+	}
+	endpoint := &url.URL{Scheme: u.Scheme, Host: host}
+	if s3utils.IsAmazonEndpoint(*endpoint) {
+		// Redirects of AWS are handled through the bucket region.
+		return nil
+	}
+	return endpoint
+}
+
+// hasSSECHeaders returns true if h carries SSE-C keys, of the object
+// or of the copy source.
+func hasSSECHeaders(h http.Header) bool {
+	return h.Get(encrypt.SseCustomerKey) != "" || h.Get(encrypt.SseCopyCustomerKey) != ""
+}
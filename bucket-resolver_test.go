@@ -0,0 +1,150 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestBucketResolver(t *testing.T) {
+	ctx := context.Background()
+	local, clnt := newTestServerClient(t)
+	remote := miniotest.NewServer(t)
+	remoteURL, err := url.Parse(remote.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resolved int32
+	fed, err := New(local.Endpoint(), &Options{
+		Creds:  credentials.NewStaticV4(local.AccessKey, local.SecretKey, ""),
+		Region: local.Region,
+		BucketResolver: BucketResolverFunc(func(_ context.Context, bucketName string) (*url.URL, error) {
+			atomic.AddInt32(&resolved, 1)
+			if bucketName == "remote" {
+				return remoteURL, nil
+			}
+			return nil, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = fed.MakeBucket(ctx, "remote", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fed.PutObject(ctx, "remote", "object", strings.NewReader("remote"), 6, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fed.PutObject(ctx, "bucket", "object", strings.NewReader("local"), 5, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if found, err := clnt.BucketExists(ctx, "remote"); err != nil || found {
+		t.Fatalf("expected bucket on the remote server only, got %v, %v", found, err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&resolved); n != 2 {
+		t.Fatalf("expected buckets to be resolved once, got %d resolutions", n)
+	}
+}
+
+func TestBucketRedirect(t *testing.T) {
+	ctx := context.Background()
+	remote, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "content"})
+
+	var redirected int32
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&redirected, 1)
+		w.Header().Set("Location", remote.URL+r.URL.RequestURI())
+		w.WriteHeader(http.StatusMovedPermanently)
+	}))
+	defer redirector.Close()
+
+	// Without a bucket resolver redirects are not followed.
+	plain, err := New(strings.TrimPrefix(redirector.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4(remote.AccessKey, remote.SecretKey, ""),
+		Region: remote.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = plain.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Fatal("expected the redirect not to be followed")
+	}
+	atomic.StoreInt32(&redirected, 0)
+
+	fed, err := New(strings.TrimPrefix(redirector.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4(remote.AccessKey, remote.SecretKey, ""),
+		Region: remote.Region,
+		BucketResolver: BucketResolverFunc(func(context.Context, string) (*url.URL, error) {
+			return nil, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		obj, err := fed.GetObject(ctx, "bucket", "object", GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(obj)
+		obj.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "content" {
+			t.Fatalf("unexpected content %q", data)
+		}
+	}
+	if n := atomic.LoadInt32(&redirected); n != 1 {
+		t.Fatalf("expected the redirect to be cached, got %d redirects", n)
+	}
+}
+
+func TestRedirectEndpoint(t *testing.T) {
+	req := &http.Request{URL: &url.URL{Scheme: "https", Host: "bucket.cluster1:9000", Path: "/object"}}
+	for location, want := range map[string]string{
+		"https://bucket.cluster2:9000/object": "https://cluster2:9000",
+		"http://bucket.cluster2:9000/object":  "",
+		"https://bucket.cluster1:9000/other":  "",
+		"/object?x=y":                         "",
+	} {
+		res := &http.Response{StatusCode: http.StatusMovedPermanently, Header: http.Header{"Location": {location}}, Request: req}
+		got := ""
+		if endpoint := redirectEndpoint(res, "bucket"); endpoint != nil {
+			got = endpoint.String()
+		}
+		if got != want {
+			t.Errorf("%s: expected %q, got %q", location, want, got)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"math/bits"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ChecksumAlgorithm is a checksum algorithm not built into S3, like
+// XXH3, supported by some MinIO deployments, see RegisterChecksum.
+type ChecksumAlgorithm struct {
+	// Name of the algorithm, e.g. "XXH3", sent as x-amz-checksum-algorithm,
+	// the checksums are sent as x-amz-checksum-<lowercase name>.
+	Name string
+
+	// Size is the size of the un-encoded checksums.
+	Size int
+
+	// New returns a new hasher computing the checksums.
+	New func() hash.Hash
+
+	// Fallback is the checksum used instead with servers found not to
+	// support the algorithm, ChecksumCRC32C if not set.
+	Fallback ChecksumType
+}
+
+func (a ChecksumAlgorithm) key() string {
+	return "x-amz-checksum-" + strings.ToLower(a.Name)
+}
+
+// maxCustomChecksums is the number of custom checksums which can be registered.
+const maxCustomChecksums = 8
+
+// customChecksums holds the registered custom checksums, the type of
+// the checksum at index i is 1<<(16+i).
+var customChecksums struct {
+	sync.RWMutex
+	algorithms []ChecksumAlgorithm
+}
+
+// RegisterChecksum registers a custom checksum algorithm and returns
+// its type, to be used as PutObjectOptions.Checksum or AutoChecksum
+// like the built-in types. At most 8 algorithms can be registered,
+// typically at initialization:
+//
+//	var ChecksumXXH3, _ = minio.RegisterChecksum(minio.ChecksumAlgorithm{
+//		Name: "XXH3",
+//		Size: 8,
+//		New:  func() hash.Hash { return xxh3.New() },
+//	})
+//
+// Custom checksums are sent like the built-in ones, in headers or
+// trailers, and verified by the servers which support them. Multipart
+// uploads send the checksums of their parts only. Each client detects
+// whether its server supports a custom checksum from the response of
+// the first upload with it: servers which verify a checksum return it,
+// the other ones ignore or reject it. Once found unsupported, uploads
+// with the checksum use its Fallback instead, see
+// Client.ChecksumSupported.
+func RegisterChecksum(alg ChecksumAlgorithm) (ChecksumType, error) {
+	if alg.Name == "" || strings.IndexFunc(alg.Name, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	}) >= 0 {
+		return ChecksumNone, errInvalidArgument(fmt.Sprintf("Invalid checksum name %q", alg.Name))
+	}
+	if alg.Size <= 0 || alg.New == nil {
+		return ChecksumNone, errInvalidArgument("Checksum algorithm " + alg.Name + " needs a size and a hasher")
+	}
+	if alg.Fallback == ChecksumNone {
+		alg.Fallback = ChecksumCRC32C
+	}
+	if alg.Fallback&checksumCustom != 0 || !alg.Fallback.IsSet() {
+		return ChecksumNone, errInvalidArgument("Fallback of checksum " + alg.Name + " must be a built-in checksum")
+	}
+
+	customChecksums.Lock()
+	defer customChecksums.Unlock()
+	for t := ChecksumType(1); t < checksumLast; t <<= 1 {
+		if strings.EqualFold(t.String(), alg.Name) {
+			return ChecksumNone, errInvalidArgument("Checksum " + alg.Name + " is built-in")
+		}
+	}
+	for _, registered := range customChecksums.algorithms {
+		if strings.EqualFold(registered.Name, alg.Name) {
+			return ChecksumNone, errInvalidArgument("Checksum " + alg.Name + " is already registered")
+		}
+	}
+	if len(customChecksums.algorithms) == maxCustomChecksums {
+		return ChecksumNone, errors.New("too many custom checksums registered")
+	}
+	customChecksums.algorithms = append(customChecksums.algorithms, alg)
+	return ChecksumType(1) << (16 + len(customChecksums.algorithms) - 1), nil
+}
+
+// customChecksum returns the custom checksum algorithm of type c.
+func customChecksum(c ChecksumType) (ChecksumAlgorithm, bool) {
+	t := c & checksumMask
+	if t&^checksumCustom != 0 || bits.OnesCount32(uint32(t)) != 1 {
+		return ChecksumAlgorithm{}, false
+	}
+	i := bits.TrailingZeros32(uint32(t)) - 16
+	customChecksums.RLock()
+	defer customChecksums.RUnlock()
+	if i >= len(customChecksums.algorithms) {
+		return ChecksumAlgorithm{}, false
+	}
+	return customChecksums.algorithms[i], true
+}
+
+// checksumSupport records which custom checksums the server of a client
+// supports, shared by all copies of the client.
+type checksumSupport struct {
+	sync.Mutex
+	supported map[ChecksumType]bool
+}
+
+func newChecksumSupport() *checksumSupport {
+	return &checksumSupport{supported: make(map[ChecksumType]bool)}
+}
+
+// ChecksumSupported tells whether the server supports the checksum type
+// t, and whether this is known. Built-in checksums are supported, the
+// support of custom ones is known once an upload used them, see
+// RegisterChecksum.
+func (c *Client) ChecksumSupported(t ChecksumType) (supported, known bool) {
+	t &= checksumMask
+	if t&checksumCustom == 0 {
+		return t.IsSet(), true
+	}
+	c.checksumSupport.Lock()
+	defer c.checksumSupport.Unlock()
+	supported, known = c.checksumSupport.supported[t]
+	return supported, known
+}
+
+// negotiateChecksum returns the checksum to use instead of t, the
+// fallback of custom checksums the server does not support.
+func (c *Client) negotiateChecksum(t ChecksumType) ChecksumType {
+	alg, ok := customChecksum(t)
+	if !ok {
+		return t
+	}
+	if supported, known := c.ChecksumSupported(t); known && !supported {
+		return alg.Fallback
+	}
+	return t
+}
+
+// observeChecksum records whether the server supports the custom
+// checksum sent with an upload request, from its response: successful
+// responses returning the checksum prove it, others with success or
+// rejecting the request as invalid disprove it. Multipart uploads are
+// initiated with the algorithm of the checksum, which the server
+// returns if it supports it. errCode is the error code of failed
+// requests.
+func (c *Client) observeChecksum(method string, metadata requestMetadata, res *http.Response, errCode string) {
+	if metadata.objectName == "" {
+		return
+	}
+	t := ChecksumNone
+	var returned func() bool
+	switch {
+	case method == http.MethodPut:
+		if metadata.addCrc != nil && metadata.addCrc.Base()&checksumCustom != 0 {
+			t = metadata.addCrc.Base()
+		}
+		for k := range metadata.customHeader {
+			if found := customChecksumByKey(k); found != ChecksumNone {
+				t = found
+			}
+		}
+		returned = func() bool { return res.Header.Get(t.Key()) != "" }
+	case method == http.MethodPost && metadata.queryValues.Has("uploads"):
+		t = customChecksumByName(metadata.customHeader.Get(amzChecksumAlgo))
+		returned = func() bool { return strings.EqualFold(res.Header.Get(amzChecksumAlgo), t.String()) }
+	}
+	if t == ChecksumNone {
+		return
+	}
+
+	var supported bool
+	switch errCode {
+	case "":
+		supported = returned()
+	case "InvalidRequest", "InvalidArgument", "NotImplemented":
+	default:
+		return
+	}
+	c.checksumSupport.Lock()
+	defer c.checksumSupport.Unlock()
+	c.checksumSupport.supported[t] = supported
+}
+
+// customChecksumByName returns the type of the custom checksum named
+// name, ChecksumNone if none.
+func customChecksumByName(name string) ChecksumType {
+	if name == "" {
+		return ChecksumNone
+	}
+	customChecksums.RLock()
+	defer customChecksums.RUnlock()
+	for i, alg := range customChecksums.algorithms {
+		if strings.EqualFold(alg.Name, name) {
+			return ChecksumType(1) << (16 + i)
+		}
+	}
+	return ChecksumNone
+}
+
+// customChecksumByKey returns the type of the custom checksum sent with
+// the header key, ChecksumNone if none.
+func customChecksumByKey(key string) ChecksumType {
+	customChecksums.RLock()
+	defer customChecksums.RUnlock()
+	for i, alg := range customChecksums.algorithms {
+		if strings.EqualFold(alg.key(), key) {
+			return ChecksumType(1) << (16 + i)
+		}
+	}
+	return ChecksumNone
+}
@@ -0,0 +1,178 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"hash"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// checksumFNV64 is a custom checksum registered once for the tests.
+var checksumFNV64, checksumFNV64Err = RegisterChecksum(ChecksumAlgorithm{
+	Name:     "FNV64",
+	Size:     8,
+	New:      func() hash.Hash { return fnv.New64a() },
+	Fallback: ChecksumSHA256,
+})
+
+// checksumEchoTransport records the checksums sent with uploads and
+// optionally returns them in the responses, like servers verifying them.
+type checksumEchoTransport struct {
+	echo bool
+
+	mu   sync.Mutex
+	sent []string
+}
+
+func (t *checksumEchoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var keys []string
+	for k := range req.Header {
+		if strings.HasPrefix(k, "X-Amz-Checksum-") && k != "X-Amz-Checksum-Algorithm" && k != "X-Amz-Checksum-Type" {
+			keys = append(keys, strings.ToLower(k))
+		}
+	}
+	if trailer := req.Header.Get("X-Amz-Trailer"); trailer != "" {
+		keys = append(keys, strings.ToLower(trailer))
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.Method != http.MethodPut {
+		return resp, err
+	}
+	t.mu.Lock()
+	t.sent = append(t.sent, keys...)
+	t.mu.Unlock()
+	if t.echo {
+		for _, k := range keys {
+			resp.Header.Set(k, "echoed")
+		}
+	}
+	return resp, nil
+}
+
+func TestCustomChecksum(t *testing.T) {
+	if checksumFNV64Err != nil {
+		t.Fatal(checksumFNV64Err)
+	}
+	if !checksumFNV64.IsSet() || checksumFNV64.String() != "FNV64" || checksumFNV64.Key() != "x-amz-checksum-fnv64" || checksumFNV64.RawByteLen() != 8 {
+		t.Fatalf("unexpected custom checksum %v %q", checksumFNV64, checksumFNV64.Key())
+	}
+	if sum := checksumFNV64.ChecksumBytes([]byte("data")); !sum.IsSet() || sum.Type != checksumFNV64 {
+		t.Fatalf("unexpected checksum %+v", sum)
+	}
+	if (checksumFNV64 << 1).IsSet() {
+		t.Fatal("unregistered custom checksum is set")
+	}
+	for _, alg := range []ChecksumAlgorithm{
+		{Name: "fnv64", Size: 8, New: func() hash.Hash { return fnv.New64a() }},
+		{Name: "crc32c", Size: 4, New: func() hash.Hash { return fnv.New32a() }},
+		{Name: "x-y", Size: 8, New: func() hash.Hash { return fnv.New64a() }},
+		{Name: "FNV32"},
+		{Name: "FNV128", Size: 16, New: func() hash.Hash { return fnv.New128a() }, Fallback: checksumFNV64},
+	} {
+		if _, err := RegisterChecksum(alg); err == nil {
+			t.Fatalf("expected registering %q to fail", alg.Name)
+		}
+	}
+
+	srv, _ := newTestServerClient(t)
+	ctx := context.Background()
+	for _, echo := range []bool{true, false} {
+		transport := &checksumEchoTransport{echo: echo}
+		clnt, err := New(srv.Endpoint(), &Options{
+			Creds:           credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+			Region:          srv.Region,
+			Transport:       transport,
+			TrailingHeaders: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, known := clnt.ChecksumSupported(checksumFNV64); known {
+			t.Fatal("expected the support of the custom checksum to be unknown")
+		}
+		data := []byte("custom checksum")
+		for range 2 {
+			_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{Checksum: checksumFNV64})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err = clnt.PutObjectBytes(ctx, "bucket", "object", data, PutObjectOptions{Checksum: checksumFNV64}); err != nil {
+			t.Fatal(err)
+		}
+		if supported, known := clnt.ChecksumSupported(checksumFNV64); supported != echo || !known {
+			t.Fatalf("expected support %v, got %v, %v", echo, supported, known)
+		}
+		want := []string{"x-amz-checksum-fnv64", "x-amz-checksum-fnv64", "x-amz-checksum-fnv64"}
+		if !echo {
+			want[1], want[2] = "x-amz-checksum-sha256", "x-amz-checksum-sha256"
+		}
+		if strings.Join(transport.sent, ",") != strings.Join(want, ",") {
+			t.Fatalf("expected checksums %q, got %q", want, transport.sent)
+		}
+	}
+
+	// Servers rejecting the initiation of multipart uploads with the
+	// checksum get its fallback from then on.
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:           credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:          srv.Region,
+		Transport:       checksumRejectTransport{},
+		TrailingHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("multipart")
+	opts := PutObjectOptions{Checksum: checksumFNV64}
+	if _, err = clnt.PutObject(ctx, "bucket", "multipart", bytes.NewReader(data), -1, opts); ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+
+	}
+	if supported, known := clnt.ChecksumSupported(checksumFNV64); supported || !known {
+		t.Fatalf("expected the checksum to be unsupported, got %v, %v", supported, known)
+	}
+	if _, err = clnt.PutObject(ctx, "bucket", "multipart", bytes.NewReader(data), -1, opts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// checksumRejectTransport rejects the initiation of multipart uploads
+// with a custom checksum algorithm.
+type checksumRejectTransport struct{}
+
+func (checksumRejectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost && req.URL.Query().Has("uploads") && req.Header.Get(amzChecksumAlgo) == "FNV64" {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"Content-Type": {"application/xml"}},
+			Body:       io.NopCloser(strings.NewReader("<Error><Code>InvalidArgument</Code><Message>Invalid checksum algorithm</Message></Error>")),
+			Request:    req,
+		}, nil
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -94,8 +94,12 @@ const (
 	// to indicate full object checksums.
 	ChecksumFullObject
 
+	// checksumCustom is the mask of the types of custom checksums,
+	// see RegisterChecksum.
+	checksumCustom ChecksumType = 0xff << 16
+
 	// checksumMask is a mask for valid checksum types.
-	checksumMask = checksumLast - 1
+	checksumMask = checksumLast - 1 | checksumCustom
 
 	// ChecksumNone indicates no checksum.
 	ChecksumNone ChecksumType = 0
@@ -140,6 +144,9 @@ func (c ChecksumType) Key() string {
 	case ChecksumCRC64NVME:
 		return amzChecksumCRC64NVME
 	}
+	if alg, ok := customChecksum(c); ok {
+		return alg.key()
+	}
 	return ""
 }
 
@@ -187,6 +194,9 @@ func (c ChecksumType) RawByteLen() int {
 	case ChecksumCRC64NVME:
 		return crc64.Size
 	}
+	if alg, ok := customChecksum(c); ok {
+		return alg.Size
+	}
 	return 0
 }
 
@@ -207,11 +217,18 @@ func (c ChecksumType) Hasher() hash.Hash {
 	case ChecksumCRC64NVME:
 		return crc64nvme.New()
 	}
+	if alg, ok := customChecksum(c); ok {
+		return alg.New()
+	}
 	return nil
 }
 
 // IsSet returns whether the type is valid and known.
 func (c ChecksumType) IsSet() bool {
+	if c&checksumCustom != 0 {
+		_, ok := customChecksum(c)
+		return ok
+	}
 	return bits.OnesCount32(uint32(c&checksumMask)) == 1
 }
 
@@ -233,7 +250,8 @@ func (c ChecksumType) EncodeToString(b []byte) string {
 }
 
 // String returns the type as a string.
-// CRC32, CRC32C, SHA1, and SHA256 for valid values.
+// CRC32, CRC32C, SHA1, SHA256, CRC64NVME or the name of a custom
+// checksum for valid values.
 // Empty string for unset and "<invalid>" if not valid.
 func (c ChecksumType) String() string {
 	switch c & checksumMask {
@@ -250,6 +268,9 @@ func (c ChecksumType) String() string {
 	case ChecksumCRC64NVME:
 		return "CRC64NVME"
 	}
+	if alg, ok := customChecksum(c); ok {
+		return alg.Name
+	}
 	return "<invalid>"
 }
 
@@ -0,0 +1,96 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// objectSubresources are the query parameters of object requests whose
+// responses are documents, like ListParts, rather than object data.
+var objectSubresources = []string{"uploadId", "attributes", "tagging", "acl", "retention", "legal-hold", "select"}
+
+// isObjectDataRequest tells whether the response of a request carries
+// object data, whose Content-Length must be the one of the object.
+func isObjectDataRequest(method string, metadata requestMetadata) bool {
+	if metadata.objectName == "" || metadata.adminPath != "" {
+		return false
+	}
+	if method != http.MethodGet && method != http.MethodHead {
+		return false
+	}
+	for _, k := range objectSubresources {
+		if metadata.queryValues.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// setAcceptEncoding negotiates the compression of the response of a
+// request, see Options.TransportCompression: object data is never
+// compressed, other responses like listings may be.
+func (c *Client) setAcceptEncoding(req *http.Request, method string, metadata requestMetadata) {
+	if !c.transportCompression || req.Header.Get("Accept-Encoding") != "" {
+		return
+	}
+	if isObjectDataRequest(method, metadata) {
+		req.Header.Set("Accept-Encoding", "identity")
+		return
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+}
+
+// gzipBody decompresses a gzip encoded response body.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (g *gzipBody) Read(p []byte) (int, error) {
+	if g.zr == nil && g.err == nil {
+		g.zr, g.err = gzip.NewReader(g.body)
+	}
+	if g.err != nil {
+		return 0, g.err
+	}
+	return g.zr.Read(p)
+}
+
+func (g *gzipBody) Close() error {
+	return g.body.Close()
+}
+
+// decompressResponse decompresses the response to a request which
+// accepted gzip, the way http.Transport does it without
+// DisableCompression.
+func (c *Client) decompressResponse(req *http.Request, resp *http.Response) {
+	if !c.transportCompression || req.Header.Get("Accept-Encoding") != "gzip" ||
+		!strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
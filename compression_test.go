@@ -0,0 +1,128 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestTransportCompression(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	var mu sync.Mutex
+	encodings := map[string]string{}
+	compressed := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		encodings[r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery] = r.Header.Get("Accept-Encoding")
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, r)
+		for k, v := range rec.Header() {
+			w.Header()[k] = v
+		}
+		body := rec.Body.Bytes()
+		if r.Header.Get("Accept-Encoding") == "gzip" && len(body) > 0 {
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			zw.Write(body)
+			zw.Close()
+			body = buf.Bytes()
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			compressed++
+		}
+		w.WriteHeader(rec.Code)
+		w.Write(body)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(strings.TrimPrefix(proxy.URL, "http://"), &Options{
+		Creds:                credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:               srv.Region,
+		TransportCompression: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	content := strings.Repeat("compressible ", 100)
+	if _, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader(content), int64(len(content)), PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	for obj := range clnt.ListObjects(ctx, "bucket", ListObjectsOptions{}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+		keys = append(keys, obj.Key)
+	}
+	if len(keys) != 1 || keys[0] != "object" {
+		t.Fatalf("unexpected listing %v", keys)
+	}
+	if _, err = clnt.GetObjectTagging(ctx, "bucket", "object", GetObjectTaggingOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.GetObjectTagging(ctx, "bucket", "missing", GetObjectTaggingOptions{}); ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected compressed NoSuchKey error, got %v", err)
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != content {
+		t.Fatalf("unexpected content %q", data)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for req, e := range encodings {
+		expected := "gzip"
+		if req == "GET /bucket/object?" || req == "HEAD /bucket/object?" {
+			expected = "identity"
+		}
+		if e != expected {
+			t.Errorf("%s: expected Accept-Encoding %s, got %q", req, expected, e)
+		}
+	}
+	if compressed == 0 {
+		t.Error("expected compressed responses")
+	}
+}
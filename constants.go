@@ -80,6 +80,9 @@ const (
 	// Website redirect location header
 	amzWebsiteRedirectLocation = "X-Amz-Website-Redirect-Location"
 
+	// Bucket region header
+	amzBucketRegion = "X-Amz-Bucket-Region"
+
 	// GetObjectAttributes headers
 	amzPartNumberMarker    = "X-Amz-Part-Number-Marker"
 	amzExpectedBucketOnwer = "X-Amz-Expected-Bucket-Owner"
@@ -96,6 +99,7 @@ const (
 	amzRestore           = "X-Amz-Restore"
 	amzReplicationStatus = "X-Amz-Replication-Status"
 	amzDeleteMarker      = "X-Amz-Delete-Marker"
+	amzMpPartsCount      = "X-Amz-Mp-Parts-Count"
 
 	// Object legal hold header
 	amzLegalHoldHeader = "X-Amz-Object-Lock-Legal-Hold"
@@ -127,4 +131,8 @@ const (
 	minioTgtReplicationReady = "X-Minio-Replication-Ready"
 	// Header asks if delete marker replication request can be sent by source now.
 	isMinioTgtReplicationReady = "X-Minio-Check-Replication-Ready"
+
+	// MinIO extracts uploaded tar archives with these headers set
+	minIOSnowballAutoExtract = "X-Amz-Meta-Snowball-Auto-Extract"
+	minIOSnowballPrefix      = "X-Amz-Meta-Minio-Snowball-Prefix"
 )
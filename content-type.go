@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// sniffLen is the number of bytes used to sniff the content type,
+// see http.DetectContentType.
+const sniffLen = 512
+
+var (
+	contentTypesMu sync.RWMutex
+	// contentTypes complements the system MIME table with types it
+	// often lacks.
+	contentTypes = map[string]string{
+		".avif":    "image/avif",
+		".heic":    "image/heic",
+		".jxl":     "image/jxl",
+		".webp":    "image/webp",
+		".wasm":    "application/wasm",
+		".mjs":     "text/javascript",
+		".js":      "text/javascript",
+		".json":    "application/json",
+		".map":     "application/json",
+		".md":      "text/markdown",
+		".yaml":    "application/yaml",
+		".yml":     "application/yaml",
+		".toml":    "application/toml",
+		".woff2":   "font/woff2",
+		".opus":    "audio/ogg",
+		".webm":    "video/webm",
+		".mp4":     "video/mp4",
+		".m4a":     "audio/mp4",
+		".parquet": "application/vnd.apache.parquet",
+		".zst":     "application/zstd",
+		".gz":      "application/gzip",
+		".tar":     "application/x-tar",
+	}
+)
+
+// RegisterContentType adds or replaces the content type detected for
+// object names ending with the extension ext, e.g. ".wasm".
+func RegisterContentType(ext, contentType string) {
+	contentTypesMu.Lock()
+	defer contentTypesMu.Unlock()
+	contentTypes[strings.ToLower(ext)] = contentType
+}
+
+// contentTypeByExtension returns the content type for the extension of
+// name, empty if it is not known.
+func contentTypeByExtension(name string) string {
+	ext := strings.ToLower(path.Ext(name))
+	if ext == "" {
+		return ""
+	}
+	contentTypesMu.RLock()
+	contentType, found := contentTypes[ext]
+	contentTypesMu.RUnlock()
+	if found {
+		return contentType
+	}
+	return mime.TypeByExtension(ext)
+}
+
+// detectContentType returns the content type of an upload by the
+// extension of the object name or else by sniffing the first bytes of
+// reader. The returned reader must be used in place of reader.
+func detectContentType(objectName string, reader io.Reader) (string, io.Reader, error) {
+	if contentType := contentTypeByExtension(objectName); contentType != "" {
+		return contentType, reader, nil
+	}
+
+	head := make([]byte, sniffLen)
+	if seeker, ok := reader.(io.Seeker); ok {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return "", nil, err
+		}
+		n, err := io.ReadFull(reader, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return "", nil, err
+		}
+		if _, err = seeker.Seek(offset, io.SeekStart); err != nil {
+			return "", nil, err
+		}
+		return http.DetectContentType(head[:n]), reader, nil
+	}
+
+	n, err := io.ReadFull(reader, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), reader), nil
+}
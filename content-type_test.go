@@ -0,0 +1,132 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectContentType(t *testing.T) {
+	RegisterContentType(".custom", "application/x-custom")
+
+	png := "\x89PNG\x0D\x0A\x1A\x0A" + strings.Repeat("x", 1024)
+	testCases := []struct {
+		name, data, want string
+	}{
+		{"module.wasm", "\x00asm", "application/wasm"},
+		{"photo.AVIF", "", "image/avif"},
+		{"app.mjs", "export {}", "text/javascript"},
+		{"data.custom", "", "application/x-custom"},
+		{"image", png, "image/png"},
+		{"notes", "plain text", "text/plain; charset=utf-8"},
+		{"blob", "", "text/plain; charset=utf-8"},
+	}
+	for i, tc := range testCases {
+		// Sniffing must not consume data of seekable and
+		// non-seekable readers.
+		for _, r := range []io.Reader{strings.NewReader(tc.data), io.MultiReader(strings.NewReader(tc.data))} {
+			got, reader, err := detectContentType(tc.name, r)
+			if err != nil {
+				t.Fatalf("Test %d: %v", i+1, err)
+			}
+			if got != tc.want {
+				t.Errorf("Test %d: expected %s, got %s", i+1, tc.want, got)
+			}
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("Test %d: %v", i+1, err)
+			}
+			if string(data) != tc.data {
+				t.Errorf("Test %d: data was not preserved", i+1)
+			}
+		}
+	}
+}
+
+func TestPutObjectDetectContentType(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	data := []byte("GIF89a" + strings.Repeat("x", 100))
+	for name, opts := range map[string]PutObjectOptions{
+		"detected": {DetectContentType: true},
+		"default":  {},
+		"explicit": {DetectContentType: true, ContentType: "image/x-mine"},
+	} {
+		_, err := clnt.PutObject(ctx, "bucket", name, io.MultiReader(bytes.NewReader(data)), int64(len(data)), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, want := range map[string]string{
+		"detected": "image/gif",
+		"default":  "application/octet-stream",
+		"explicit": "image/x-mine",
+	} {
+		info, err := clnt.StatObject(ctx, "bucket", name, StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.ContentType != want || info.Size != int64(len(data)) {
+			t.Errorf("%s: expected %s, got %s (%d bytes)", name, want, info.ContentType, info.Size)
+		}
+	}
+}
+
+func TestFPutObjectDetectContentType(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	// Only uploads asking for detection use the registered types.
+	RegisterContentType(".fput", "application/x-fput")
+	dir := t.TempDir()
+	data := []byte("GIF89a" + strings.Repeat("x", 100))
+	for _, name := range []string{"image", "data.fput"} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tc := range []struct {
+		file, object string
+		detect       bool
+		want         string
+	}{
+		{"image", "sniffed", true, "image/gif"},
+		{"image", "unknown", false, "application/octet-stream"},
+		{"data.fput", "registered", true, "application/x-fput"},
+		{"data.fput", "system", false, "application/octet-stream"},
+	} {
+		_, err := clnt.FPutObject(ctx, "bucket", tc.object, filepath.Join(dir, tc.file), PutObjectOptions{DetectContentType: tc.detect})
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := clnt.StatObject(ctx, "bucket", tc.object, StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.ContentType != tc.want || info.Size != int64(len(data)) {
+			t.Errorf("%s: expected %s, got %s (%d bytes)", tc.object, tc.want, info.ContentType, info.Size)
+		}
+	}
+}
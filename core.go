@@ -20,9 +20,12 @@ package minio
 import (
 	"context"
 	"io"
+	"maps"
 	"net/http"
+	"sort"
 
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/tags"
 )
 
 // Core - Inherits Client and adds new methods to expose the low level S3 APIs.
@@ -71,12 +74,38 @@ func (c Core) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBuck
 
 // PutObject - Upload object. Uploads using single PUT call.
 func (c Core) PutObject(ctx context.Context, bucket, object string, data io.Reader, size int64, md5Base64, sha256Hex string, opts PutObjectOptions) (UploadInfo, error) {
-	hookReader := newHook(data, opts.Progress)
+	if opts.OnProgress != nil {
+		opts.progress = newProgressTracker(opts.OnProgress, size)
+	}
+	hookReader := newHook(data, opts.progressHook())
 	return c.putObjectDo(ctx, bucket, object, hookReader, md5Base64, sha256Hex, size, opts)
 }
 
 // NewMultipartUpload - Initiates new multipart upload and returns the new uploadID.
+//
+// All object properties of opts are applied the way PutObject does, e.g.
+// tags, retention, legal hold, SSE, storage class and custom headers.
+// If opts.Checksum is set the upload announces the checksum algorithm,
+// every part must then be uploaded with a checksum of that type.
+// Options about how data is uploaded, e.g. PartSize, are ignored.
 func (c Core) NewMultipartUpload(ctx context.Context, bucket, object string, opts PutObjectOptions) (uploadID string, err error) {
+	if opts.Mode != "" && !opts.Mode.IsValid() {
+		return "", errInvalidArgument(opts.Mode.String() + " unsupported retention mode")
+	}
+	if opts.LegalHold != "" && !opts.LegalHold.IsValid() {
+		return "", errInvalidArgument(opts.LegalHold.String() + " unsupported legal-hold status")
+	}
+	// PutObjectOptions.Header drops invalid tags.
+	if len(opts.UserTags) != 0 {
+		if _, err = tags.NewTags(opts.UserTags, true); err != nil {
+			return "", err
+		}
+	}
+	if opts.Checksum.IsSet() {
+		opts.UserMetadata = maps.Clone(opts.UserMetadata)
+		opts.AutoChecksum = opts.Checksum
+		addAutoChecksumHeaders(&opts)
+	}
 	result, err := c.initiateMultipartUpload(ctx, bucket, object, opts)
 	return result.UploadID, err
 }
@@ -120,6 +149,26 @@ func (c Core) ListObjectParts(ctx context.Context, bucket, object, uploadID stri
 	return c.listObjectPartsQuery(ctx, bucket, object, uploadID, partNumberMarker, maxParts)
 }
 
+// GetUploadedPartsAll - List all uploaded parts of an incomplete upload
+// ordered by part number, including their checksums. Use it to find the
+// parts left to upload when resuming an upload.
+func (c Core) GetUploadedPartsAll(ctx context.Context, bucket, object, uploadID string) ([]ObjectPart, error) {
+	var parts []ObjectPart
+	p := c.ListObjectPartsPaginator(bucket, object, uploadID, 1000)
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, page...)
+	}
+	// Servers list parts in order, do not rely on it.
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].PartNumber < parts[j].PartNumber
+	})
+	return parts, nil
+}
+
 // CompleteMultipartUpload - Concatenate uploaded parts and commit to an object.
 func (c Core) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []CompletePart, opts PutObjectOptions) (UploadInfo, error) {
 	res, err := c.completeMultipartUpload(ctx, bucket, object, uploadID, completeMultipartUpload{
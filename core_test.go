@@ -23,13 +23,16 @@ import (
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/jie123108/minio-go/v7/pkg/credentials"
 	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
 )
 
 const (
@@ -896,3 +899,127 @@ func TestCoreMultipartUpload(t *testing.T) {
 		t.Fatal("Error: ", err)
 	}
 }
+
+func TestCoreGetUploadedPartsAll(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	core := Core{clnt}
+	ctx := context.Background()
+	uploadID, err := core.NewMultipartUpload(ctx, "bucket", "object", PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Upload the parts out of order, with checksums.
+	for _, partID := range []int{3, 1, 2} {
+		data := bytes.Repeat([]byte{byte('a' + partID)}, partID)
+		opts := PutObjectPartOptions{CustomHeader: make(http.Header)}
+		opts.CustomHeader.Set(ChecksumCRC32C.Key(), ChecksumCRC32C.EncodeToString(data))
+		if _, err = core.PutObjectPart(ctx, "bucket", "object", uploadID, partID, bytes.NewReader(data), int64(len(data)), opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parts, err := core.GetUploadedPartsAll(ctx, "bucket", "object", uploadID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+	for i, part := range parts {
+		data := bytes.Repeat([]byte{byte('a' + i + 1)}, i+1)
+		if part.PartNumber != i+1 || part.Size != int64(i+1) || strings.Contains(part.ETag, `"`) {
+			t.Errorf("unexpected part %+v", part)
+		}
+		if got, want := part.Checksum(ChecksumCRC32C), ChecksumCRC32C.EncodeToString(data); got != want {
+			t.Errorf("part %d: expected checksum %s, got %s", part.PartNumber, want, got)
+		}
+	}
+
+	// The paginator follows the part number marker.
+	p := core.ListObjectPartsPaginator("bucket", "object", uploadID, 2)
+	var pages []int
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, len(page))
+	}
+	if len(pages) != 2 || pages[0] != 2 || pages[1] != 1 {
+		t.Fatalf("expected pages of 2 and 1 parts, got %v", pages)
+	}
+
+	if _, err = core.GetUploadedPartsAll(ctx, "bucket", "object", "unknown"); ToErrorResponse(err).Code != "NoSuchUpload" {
+		t.Fatalf("expected NoSuchUpload, got %v", err)
+	}
+}
+
+func TestCoreNewMultipartUploadOptions(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Record the headers of the initiate request.
+	var header http.Header
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Query().Has("uploads") {
+			header = r.Header.Clone()
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	core := Core{clnt}
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	metadata := map[string]string{"Owner": "team"}
+	opts := PutObjectOptions{
+		UserMetadata:         metadata,
+		UserTags:             map[string]string{"env": "prod"},
+		Mode:                 Governance,
+		RetainUntilDate:      until,
+		LegalHold:            LegalHoldEnabled,
+		StorageClass:         "REDUCED_REDUNDANCY",
+		ServerSideEncryption: encrypt.NewSSE(),
+		Checksum:             ChecksumFullObjectCRC32C,
+	}
+	if err = opts.SetSystemHeader("Cache-Control", "no-cache"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = core.NewMultipartUpload(ctx, "bucket", "object", opts); err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[string]string{
+		"X-Amz-Meta-Owner":                    "team",
+		"X-Amz-Tagging":                       "env=prod",
+		"X-Amz-Object-Lock-Mode":              "GOVERNANCE",
+		"X-Amz-Object-Lock-Retain-Until-Date": until.Format(time.RFC3339),
+		"X-Amz-Object-Lock-Legal-Hold":        "ON",
+		"X-Amz-Storage-Class":                 "REDUCED_REDUNDANCY",
+		"X-Amz-Server-Side-Encryption":        "AES256",
+		"X-Amz-Checksum-Algorithm":            "CRC32C",
+		"X-Amz-Checksum-Type":                 "FULL_OBJECT",
+		"Cache-Control":                       "no-cache",
+	} {
+		if got := header.Get(k); got != want {
+			t.Errorf("%s: expected %q, got %q", k, want, got)
+		}
+	}
+	if len(metadata) != 1 {
+		t.Fatalf("user metadata of the options was modified: %v", metadata)
+	}
+
+	opts = PutObjectOptions{UserTags: map[string]string{"": "empty"}}
+	if _, err = core.NewMultipartUpload(ctx, "bucket", "object", opts); err == nil {
+		t.Fatal("expected invalid tags to fail")
+	}
+}
@@ -48,24 +48,16 @@ func main() {
 
 	// List 'N' number of objects from a bucket-name with a matching prefix.
 	listObjectsN := func(bucket, prefix string, recursive bool, N int) (objsInfo []minio.ObjectInfo, err error) {
-		ctx, cancel := context.WithCancel(context.Background())
-		// Indicate ListObjects go-routine to exit and stop feeding the objectInfo channel.
-		defer cancel()
-		i := 1
 		opts := minio.ListObjectsOptions{
-			UseV1:     true,
 			Prefix:    prefix,
 			Recursive: recursive,
+			// Stop listing after N entries, no more are requested from the server.
+			Limit: N,
 		}
-		for object := range s3Client.ListObjects(ctx, bucket, opts) {
+		for object := range s3Client.ListObjects(context.Background(), bucket, opts) {
 			if object.Err != nil {
 				return nil, object.Err
 			}
-			i++
-			// Verify if we have printed N objects.
-			if i == N {
-				break
-			}
 			objsInfo = append(objsInfo, object)
 		}
 		return objsInfo, nil
@@ -0,0 +1,52 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+)
+
+type expectedBucketOwnerKey struct{}
+
+// WithExpectedBucketOwner returns a context making the API calls it is
+// passed to expect the buckets they access to be owned by the account
+// accountID, overriding Options.ExpectedBucketOwner. An empty
+// accountID disables the check.
+//
+//	ctx = minio.WithExpectedBucketOwner(ctx, "111122223333")
+//	_, err = client.PutObject(ctx, "bucket", "object", reader, size, minio.PutObjectOptions{})
+func WithExpectedBucketOwner(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, expectedBucketOwnerKey{}, accountID)
+}
+
+// setExpectedBucketOwner sets the x-amz-expected-bucket-owner header of
+// the request, unless already set, to the expected bucket owner of its
+// context or else of the client.
+func (c *Client) setExpectedBucketOwner(req *http.Request) {
+	if req.Header.Get(amzExpectedBucketOnwer) != "" {
+		return
+	}
+	owner, ok := req.Context().Value(expectedBucketOwnerKey{}).(string)
+	if !ok {
+		owner = c.bucketOwner
+	}
+	if owner != "" {
+		req.Header.Set(amzExpectedBucketOnwer, owner)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+// bucketOwnerTransport records the expected bucket owners of requests.
+type bucketOwnerTransport struct {
+	mu     sync.Mutex
+	owners []string
+}
+
+func (t *bucketOwnerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.owners = append(t.owners, req.Header.Get(amzExpectedBucketOnwer))
+	t.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (t *bucketOwnerTransport) reset() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	owners := t.owners
+	t.owners = nil
+	return owners
+}
+
+func TestExpectedBucketOwner(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	transport := &bucketOwnerTransport{}
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:               credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:              srv.Region,
+		Transport:           transport,
+		ExpectedBucketOwner: "111122223333",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"object": "data"})
+	transport.reset()
+
+	for _, tc := range []struct {
+		ctx  context.Context
+		want string
+	}{
+		{ctx, "111122223333"},
+		{WithExpectedBucketOwner(ctx, "444455556666"), "444455556666"},
+		{WithExpectedBucketOwner(ctx, ""), ""},
+	} {
+		if _, err = clnt.StatObject(tc.ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		for info := range clnt.ListObjects(tc.ctx, "bucket", ListObjectsOptions{}) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+		}
+		owners := transport.reset()
+		if len(owners) != 2 || owners[0] != tc.want || owners[1] != tc.want {
+			t.Fatalf("expected bucket owner %q, got %q", tc.want, owners)
+		}
+	}
+
+	if _, err = clnt.ListBuckets(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if owners := transport.reset(); len(owners) != 1 || owners[0] != "" {
+		t.Fatalf("expected no bucket owner for ListBuckets, got %q", owners)
+	}
+}
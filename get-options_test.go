@@ -18,8 +18,11 @@
 package minio
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestSetHeader(t *testing.T) {
@@ -99,3 +102,60 @@ func TestCustomQueryParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestResponseOverrides(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	putTestObjects(t, clnt, map[string]string{"report": "data"})
+
+	opts := GetObjectOptions{}
+	if err := opts.SetResponseAttachment(""); err == nil {
+		t.Fatal("expected error for empty filename")
+	}
+	if err := opts.SetResponseAttachment("Bericht März.csv"); err != nil {
+		t.Fatal(err)
+	}
+	opts.SetResponseContentType("text/csv")
+	opts.SetResponseContentEncoding("identity")
+
+	const disposition = "attachment; filename*=utf-8''Bericht%20M%C3%A4rz.csv"
+	if got := opts.reqParams.Get("response-content-disposition"); got != disposition {
+		t.Fatalf("expected disposition %s, got %s", disposition, got)
+	}
+
+	u, err := clnt.PresignedGetObjectWithOptions(ctx, "bucket", "report", time.Minute, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(u.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %s", resp.Status)
+	}
+	want := map[string]string{
+		"Content-Disposition": disposition,
+		"Content-Type":        "text/csv",
+		"Content-Encoding":    "identity",
+	}
+	for k, v := range want {
+		if got := resp.Header.Get(k); got != v {
+			t.Errorf("expected %s: %s, got %s", k, v, got)
+		}
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "report", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	info, err := obj.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "text/csv" || info.Metadata.Get("Content-Disposition") != disposition {
+		t.Errorf("unexpected response headers %v", info.Metadata)
+	}
+}
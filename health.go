@@ -0,0 +1,244 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// healthProbeTimeout bounds a single health probe.
+const healthProbeTimeout = 3 * time.Second
+
+// healthLatencyWindow is the number of recent probes the latency
+// percentiles of HealthStats are computed from.
+const healthLatencyWindow = 128
+
+// HealthCheckOptions configures HealthCheckWithOptions.
+type HealthCheckOptions struct {
+	// Interval between probes, at least one second.
+	Interval time.Duration
+
+	// ProbeOnline probes the endpoint also while it is online, to
+	// notice outages without failing requests and to measure the
+	// latency continuously. By default only offline endpoints are
+	// probed until they are back online.
+	ProbeOnline bool
+
+	// OnStateChange is called when the endpoint goes offline, because
+	// a request or probe failed to reach it, or back online.
+	OnStateChange func(online bool)
+}
+
+// HealthStats are the results of the health probes of HealthCheck.
+type HealthStats struct {
+	Online bool
+	// Probes is the number of probes made, Failures the number of
+	// probes which did not reach the endpoint.
+	Probes   int
+	Failures int
+	// Latency percentiles of the recent successful probes, zero
+	// before the first one.
+	P50, P90, P99 time.Duration
+}
+
+// healthMonitor records the probes of a running health check.
+type healthMonitor struct {
+	onStateChange func(online bool)
+
+	mu        sync.Mutex
+	probes    int
+	failures  int
+	latencies []time.Duration // ring buffer of recent latencies
+	next      int
+}
+
+func (m *healthMonitor) record(latency time.Duration, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.probes++
+	if !ok {
+		m.failures++
+		return
+	}
+	if len(m.latencies) < healthLatencyWindow {
+		m.latencies = append(m.latencies, latency)
+		return
+	}
+	m.latencies[m.next] = latency
+	m.next = (m.next + 1) % healthLatencyWindow
+}
+
+func (m *healthMonitor) stats() HealthStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := HealthStats{Probes: m.probes, Failures: m.failures}
+	if len(m.latencies) == 0 {
+		return s
+	}
+	sorted := slices.Clone(m.latencies)
+	slices.Sort(sorted)
+	percentile := func(p int) time.Duration {
+		return sorted[(len(sorted)-1)*p/100]
+	}
+	s.P50, s.P90, s.P99 = percentile(50), percentile(90), percentile(99)
+	return s
+}
+
+// monitor returns the monitor of the running health check, if any.
+func (c *Client) monitor() *healthMonitor {
+	m, _ := c.healthMonitor.Load().(*healthMonitor)
+	return m
+}
+
+// setHealth changes the health status from old to new, and reports
+// the change to the running health check.
+func (c *Client) setHealth(old, new int32) {
+	if !atomic.CompareAndSwapInt32(&c.healthStatus, old, new) {
+		return
+	}
+	if m := c.monitor(); m != nil && m.onStateChange != nil {
+		m.onStateChange(new == online)
+	}
+}
+
+// HealthStats returns the results of the probes of the running health
+// check, see HealthCheckWithOptions.
+func (c *Client) HealthStats() HealthStats {
+	var s HealthStats
+	if m := c.monitor(); m != nil {
+		s = m.stats()
+	}
+	s.Online = c.IsOnline()
+	return s
+}
+
+// probeHealth sends a bucket location request for a bucket which does
+// not exist, any answer but a server error shows the endpoint is up.
+func (c *Client) probeHealth(ctx context.Context, probeBucketName string) bool {
+	ctx, cancel := context.WithTimeout(unscheduled(ctx), healthProbeTimeout)
+	defer cancel()
+	start := time.Now()
+	ok := false
+	if req, err := c.getBucketLocationRequest(ctx, probeBucketName); err == nil {
+		resp, err := c.do(req)
+		ok = err == nil && resp.StatusCode < http.StatusInternalServerError
+		closeResponse(resp)
+	}
+	if m := c.monitor(); m != nil {
+		m.record(time.Since(start), ok)
+	}
+	return ok
+}
+
+// HealthCheckWithOptions starts a health check like HealthCheck, with
+// latency statistics, see HealthStats, and state change notifications.
+func (c *Client) HealthCheckWithOptions(opts HealthCheckOptions) (context.CancelFunc, error) {
+	if opts.Interval < 1*time.Second {
+		return nil, fmt.Errorf("health check duration should be at least 1 second")
+	}
+	if !atomic.CompareAndSwapInt32(&c.healthStatus, unknown, offline) {
+		return nil, fmt.Errorf("health check is running")
+	}
+	c.healthMonitor.Store(&healthMonitor{onStateChange: opts.OnStateChange})
+
+	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-health-")
+	ctx, cancelFn := context.WithCancel(context.Background())
+	probe := func() {
+		if c.probeHealth(ctx, probeBucketName) {
+			c.setHealth(offline, online)
+		} else {
+			c.setHealth(online, offline)
+		}
+	}
+	// Change to online, if we can connect.
+	probe()
+
+	go func(duration time.Duration) {
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				atomic.StoreInt32(&c.healthStatus, unknown)
+				return
+			case <-timer.C:
+				// Only probe endpoints marked offline, unless asked otherwise.
+				if opts.ProbeOnline || c.IsOffline() {
+					probe()
+				}
+				timer.Reset(duration)
+			}
+		}
+	}(opts.Interval)
+	return cancelFn, nil
+}
+
+// Warmup opens n connections to the endpoint in parallel, which are
+// kept for reuse up to the idle connection limit of the transport, and
+// caches the region of buckets, so that the first requests do not wait
+// for TLS handshakes and bucket location lookups. It fails if no
+// connection could be opened or a bucket region lookup failed.
+func (c *Client) Warmup(ctx context.Context, n int, buckets ...string) error {
+	if n < 0 {
+		return errInvalidArgument("Number of warmup connections cannot be negative")
+	}
+	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-warmup-")
+	var (
+		wg        sync.WaitGroup
+		connected atomic.Int32
+		errs      = make([]error, n)
+	)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := c.getBucketLocationRequest(ctx, probeBucketName)
+			if err == nil {
+				var resp *http.Response
+				resp, err = c.do(req)
+				// Drain the response so that the connection is reused.
+				closeResponse(resp)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			connected.Add(1)
+		}()
+	}
+	wg.Wait()
+	if n > 0 && connected.Load() == 0 {
+		return errors.Join(errs...)
+	}
+
+	errs = errs[:0]
+	for _, bucket := range buckets {
+		if _, err := c.getBucketLocation(ctx, bucket); err != nil {
+			errs = append(errs, fmt.Errorf("bucket %s: %w", bucket, err))
+		}
+	}
+	return errors.Join(errs...)
+}
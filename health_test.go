@@ -0,0 +1,124 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestWarmup(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var conns atomic.Int32
+	proxy := httptest.NewUnstartedServer(srv)
+	proxy.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	proxy.Start()
+	defer proxy.Close()
+
+	// Without a region the bucket locations are looked up.
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.Warmup(context.Background(), 4, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if conns.Load() < 2 {
+		t.Fatalf("expected parallel connections, got %d", conns.Load())
+	}
+	if _, ok := clnt.bucketLocCache.Get("bucket"); !ok {
+		t.Fatal("expected the bucket location to be cached")
+	}
+
+	down, err := New("127.0.0.1:1", &Options{MaxRetries: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = down.Warmup(context.Background(), 2); err == nil {
+		t.Fatal("expected the warmup of an unreachable endpoint to fail")
+	}
+	if err = clnt.Warmup(context.Background(), -1); ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestHealthCheckWithOptions(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var unavailable atomic.Bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	clnt, err := New(proxy.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes := make(chan bool, 4)
+	cancel, err := clnt.HealthCheckWithOptions(HealthCheckOptions{
+		Interval:      time.Second,
+		ProbeOnline:   true,
+		OnStateChange: func(online bool) { changes <- online },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	if _, err = clnt.HealthCheck(time.Second); err == nil {
+		t.Fatal("expected a second health check to fail")
+	}
+
+	if online := <-changes; !online || clnt.IsOffline() {
+		t.Fatal("expected the endpoint to be online")
+	}
+	unavailable.Store(true)
+	select {
+	case online := <-changes:
+		if online || clnt.IsOnline() {
+			t.Fatal("expected the endpoint to be offline")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the probe to notice the outage")
+	}
+
+	stats := clnt.HealthStats()
+	if stats.Online || stats.Probes < 2 || stats.Failures < 1 || stats.P50 <= 0 || stats.P99 < stats.P50 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/encrypt"
+)
+
+// redactedHeaders are the request headers whose values are not logged.
+var redactedHeaders = map[string]bool{
+	"Authorization":            true,
+	"X-Amz-Security-Token":     true,
+	encrypt.SseCustomerKey:     true,
+	encrypt.SseCopyCustomerKey: true,
+}
+
+// requestOperation names the operation of a request by its method and
+// sub-resources, e.g. "PUT ?partNumber&uploadId", without the values
+// of the query parameters.
+func requestOperation(method string, query url.Values) string {
+	if len(query) == 0 {
+		return method
+	}
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return method + " ?" + strings.Join(keys, "&")
+}
+
+// headerAttrs returns the headers as log attributes, with the values
+// of credentials and encryption keys redacted.
+func headerAttrs(h http.Header) []any {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	attrs := make([]any, 0, len(keys))
+	for _, k := range keys {
+		v := strings.Join(h[k], ",")
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			v = "REDACTED"
+		}
+		attrs = append(attrs, slog.String(k, v))
+	}
+	return attrs
+}
+
+// logRequest writes the structured log entry of a request made by
+// executeMethod with Options.Logger, with the connection-level timings
+// of its last attempt. Successful requests are logged at debug level
+// with their headers, failed ones at warning level.
+func (c *Client) logRequest(ctx context.Context, method string, metadata requestMetadata, req *http.Request, start time.Time, attempts int, timings RequestTimings, res *http.Response, err error) {
+	level := slog.LevelDebug
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+	if err != nil || status >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []any{
+		slog.String("operation", requestOperation(method, metadata.queryValues)),
+		slog.String("bucket", metadata.bucketName),
+		slog.String("object", metadata.objectName),
+		slog.Int("status", status),
+		slog.Duration("duration", time.Since(start)),
+		slog.Int("retries", max(attempts-1, 0)),
+		slog.Int64("bytes_sent", max(metadata.contentLength, 0)),
+		slog.Group("timings",
+			slog.Bool("reused_conn", timings.ReusedConn),
+			slog.Duration("dns", timings.DNSLookup),
+			slog.Duration("connect", timings.Connect),
+			slog.Duration("tls", timings.TLSHandshake),
+			slog.Duration("server", timings.ServerProcessing),
+			slog.Duration("ttfb", timings.TimeToFirstByte)),
+	}
+	if res != nil {
+		attrs = append(attrs,
+			slog.Int64("bytes_received", max(res.ContentLength, 0)),
+			slog.String("request_id", res.Header.Get("X-Amz-Request-Id")))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	if c.logger.Enabled(ctx, slog.LevelDebug) {
+		if req != nil {
+			attrs = append(attrs, slog.Group("request_header", headerAttrs(req.Header)...))
+		}
+		if res != nil {
+			attrs = append(attrs, slog.Group("response_header", headerAttrs(res.Header)...))
+		}
+	}
+	c.logger.Log(ctx, level, "S3 request", attrs...)
+}
@@ -0,0 +1,80 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestLogger(t *testing.T) {
+	srv, _ := newTestServerClient(t)
+	var buf bytes.Buffer
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+		Logger: slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader("hello"), 5, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "missing", StatObjectOptions{}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	var entries []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var entry map[string]any
+		if err = json.Unmarshal(line, &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d: %s", len(entries), buf.String())
+	}
+
+	put := entries[0]
+	if put["level"] != "DEBUG" || put["operation"] != "PUT" || put["bucket"] != "bucket" ||
+		put["object"] != "object" || put["status"] != float64(200) || put["bytes_sent"] != float64(5) {
+		t.Fatalf("unexpected entry: %v", put)
+	}
+	header, ok := put["request_header"].(map[string]any)
+	if !ok || header["Authorization"] != "REDACTED" {
+		t.Fatalf("expected a redacted authorization header, got %v", put["request_header"])
+	}
+
+	stat := entries[1]
+	if stat["level"] != "WARN" || stat["operation"] != "HEAD" || stat["status"] != float64(404) {
+		t.Fatalf("unexpected entry: %v", stat)
+	}
+	if strings.Contains(buf.String(), "Credential=") {
+		t.Fatal("credentials were logged")
+	}
+}
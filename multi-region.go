@@ -0,0 +1,171 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3utils"
+)
+
+// MultiRegionOptions holds the options of NewMultiRegion.
+type MultiRegionOptions struct {
+	// Options of the clients of all regions. Region is the region of
+	// requests without bucket, used to look up the location of
+	// buckets, "us-east-1" if not set.
+	Options
+
+	// Endpoint of the clients, e.g. "s3.amazonaws.com". The host of
+	// AWS endpoints is the one of the region of each bucket.
+	Endpoint string
+
+	// RegionEndpoint, if set, returns the endpoint of the client of a
+	// region instead of Endpoint, for deployments with an endpoint
+	// per region.
+	RegionEndpoint func(region string) string
+}
+
+// MultiRegionClient routes the requests of each bucket to a client of
+// the region of the bucket, see NewMultiRegion.
+type MultiRegionClient struct {
+	opts      MultiRegionOptions
+	locations *bucketLocationCache
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewMultiRegion returns a client for buckets spread over regions. It
+// keeps a client per region, created on first use with opts, and
+// looks up the location of each bucket once to route its requests to
+// the client of its region:
+//
+//	mc, err := minio.NewMultiRegion(minio.MultiRegionOptions{
+//		Options:  minio.Options{Creds: creds, Secure: true},
+//		Endpoint: "s3.amazonaws.com",
+//	})
+//	...
+//	err = mc.Do(ctx, "bucket", func(c *minio.Client) error {
+//		_, err := c.PutObject(ctx, "bucket", "object", reader, size, minio.PutObjectOptions{})
+//		return err
+//	})
+func NewMultiRegion(opts MultiRegionOptions) (*MultiRegionClient, error) {
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	m := &MultiRegionClient{
+		opts:      opts,
+		locations: newBucketLocationCache(),
+		clients:   make(map[string]*Client),
+	}
+	// Catch invalid options early.
+	if _, err := m.RegionClient(opts.Region); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RegionClient returns the client of the region.
+func (m *MultiRegionClient) RegionClient(region string) (*Client, error) {
+	if region == "" {
+		region = m.opts.Region
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.clients[region]; ok {
+		return c, nil
+	}
+	opts := m.opts.Options
+	opts.Region = region
+	endpoint := m.opts.Endpoint
+	if m.opts.RegionEndpoint != nil {
+		endpoint = m.opts.RegionEndpoint(region)
+	}
+	c, err := New(endpoint, &opts)
+	if err != nil {
+		return nil, err
+	}
+	m.clients[region] = c
+	return c, nil
+}
+
+// Client returns the client of the region of the bucket, looking up
+// its location on first use.
+func (m *MultiRegionClient) Client(ctx context.Context, bucketName string) (*Client, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	region, ok := m.locations.Get(bucketName)
+	if !ok {
+		c, err := m.RegionClient(m.opts.Region)
+		if err != nil {
+			return nil, err
+		}
+		if region, err = c.LookupBucketLocation(ctx, bucketName); err != nil {
+			return nil, err
+		}
+		m.locations.Set(bucketName, region)
+	}
+	return m.RegionClient(region)
+}
+
+// Do calls fn with the client of the region of the bucket. If the
+// bucket turns out to be in another region, the server answering with
+// a PermanentRedirect error, e.g. as it was recreated elsewhere, fn is
+// called again with the client of that region.
+func (m *MultiRegionClient) Do(ctx context.Context, bucketName string, fn func(c *Client) error) error {
+	c, err := m.Client(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	err = fn(c)
+	region, moved := m.movedRegion(ctx, bucketName, c, err)
+	if !moved {
+		return err
+	}
+	m.locations.Set(bucketName, region)
+	if c, err = m.RegionClient(region); err != nil {
+		return err
+	}
+	return fn(c)
+}
+
+// movedRegion returns the region the bucket moved to if err redirects
+// to another region than the one of c.
+func (m *MultiRegionClient) movedRegion(ctx context.Context, bucketName string, c *Client, err error) (string, bool) {
+	errResp := ToErrorResponse(err)
+	if errResp.Code != "PermanentRedirect" && errResp.StatusCode != http.StatusMovedPermanently {
+		return "", false
+	}
+	region := errResp.Region
+	if region == "" {
+		var lerr error
+		if region, lerr = c.LookupBucketLocation(ctx, bucketName); lerr != nil {
+			return "", false
+		}
+	}
+	return region, region != c.region
+}
+
+// ForgetBucket forgets the location of the bucket, looked up again on
+// next use, e.g. once the bucket is removed.
+func (m *MultiRegionClient) ForgetBucket(bucketName string) {
+	m.locations.Delete(bucketName)
+}
@@ -0,0 +1,134 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+// regionScope matches the region of the credential scope of requests.
+var regionScope = regexp.MustCompile(`Credential=[^/]+/\d+/([^/]+)/`)
+
+// regionProxy redirects requests signed for another region than the
+// one of their bucket like AWS S3, and reports the locations set in
+// locations to GetBucketLocation requests.
+type regionProxy struct {
+	srv *miniotest.Server
+
+	mu        sync.Mutex
+	regions   map[string]string
+	locations map[string]string
+}
+
+func (p *regionProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	p.mu.Lock()
+	region, location := p.regions[bucket], p.locations[bucket]
+	p.mu.Unlock()
+	if r.URL.Query().Has("location") && location != "" {
+		fmt.Fprintf(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">%s</LocationConstraint>`, location)
+		return
+	}
+	m := regionScope.FindStringSubmatch(r.Header.Get("Authorization"))
+	if region != "" && m != nil && m[1] != region && !r.URL.Query().Has("location") {
+		w.Header().Set("x-amz-bucket-region", region)
+		w.WriteHeader(http.StatusMovedPermanently)
+		return
+	}
+	p.srv.ServeHTTP(w, r)
+}
+
+func TestMultiRegion(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	proxy := &regionProxy{
+		srv:       srv,
+		regions:   map[string]string{"east": "us-east-1", "west": "eu-west-1", "moved": "ap-south-1"},
+		locations: map[string]string{"moved": "eu-west-1"},
+	}
+	ts := httptest.NewServer(proxy)
+	defer ts.Close()
+
+	mc, err := NewMultiRegion(MultiRegionOptions{
+		Options:  Options{Creds: credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, "")},
+		Endpoint: ts.Listener.Addr().String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for bucket, region := range proxy.regions {
+		c, err := mc.RegionClient(region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = c.MakeBucket(ctx, bucket, MakeBucketOptions{Region: region}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, bucket := range []string{"east", "west", "moved"} {
+		err = mc.Do(ctx, bucket, func(c *Client) error {
+			_, err := c.PutObject(ctx, bucket, "object", strings.NewReader("data"), 4, PutObjectOptions{})
+			return err
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", bucket, err)
+		}
+		c, err := mc.Client(ctx, bucket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.region != proxy.regions[bucket] {
+			t.Fatalf("expected the client of bucket %s to be in %s, got %s", bucket, proxy.regions[bucket], c.region)
+		}
+		if _, err = c.StatObject(ctx, bucket, "object", StatObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The location cache of clients is bypassed by lookups.
+	c, err := mc.RegionClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.bucketLocCache.Set("west", "us-west-2")
+	if location, err := c.LookupBucketLocation(ctx, "west"); err != nil || location != "eu-west-1" {
+		t.Fatalf("expected eu-west-1, got %q, %v", location, err)
+	}
+	if location, _ := c.bucketLocCache.Get("west"); location != "eu-west-1" {
+		t.Fatalf("expected the location to be cached, got %q", location)
+	}
+
+	mc.ForgetBucket("west")
+	if _, ok := mc.locations.Get("west"); ok {
+		t.Fatal("expected the location of the bucket to be forgotten")
+	}
+	if _, err = mc.Client(ctx, "bad_bucket!"); err == nil {
+		t.Fatal("expected an invalid bucket name to fail")
+	}
+}
@@ -0,0 +1,314 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MultiSiteOptions configures a MultiSiteClient.
+type MultiSiteOptions struct {
+	// WriteQuorum is the number of sites a write must succeed on,
+	// defaults to a majority of the sites.
+	WriteQuorum int
+}
+
+// SiteResult is the outcome of a write on one site.
+type SiteResult struct {
+	// Endpoint of the site, see Client.EndpointURL.
+	Endpoint string
+	// Info of the uploaded object, set by PutObject on success.
+	Info UploadInfo
+	Err  error
+}
+
+// QuorumError is returned by writes which succeeded on fewer sites than
+// the write quorum.
+type QuorumError struct {
+	Quorum    int
+	Succeeded int
+	Results   []SiteResult
+}
+
+func (e *QuorumError) Error() string {
+	var errs []string
+	for _, r := range e.Results {
+		if r.Err != nil {
+			errs = append(errs, r.Endpoint+": "+r.Err.Error())
+		}
+	}
+	return fmt.Sprintf("write succeeded on %d of %d required sites: %s", e.Succeeded, e.Quorum, strings.Join(errs, "; "))
+}
+
+// Unwrap returns the errors of the failed sites.
+func (e *QuorumError) Unwrap() []error {
+	var errs []error
+	for _, r := range e.Results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errs
+}
+
+// MultiSiteClient writes objects to several MinIO sites for client-side
+// active-active replication, and reads them from the nearest healthy
+// site: sites are ordered by the latency of their recent answers, sites
+// which are offline according to Client.HealthCheck or failed to answer
+// come last. Sites are configured independently, e.g. with their own
+// credentials, and should not replicate between each other.
+type MultiSiteClient struct {
+	sites  []*Client
+	quorum int
+	// latency holds the moving average of the read latency of each
+	// site in nanoseconds, zero until the first read.
+	latency []atomic.Int64
+}
+
+// NewMultiSite returns a client writing to and reading from all sites.
+// Sites are preferred for reads in the order given until their latency
+// is known.
+func NewMultiSite(sites []*Client, opts MultiSiteOptions) (*MultiSiteClient, error) {
+	if len(sites) == 0 {
+		return nil, errInvalidArgument("At least one site is required.")
+	}
+	quorum := opts.WriteQuorum
+	if quorum == 0 {
+		quorum = len(sites)/2 + 1
+	}
+	if quorum < 0 || quorum > len(sites) {
+		return nil, errInvalidArgument(fmt.Sprintf("Write quorum must be between 1 and %d.", len(sites)))
+	}
+	return &MultiSiteClient{
+		sites:   slices.Clone(sites),
+		quorum:  quorum,
+		latency: make([]atomic.Int64, len(sites)),
+	}, nil
+}
+
+// Sites returns the clients of all sites.
+func (m *MultiSiteClient) Sites() []*Client {
+	return slices.Clone(m.sites)
+}
+
+// writeAll runs write on all sites in parallel and checks the quorum.
+func (m *MultiSiteClient) writeAll(write func(i int, site *Client) (UploadInfo, error)) ([]SiteResult, error) {
+	results := make([]SiteResult, len(m.sites))
+	var wg sync.WaitGroup
+	for i, site := range m.sites {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info, err := write(i, site)
+			results[i] = SiteResult{Endpoint: site.EndpointURL().String(), Info: info, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, r := range results {
+		if r.Err == nil {
+			succeeded++
+		}
+	}
+	if succeeded < m.quorum {
+		return results, &QuorumError{Quorum: m.quorum, Succeeded: succeeded, Results: results}
+	}
+	return results, nil
+}
+
+// PutObject uploads the object to all sites in parallel and returns
+// the result of each site. It fails with a *QuorumError if fewer sites
+// than the write quorum stored the object, the object is not removed
+// from the other sites. Readers implementing io.ReaderAt with a known
+// size are read independently by each site, other readers are read
+// once and streamed to all sites at the pace of the slowest one.
+// Progress and OnProgress of opts are ignored.
+func (m *MultiSiteClient) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) ([]SiteResult, error) {
+	opts.Progress, opts.OnProgress = nil, nil
+
+	// Each site gets its own copy of the maps of opts, which are
+	// written to while uploading.
+	siteOpts := make([]PutObjectOptions, len(m.sites))
+	for i := range siteOpts {
+		siteOpts[i] = opts
+		siteOpts[i].UserMetadata = maps.Clone(opts.UserMetadata)
+		siteOpts[i].UserTags = maps.Clone(opts.UserTags)
+		siteOpts[i].customHeaders = opts.customHeaders.Clone()
+	}
+
+	readerAt, ok := reader.(io.ReaderAt)
+	if ok && objectSize >= 0 {
+		return m.writeAll(func(i int, site *Client) (UploadInfo, error) {
+			return site.PutObject(ctx, bucketName, objectName, io.NewSectionReader(readerAt, 0, objectSize), objectSize, siteOpts[i])
+		})
+	}
+
+	readers, writers := make([]*io.PipeReader, len(m.sites)), make([]*io.PipeWriter, len(m.sites))
+	for i := range m.sites {
+		readers[i], writers[i] = io.Pipe()
+	}
+	go fanOut(reader, writers)
+	return m.writeAll(func(i int, site *Client) (UploadInfo, error) {
+		info, err := site.PutObject(ctx, bucketName, objectName, readers[i], objectSize, siteOpts[i])
+		// Stop feeding a site which is done or failed.
+		readers[i].CloseWithError(errors.New("site is done reading"))
+		return info, err
+	})
+}
+
+// fanOut copies src to all writers, dropping writers which fail.
+func fanOut(src io.Reader, writers []*io.PipeWriter) {
+	live := slices.Clone(writers)
+	buf := make([]byte, 32*1024)
+	for len(live) > 0 {
+		n, err := src.Read(buf)
+		if n > 0 {
+			live = slices.DeleteFunc(live, func(w *io.PipeWriter) bool {
+				_, werr := w.Write(buf[:n])
+				return werr != nil
+			})
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			for _, w := range live {
+				w.CloseWithError(err)
+			}
+			return
+		}
+	}
+	for _, w := range live {
+		w.Close()
+	}
+}
+
+// RemoveObject removes the object from all sites in parallel and
+// returns the result of each site. It fails with a *QuorumError if
+// fewer sites than the write quorum removed the object.
+func (m *MultiSiteClient) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) ([]SiteResult, error) {
+	return m.writeAll(func(_ int, site *Client) (UploadInfo, error) {
+		return UploadInfo{}, site.RemoveObject(ctx, bucketName, objectName, opts)
+	})
+}
+
+// siteFailurePenalty is the latency recorded for sites which fail to
+// answer, so that they are tried after all others.
+const siteFailurePenalty = time.Minute
+
+// readOrder returns the indexes of the sites, online sites with the
+// lowest latency first.
+func (m *MultiSiteClient) readOrder() []int {
+	order := make([]int, len(m.sites))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if offA, offB := m.sites[a].IsOffline(), m.sites[b].IsOffline(); offA != offB {
+			if offA {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(m.latency[a].Load(), m.latency[b].Load())
+	})
+	return order
+}
+
+// observe updates the latency average of the site i.
+func (m *MultiSiteClient) observe(i int, d time.Duration) {
+	for {
+		old := m.latency[i].Load()
+		avg := int64(d)
+		if old != 0 {
+			avg = (3*old + int64(d)) / 4
+		}
+		if m.latency[i].CompareAndSwap(old, max(avg, 1)) {
+			return
+		}
+	}
+}
+
+// read calls fn on the sites in read order until it succeeds, and
+// returns the error of the first site otherwise.
+func (m *MultiSiteClient) read(ctx context.Context, fn func(site *Client) error) error {
+	var firstErr error
+	for _, i := range m.readOrder() {
+		start := time.Now()
+		err := fn(m.sites[i])
+		switch {
+		case err == nil:
+			m.observe(i, time.Since(start))
+			return nil
+		case ToErrorResponse(err).StatusCode != 0:
+			// The site answered, e.g. that it misses the object.
+			m.observe(i, time.Since(start))
+		case ctx.Err() == nil:
+			m.observe(i, siteFailurePenalty)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// StatObject returns the info of the object from the nearest site
+// which has it.
+func (m *MultiSiteClient) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (info ObjectInfo, err error) {
+	err = m.read(ctx, func(site *Client) error {
+		var serr error
+		info, serr = site.StatObject(ctx, bucketName, objectName, opts)
+		return serr
+	})
+	return info, err
+}
+
+// GetObject returns the object from the nearest site which has it.
+// Unlike Client.GetObject the first request is made right away to find
+// the site, later failures while reading are not retried on other
+// sites.
+func (m *MultiSiteClient) GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (obj *Object, err error) {
+	err = m.read(ctx, func(site *Client) error {
+		o, gerr := site.GetObject(ctx, bucketName, objectName, opts)
+		if gerr != nil {
+			return gerr
+		}
+		if _, gerr = o.Stat(); gerr != nil {
+			o.Close()
+			return gerr
+		}
+		obj = o
+		return nil
+	})
+	return obj, err
+}
@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestMultiSiteClient(t *testing.T) {
+	_, site1 := newTestServerClient(t)
+	_, site2 := newTestServerClient(t)
+	down, err := New("127.0.0.1:1", &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err = NewMultiSite([]*Client{site1}, MultiSiteOptions{WriteQuorum: 2}); err == nil {
+		t.Fatal("expected a quorum larger than the sites to fail")
+	}
+	m, err := NewMultiSite([]*Client{down, site1, site2}, MultiSiteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Readers with and without io.ReaderAt.
+	readers := map[string]io.Reader{
+		"at":     strings.NewReader("hello"),
+		"stream": io.MultiReader(strings.NewReader("hel"), strings.NewReader("lo")),
+	}
+	for name, r := range readers {
+		results, err := m.PutObject(ctx, "bucket", name, r, 5, PutObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results[0].Err == nil || results[1].Err != nil || results[2].Err != nil || results[2].Info.Size != 5 {
+			t.Fatalf("%s: unexpected results %+v", name, results)
+		}
+		for _, site := range []*Client{site1, site2} {
+			if _, err = site.StatObject(ctx, "bucket", name, StatObjectOptions{}); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+	}
+
+	// The sites do not share the options of the upload.
+	meta := map[string]string{"X-Amz-Meta-Site": "all"}
+	r := io.MultiReader(strings.NewReader("hel"), strings.NewReader("lo"))
+	if _, err = m.PutObject(ctx, "bucket", "meta", r, -1, PutObjectOptions{UserMetadata: meta}); err != nil {
+		t.Fatal(err)
+	}
+	if len(meta) != 1 {
+		t.Fatalf("expected the metadata of the caller unchanged, got %v", meta)
+	}
+	for _, site := range []*Client{site1, site2} {
+		info, err := site.StatObject(ctx, "bucket", "meta", StatObjectOptions{})
+		if err != nil || info.UserMetadata["Site"] != "all" {
+			t.Fatalf("unexpected metadata %v, %v", info.UserMetadata, err)
+		}
+	}
+
+	// Reads skip sites which are down or miss the object.
+	if err = site1.RemoveObject(ctx, "bucket", "at", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := m.GetObject(ctx, "bucket", "at", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("unexpected data %q, %v", data, err)
+	}
+	// The site which is down is tried last from now on.
+	if order := m.readOrder(); order[2] != 0 {
+		t.Fatalf("expected site 0 last, got %v", order)
+	}
+	if _, err = m.StatObject(ctx, "bucket", "missing", StatObjectOptions{}); err == nil {
+		t.Fatal("expected a missing object to fail")
+	}
+
+	// Without a quorum the write fails with the errors of all sites.
+	m, err = NewMultiSite([]*Client{down, site1}, MultiSiteOptions{WriteQuorum: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.RemoveObject(ctx, "bucket", "stream", RemoveObjectOptions{})
+	var qerr *QuorumError
+	if !errors.As(err, &qerr) || qerr.Succeeded != 1 || qerr.Results[0].Err == nil {
+		t.Fatalf("expected a quorum error, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultEKSPodIdentityEndpoint is the credentials endpoint of the
+// EKS Pod Identity agent running on every node.
+const DefaultEKSPodIdentityEndpoint = "http://169.254.170.23/v1/credentials"
+
+// A EKSPodIdentity retrieves credentials from the EKS Pod Identity agent,
+// authorized by the service account token mounted into the pod, and
+// keeps track if those credentials are expired.
+//
+// https://docs.aws.amazon.com/eks/latest/userguide/pod-identities.html
+type EKSPodIdentity struct {
+	Expiry
+
+	// Optional http Client to use when connecting to the agent
+	// (overrides default client in CredContext)
+	Client *http.Client
+
+	// Endpoint of the agent. If empty will look for the
+	// "AWS_CONTAINER_CREDENTIALS_FULL_URI" env variable, if that is
+	// empty as well DefaultEKSPodIdentityEndpoint is used.
+	Endpoint string
+
+	// File holding the service account token. If empty will look for
+	// the "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE" env variable. The
+	// token is rotated by the kubelet, so the file is read again on
+	// every refresh.
+	TokenFile string
+}
+
+// NewEKSPodIdentity returns a pointer to a new Credentials object
+// wrapping the EKS Pod Identity provider.
+func NewEKSPodIdentity(endpoint, tokenFile string) *Credentials {
+	return New(&EKSPodIdentity{
+		Endpoint:  endpoint,
+		TokenFile: tokenFile,
+	})
+}
+
+// RetrieveWithCredContext is like Retrieve with Cred Context
+func (e *EKSPodIdentity) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
+
+	endpoint := e.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+		if endpoint == "" {
+			endpoint = DefaultEKSPodIdentityEndpoint
+		}
+	}
+	ok, err := isContainerHost(endpoint)
+	if err != nil {
+		return Value{}, err
+	}
+	if !ok {
+		return Value{}, fmt.Errorf("uri host is not a loopback or container credentials address: %s", endpoint)
+	}
+
+	tokenFile := e.TokenFile
+	if tokenFile == "" {
+		tokenFile = os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE")
+		if tokenFile == "" {
+			return Value{}, errors.New("EKS Pod Identity token file is not set")
+		}
+	}
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return Value{}, err
+	}
+
+	client := e.Client
+	if client == nil {
+		client = cc.Client
+	}
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+
+	roleCreds, err := getEcsTaskCredentials(client, endpoint, strings.TrimSpace(string(token)))
+	if err != nil {
+		return Value{}, err
+	}
+	e.SetExpiration(roleCreds.Expiration, DefaultExpiryWindow)
+
+	return Value{
+		AccessKeyID:     roleCreds.AccessKeyID,
+		SecretAccessKey: roleCreds.SecretAccessKey,
+		SessionToken:    roleCreds.Token,
+		Expiration:      roleCreds.Expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// Retrieve retrieves credentials from the EKS Pod Identity agent.
+// Error will be returned if the token file can't be read or the
+// request fails.
+func (e *EKSPodIdentity) Retrieve() (Value, error) {
+	return e.RetrieveWithCredContext(nil)
+}
@@ -0,0 +1,99 @@
+//go:build !windows
+// +build !windows
+
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestEKSPodIdentity(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		if token == "" {
+			http.Error(w, "missing token", http.StatusBadRequest)
+			return
+		}
+		tokens = append(tokens, token)
+		// Already expired, so every Get refreshes.
+		fmt.Fprintf(w, credsRespEcsTaskTmpl, time.Now().Add(-time.Minute).UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "eks-pod-identity-token")
+	if err := os.WriteFile(tokenFile, []byte("token1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/v1/credentials")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE", tokenFile)
+
+	creds := NewEKSPodIdentity("", "")
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "accessKey" || v.SecretAccessKey != "secret" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %+v", v)
+	}
+
+	// The kubelet rotates the token.
+	if err := os.WriteFile(tokenFile, []byte("token2"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = creds.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || tokens[0] != "token1" || tokens[1] != "token2" {
+		t.Fatalf("Expected requests authorized with [token1 token2], got %v", tokens)
+	}
+}
+
+func TestEKSPodIdentityFailures(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "eks-pod-identity-token")
+	if err := os.WriteFile(tokenFile, []byte("token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE", "")
+
+	testCases := []struct {
+		endpoint  string
+		tokenFile string
+	}{
+		// Not a loopback or container credentials address.
+		{"http://192.0.2.1/v1/credentials", tokenFile},
+		// No token file.
+		{"http://127.0.0.1/v1/credentials", ""},
+		{"http://127.0.0.1/v1/credentials", tokenFile + ".missing"},
+	}
+	for i, testCase := range testCases {
+		p := &EKSPodIdentity{Endpoint: testCase.endpoint, TokenFile: testCase.tokenFile}
+		if _, err := p.Retrieve(); err == nil {
+			t.Errorf("Test %d: expected failure", i+1)
+		}
+	}
+}
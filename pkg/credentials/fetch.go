@@ -0,0 +1,91 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"time"
+)
+
+// FetchFunc fetches credentials, e.g. from a secret store, returning them
+// along with the time they expire at. A zero expiry time means the
+// credentials don't expire.
+type FetchFunc func(cc *CredContext) (Value, time.Time, error)
+
+// A Fetch retrieves credentials with a FetchFunc and keeps track if
+// those credentials are expired, so credentials rotated by an external
+// store can be used without implementing a Provider.
+type Fetch struct {
+	Expiry
+
+	// FetchFunc called for every refresh of the credentials.
+	FetchFunc FetchFunc
+
+	// ExpiryWindow refreshes the credentials this long before they
+	// expire. DefaultExpiryWindow if zero.
+	ExpiryWindow time.Duration
+
+	// noExpiry is set when the last fetched credentials don't expire.
+	noExpiry bool
+}
+
+// NewFetch returns a pointer to a new Credentials object wrapping
+// the FetchFunc provider.
+func NewFetch(fetch FetchFunc) *Credentials {
+	return New(&Fetch{FetchFunc: fetch})
+}
+
+// RetrieveWithCredContext fetches the credentials with the FetchFunc.
+func (f *Fetch) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	if f.FetchFunc == nil {
+		return Value{}, errors.New("no FetchFunc to fetch credentials with")
+	}
+	if cc == nil {
+		cc = defaultCredContext
+	}
+
+	v, expiry, err := f.FetchFunc(cc)
+	if err != nil {
+		return Value{}, err
+	}
+
+	f.noExpiry = expiry.IsZero()
+	if !f.noExpiry {
+		window := f.ExpiryWindow
+		if window == 0 {
+			window = DefaultExpiryWindow
+		}
+		f.SetExpiration(expiry, window)
+		v.Expiration = expiry
+	}
+	return v, nil
+}
+
+// Retrieve fetches the credentials with the FetchFunc.
+func (f *Fetch) Retrieve() (Value, error) {
+	return f.RetrieveWithCredContext(nil)
+}
+
+// IsExpired returns if the credentials are expired, never for
+// credentials fetched without expiry time.
+func (f *Fetch) IsExpired() bool {
+	if f.noExpiry {
+		return false
+	}
+	return f.Expiry.IsExpired()
+}
@@ -0,0 +1,129 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+	var fetches int
+	var expiry time.Time
+	p := &Fetch{
+		FetchFunc: func(_ *CredContext) (Value, time.Time, error) {
+			fetches++
+			return Value{AccessKeyID: fmt.Sprintf("key%d", fetches), SecretAccessKey: "secret"}, expiry, nil
+		},
+	}
+	p.CurrentTime = func() time.Time { return now }
+	creds := New(p)
+
+	// Without expiry the credentials are cached until expired explicitly.
+	for i := 0; i < 2; i++ {
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.AccessKeyID != "key1" || !v.SignerType.IsV4() {
+			t.Fatalf("Unexpected credentials %+v", v)
+		}
+	}
+	creds.Expire()
+
+	expiry = now.Add(time.Hour)
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "key2" || !v.Expiration.Equal(expiry) {
+		t.Fatalf("Unexpected credentials %+v", v)
+	}
+	if creds.IsExpired() {
+		t.Fatal("Should not be expired")
+	}
+	// Refreshed ahead of the expiry by the default window.
+	now = now.Add(50 * time.Minute)
+	if !creds.IsExpired() {
+		t.Fatal("Should be expired")
+	}
+
+	p.FetchFunc = func(_ *CredContext) (Value, time.Time, error) {
+		return Value{}, time.Time{}, errors.New("unavailable")
+	}
+	if _, err = creds.Get(); err == nil {
+		t.Fatal("Expected fetch failure")
+	}
+}
+
+func TestVaultAWS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vaultToken" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors":["permission denied"]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/aws/creds/user":
+			fmt.Fprint(w, `{"lease_id":"aws/creds/user/1","lease_duration":3600,"renewable":true,"data":{"access_key":"userKey","secret_key":"secret","security_token":null}}`)
+		case "/v1/secrets/aws/sts/role":
+			if r.URL.Query().Get("ttl") != "900s" || r.Header.Get("X-Vault-Namespace") != "team" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"errors":["unexpected request"]}`)
+				return
+			}
+			fmt.Fprint(w, `{"lease_id":"","lease_duration":900,"renewable":false,"data":{"access_key":"roleKey","secret_key":"secret","session_token":"token"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "vaultToken")
+	t.Setenv("VAULT_NAMESPACE", "")
+
+	v, err := NewVaultAWS(VaultAWSOptions{Role: "user"}).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "userKey" || v.SessionToken != "" || time.Until(v.Expiration) <= 59*time.Minute {
+		t.Fatalf("Unexpected credentials %+v", v)
+	}
+
+	v, err = NewVaultAWS(VaultAWSOptions{Mount: "/secrets/aws/", Role: "role", STS: true, TTL: 15 * time.Minute, Namespace: "team"}).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "roleKey" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %+v", v)
+	}
+
+	if _, err = NewVaultAWS(VaultAWSOptions{Role: "user", Token: "other"}).Get(); err == nil || err.Error() != "Vault: permission denied" {
+		t.Fatalf("Expected permission denied, got %v", err)
+	}
+	if _, err = NewVaultAWS(VaultAWSOptions{Role: "missing"}).Get(); err == nil {
+		t.Fatal("Expected failure for missing role")
+	}
+}
@@ -20,9 +20,11 @@ package credentials
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -41,6 +43,13 @@ type externalProcessCredentials struct {
 // A FileAWSCredentials retrieves credentials from the current user's home
 // directory, and keeps track if those credentials are expired.
 //
+// Profiles are read from the shared credentials file and the shared config
+// file the same way the aws-cli does, settings of the credentials file
+// taking precedence. Besides static keys and credential_process, a profile
+// may assume a role with role_arn using the credentials of its
+// source_profile or credential_source, or get role credentials of an
+// sso_session using the token cached by `aws sso login`.
+//
 // Profile ini file example: $HOME/.aws/credentials
 type FileAWSCredentials struct {
 	Expiry
@@ -53,6 +62,14 @@ type FileAWSCredentials struct {
 	// Windows:   "%USERPROFILE%\.aws\credentials"
 	Filename string
 
+	// Path to the shared config file.
+	//
+	// If empty will look for "AWS_CONFIG_FILE" env variable. If the
+	// env value is empty will default to current user's home directory.
+	// Linux/OSX: "$HOME/.aws/config"
+	// Windows:   "%USERPROFILE%\.aws\config"
+	ConfigFilename string
+
 	// AWS Profile to extract credentials from the shared credentials file. If empty
 	// will default to environment variable "AWS_PROFILE" or "default" if
 	// environment variable is also not set.
@@ -71,7 +88,10 @@ func NewFileAWSCredentials(filename, profile string) *Credentials {
 	})
 }
 
-func (p *FileAWSCredentials) retrieve() (Value, error) {
+func (p *FileAWSCredentials) retrieve(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
 	if p.Filename == "" {
 		p.Filename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
 		if p.Filename == "" {
@@ -82,6 +102,16 @@ func (p *FileAWSCredentials) retrieve() (Value, error) {
 			p.Filename = filepath.Join(homeDir, ".aws", "credentials")
 		}
 	}
+	if p.ConfigFilename == "" {
+		p.ConfigFilename = os.Getenv("AWS_CONFIG_FILE")
+		if p.ConfigFilename == "" {
+			// Without a home directory there is no config
+			// file, unlike the credentials file it is optional.
+			if homeDir, err := os.UserHomeDir(); err == nil {
+				p.ConfigFilename = filepath.Join(homeDir, ".aws", "config")
+			}
+		}
+	}
 	if p.Profile == "" {
 		p.Profile = os.Getenv("AWS_PROFILE")
 		if p.Profile == "" {
@@ -91,64 +121,276 @@ func (p *FileAWSCredentials) retrieve() (Value, error) {
 
 	p.retrieved = false
 
-	iniProfile, err := loadProfile(p.Filename, p.Profile)
+	config, err := loadSharedConfig(p.Filename, p.ConfigFilename)
+	if err != nil {
+		return Value{}, err
+	}
+	v, err := config.retrieve(cc, p.Profile, map[string]bool{})
 	if err != nil {
 		return Value{}, err
 	}
+	p.retrieved = true
+	if !v.Expiration.IsZero() {
+		p.SetExpiration(v.Expiration, DefaultExpiryWindow)
+	}
+	return v, nil
+}
+
+// Retrieve reads and extracts the shared credentials from the current
+// users home directory.
+func (p *FileAWSCredentials) Retrieve() (Value, error) {
+	return p.retrieve(nil)
+}
+
+// RetrieveWithCredContext is like Retrieve(), the cred context is used
+// for the requests of profiles assuming a role or using SSO.
+func (p *FileAWSCredentials) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	return p.retrieve(cc)
+}
+
+// sharedConfig holds the shared credentials and config files, either of
+// them may be missing.
+type sharedConfig struct {
+	credentials *ini.File
+	config      *ini.File
+
+	// Error loading the credentials file, returned for profiles
+	// missing in the config file.
+	credentialsErr error
+}
+
+// loadSharedConfig loads the shared credentials and config files. An
+// error is returned if neither exists, or if either is invalid.
+func loadSharedConfig(credentialsFile, configFile string) (sharedConfig, error) {
+	var s sharedConfig
+	s.credentials, s.credentialsErr = ini.Load(credentialsFile)
+	if s.credentialsErr != nil && !os.IsNotExist(s.credentialsErr) {
+		return sharedConfig{}, s.credentialsErr
+	}
+	if configFile != "" {
+		config, err := ini.Load(configFile)
+		if err != nil && !os.IsNotExist(err) {
+			return sharedConfig{}, err
+		}
+		s.config = config
+	}
+	if s.credentials == nil && s.config == nil {
+		return sharedConfig{}, s.credentialsErr
+	}
+	return s, nil
+}
 
-	// Default to empty string if not found.
-	id := iniProfile.Key("aws_access_key_id")
-	// Default to empty string if not found.
-	secret := iniProfile.Key("aws_secret_access_key")
-	// Default to empty string if not found.
-	token := iniProfile.Key("aws_session_token")
-
-	// If credential_process is defined, obtain credentials by executing
-	// the external process
-	credentialProcess := strings.TrimSpace(iniProfile.Key("credential_process").String())
-	if credentialProcess != "" {
-		args := strings.Fields(credentialProcess)
-		if len(args) <= 1 {
-			return Value{}, errors.New("invalid credential process args")
+// profile returns the settings of the profile, merged from the config
+// file and the credentials file, the latter taking precedence.
+func (s sharedConfig) profile(name string) (map[string]string, error) {
+	settings := map[string]string{}
+	found := false
+	if s.config != nil {
+		// Unlike the credentials file, named profiles of the
+		// config file are prefixed with "profile ".
+		sections := []string{"profile " + name}
+		if name == "default" {
+			sections = append(sections, name)
 		}
-		cmd := exec.Command(args[0], args[1:]...)
-		out, err := cmd.Output()
-		if err != nil {
-			return Value{}, err
+		for _, section := range sections {
+			if sec, err := s.config.GetSection(section); err == nil {
+				for k, v := range sec.KeysHash() {
+					settings[k] = strings.TrimSpace(v)
+				}
+				found = true
+			}
+		}
+	}
+	if s.credentials != nil {
+		sec, err := s.credentials.GetSection(name)
+		if err != nil && !found {
+			return nil, err
 		}
-		var externalProcessCredentials externalProcessCredentials
-		err = json.Unmarshal([]byte(out), &externalProcessCredentials)
+		if err == nil {
+			for k, v := range sec.KeysHash() {
+				settings[k] = strings.TrimSpace(v)
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil, s.credentialsErr
+	}
+	return settings, nil
+}
+
+// retrieve returns the credentials of the profile, visited holds the
+// profiles already followed by source_profile to detect cycles.
+func (s sharedConfig) retrieve(cc *CredContext, profile string, visited map[string]bool) (Value, error) {
+	if visited[profile] {
+		return Value{}, fmt.Errorf("source_profile cycle detected at profile %q", profile)
+	}
+	visited[profile] = true
+
+	settings, err := s.profile(profile)
+	if err != nil {
+		return Value{}, err
+	}
+
+	switch {
+	case settings["role_arn"] != "":
+		return s.assumeRole(cc, profile, settings, visited)
+	case settings["sso_session"] != "" || settings["sso_start_url"] != "":
+		return s.ssoCredentials(cc, settings)
+	case settings["credential_process"] != "":
+		// If credential_process is defined, obtain credentials by executing
+		// the external process
+		return processCredentials(settings["credential_process"])
+	}
+	return staticCredentials(settings), nil
+}
+
+// assumeRole returns the credentials of the role_arn of the profile,
+// assumed with the credentials of its source_profile or its
+// credential_source.
+func (s sharedConfig) assumeRole(cc *CredContext, profile string, settings map[string]string, visited map[string]bool) (Value, error) {
+	if settings["mfa_serial"] != "" {
+		return Value{}, fmt.Errorf("profile %q: mfa_serial is not supported", profile)
+	}
+
+	var source Value
+	var err error
+	sourceProfile, credentialSource := settings["source_profile"], settings["credential_source"]
+	switch {
+	case sourceProfile != "" && credentialSource != "":
+		return Value{}, fmt.Errorf("profile %q: source_profile and credential_source are mutually exclusive", profile)
+	case sourceProfile == profile:
+		// A profile may assume a role with its own static keys.
+		source = staticCredentials(settings)
+	case sourceProfile != "":
+		source, err = s.retrieve(cc, sourceProfile, visited)
+	case credentialSource != "":
+		source, err = retrieveCredentialSource(cc, credentialSource)
+	default:
+		return Value{}, fmt.Errorf("profile %q: role_arn requires source_profile or credential_source", profile)
+	}
+	if err != nil {
+		return Value{}, err
+	}
+	if source.AccessKeyID == "" || source.SecretAccessKey == "" {
+		return Value{}, fmt.Errorf("profile %q: no credentials to assume role %s", profile, settings["role_arn"])
+	}
+
+	opts := STSAssumeRoleOptions{
+		AccessKey:       source.AccessKeyID,
+		SecretKey:       source.SecretAccessKey,
+		SessionToken:    source.SessionToken,
+		RoleARN:         settings["role_arn"],
+		RoleSessionName: settings["role_session_name"],
+		ExternalID:      settings["external_id"],
+	}
+	if opts.RoleSessionName == "" {
+		opts.RoleSessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	if duration := settings["duration_seconds"]; duration != "" {
+		if opts.DurationSeconds, err = strconv.Atoi(duration); err != nil {
+			return Value{}, fmt.Errorf("profile %q: invalid duration_seconds %q", profile, duration)
+		}
+	}
+
+	region := RegionFromEnv()
+	if region == "" {
+		region = settings["region"]
+	}
+	endpoint := os.Getenv("AWS_ENDPOINT_URL_STS")
+	if endpoint == "" {
+		endpoint = stsRegionalEndpoint(region)
+	}
+	opts.Location = region
+	if opts.Location == "" {
+		opts.Location = "us-east-1"
+	}
+
+	client := cc.Client
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+	a, err := getAssumeRoleCredentials(client, endpoint, opts)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{
+		AccessKeyID:     a.Result.Credentials.AccessKey,
+		SecretAccessKey: a.Result.Credentials.SecretKey,
+		SessionToken:    a.Result.Credentials.SessionToken,
+		Expiration:      a.Result.Credentials.Expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// retrieveCredentialSource returns the credentials of the
+// credential_source of a profile.
+func retrieveCredentialSource(cc *CredContext, source string) (Value, error) {
+	switch source {
+	case "Environment":
+		return (&EnvAWS{}).retrieve()
+	case "Ec2InstanceMetadata":
+		if envTrue("AWS_EC2_METADATA_DISABLED") {
+			return Value{}, errors.New("credential_source Ec2InstanceMetadata: EC2 instance metadata service is disabled")
+		}
+		client := cc.Client
+		if client == nil {
+			client = defaultCredContext.Client
+		}
+		roleCreds, err := getCredentials(client, os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"), envTrue("AWS_EC2_METADATA_V1_DISABLED"), 0)
 		if err != nil {
 			return Value{}, err
 		}
-		p.retrieved = true
-		p.SetExpiration(externalProcessCredentials.Expiration, DefaultExpiryWindow)
 		return Value{
-			AccessKeyID:     externalProcessCredentials.AccessKeyID,
-			SecretAccessKey: externalProcessCredentials.SecretAccessKey,
-			SessionToken:    externalProcessCredentials.SessionToken,
-			Expiration:      externalProcessCredentials.Expiration,
+			AccessKeyID:     roleCreds.AccessKeyID,
+			SecretAccessKey: roleCreds.SecretAccessKey,
+			SessionToken:    roleCreds.Token,
+			Expiration:      roleCreds.Expiration,
 			SignerType:      SignatureV4,
 		}, nil
+	case "EcsContainer":
+		if os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI") == "" && os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI") == "" {
+			return Value{}, errors.New("credential_source EcsContainer: no container credentials endpoint is set")
+		}
+		return (&IAM{}).RetrieveWithCredContext(cc)
 	}
-	p.retrieved = true
-	return Value{
-		AccessKeyID:     id.String(),
-		SecretAccessKey: secret.String(),
-		SessionToken:    token.String(),
-		SignerType:      SignatureV4,
-	}, nil
+	return Value{}, fmt.Errorf("unsupported credential_source %q", source)
 }
 
-// Retrieve reads and extracts the shared credentials from the current
-// users home directory.
-func (p *FileAWSCredentials) Retrieve() (Value, error) {
-	return p.retrieve()
+// staticCredentials returns the keys set in the profile.
+func staticCredentials(settings map[string]string) Value {
+	return Value{
+		AccessKeyID:     settings["aws_access_key_id"],
+		SecretAccessKey: settings["aws_secret_access_key"],
+		SessionToken:    settings["aws_session_token"],
+		SignerType:      SignatureV4,
+	}
 }
 
-// RetrieveWithCredContext is like Retrieve(), cred context is no-op for File credentials
-func (p *FileAWSCredentials) RetrieveWithCredContext(_ *CredContext) (Value, error) {
-	return p.retrieve()
+// processCredentials returns the credentials printed by the
+// credential_process of a profile.
+func processCredentials(credentialProcess string) (Value, error) {
+	args := strings.Fields(credentialProcess)
+	if len(args) <= 1 {
+		return Value{}, errors.New("invalid credential process args")
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	out, err := cmd.Output()
+	if err != nil {
+		return Value{}, err
+	}
+	var externalProcessCredentials externalProcessCredentials
+	err = json.Unmarshal([]byte(out), &externalProcessCredentials)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{
+		AccessKeyID:     externalProcessCredentials.AccessKeyID,
+		SecretAccessKey: externalProcessCredentials.SecretAccessKey,
+		SessionToken:    externalProcessCredentials.SessionToken,
+		Expiration:      externalProcessCredentials.Expiration,
+		SignerType:      SignatureV4,
+	}, nil
 }
 
 // loadProfiles loads from the file pointed to by shared credentials filename for profile.
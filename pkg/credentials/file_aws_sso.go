@@ -0,0 +1,158 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// ssoToken is the access token cached by `aws sso login`.
+type ssoToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// ssoRoleCredentials is the response of the GetRoleCredentials
+// API of the AWS IAM Identity Center portal.
+type ssoRoleCredentials struct {
+	RoleCredentials struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		Expiration      int64  `json:"expiration"` // Milliseconds since epoch.
+	} `json:"roleCredentials"`
+}
+
+// ssoCredentials returns the credentials of the sso_role_name in the
+// sso_account_id of the profile from AWS IAM Identity Center, with the
+// access token of its sso_session, or of its legacy sso_start_url,
+// cached by `aws sso login`. The token is not refreshed, once it
+// expires the user has to log in again.
+func (s sharedConfig) ssoCredentials(cc *CredContext, settings map[string]string) (Value, error) {
+	startURL, region := settings["sso_start_url"], settings["sso_region"]
+	// The token cache is keyed by the session name, or by
+	// the start URL for legacy profiles without a session.
+	cacheKey := startURL
+	if name := settings["sso_session"]; name != "" {
+		if s.config == nil {
+			return Value{}, fmt.Errorf("sso-session %q not found, there is no config file", name)
+		}
+		sec, err := s.config.GetSection("sso-session " + name)
+		if err != nil {
+			return Value{}, fmt.Errorf("sso-session %q not found: %w", name, err)
+		}
+		startURL = strings.TrimSpace(sec.Key("sso_start_url").String())
+		region = strings.TrimSpace(sec.Key("sso_region").String())
+		cacheKey = name
+	}
+	accountID, roleName := settings["sso_account_id"], settings["sso_role_name"]
+	if startURL == "" || region == "" || accountID == "" || roleName == "" {
+		return Value{}, errors.New("SSO profiles require sso_start_url, sso_region, sso_account_id and sso_role_name")
+	}
+
+	token, err := loadSSOToken(cacheKey)
+	if err != nil {
+		return Value{}, err
+	}
+
+	endpoint := os.Getenv("AWS_ENDPOINT_URL_SSO")
+	if endpoint == "" {
+		endpoint = "https://portal.sso." + region + ".amazonaws.com"
+		if strings.HasPrefix(region, "cn-") {
+			endpoint += ".cn"
+		}
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return Value{}, err
+	}
+	u.Path = "/federation/credentials"
+	u.RawQuery = url.Values{
+		"account_id": []string{accountID},
+		"role_name":  []string{roleName},
+	}.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Value{}, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token.AccessToken)
+
+	client := cc.Client
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Value{}, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return Value{}, fmt.Errorf("SSO GetRoleCredentials failed: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var creds ssoRoleCredentials
+	if err = json.NewDecoder(resp.Body).Decode(&creds); err != nil {
+		return Value{}, err
+	}
+	return Value{
+		AccessKeyID:     creds.RoleCredentials.AccessKeyID,
+		SecretAccessKey: creds.RoleCredentials.SecretAccessKey,
+		SessionToken:    creds.RoleCredentials.SessionToken,
+		Expiration:      time.UnixMilli(creds.RoleCredentials.Expiration),
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// loadSSOToken reads the access token cached under
+// "$HOME/.aws/sso/cache" by `aws sso login` for the key.
+func loadSSOToken(key string) (ssoToken, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ssoToken{}, err
+	}
+	sum := sha1.Sum([]byte(key))
+	data, err := os.ReadFile(filepath.Join(homeDir, ".aws", "sso", "cache", hex.EncodeToString(sum[:])+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ssoToken{}, fmt.Errorf("no cached SSO token for %q, run `aws sso login`", key)
+		}
+		return ssoToken{}, err
+	}
+	var token ssoToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return ssoToken{}, err
+	}
+	if token.AccessToken == "" || !token.ExpiresAt.After(time.Now()) {
+		return ssoToken{}, fmt.Errorf("cached SSO token for %q is expired, run `aws sso login`", key)
+	}
+	return token, nil
+}
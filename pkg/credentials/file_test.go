@@ -18,10 +18,17 @@
 package credentials
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestFileAWS(t *testing.T) {
@@ -212,3 +219,207 @@ func TestFileMinioClient(t *testing.T) {
 		t.Error("Should be expired if not loaded")
 	}
 }
+
+const assumeRoleRespTmpl = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<AssumeRoleResult>
+  <Credentials>
+    <AccessKeyId>%s</AccessKeyId>
+    <SecretAccessKey>secret</SecretAccessKey>
+    <SessionToken>%s</SessionToken>
+    <Expiration>%s</Expiration>
+  </Credentials>
+</AssumeRoleResult>
+</AssumeRoleResponse>`
+
+// initAssumeRoleTestServer returns an STS server handing out the
+// credentials "<role name>Key" for any role ARN assumed with
+// credentials of a known access key.
+func initAssumeRoleTestServer(t *testing.T, accessKeys ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		known := false
+		for _, accessKey := range accessKeys {
+			known = known || strings.Contains(r.Header.Get("Authorization"), "Credential="+accessKey+"/")
+		}
+		if !known || r.Form.Get("Action") != "AssumeRole" || r.Form.Get("RoleSessionName") == "" {
+			http.Error(w, "Access Denied", http.StatusForbidden)
+			return
+		}
+		role := r.Form.Get("RoleArn")
+		role = role[strings.LastIndex(role, "/")+1:]
+		expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, assumeRoleRespTmpl, role+"Key", role+"Token", expiration)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFileAWSConfig(t *testing.T) {
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	configFile := filepath.Join(dir, "config")
+	err := os.WriteFile(credentialsFile, []byte(`[base]
+aws_access_key_id = baseKey
+aws_secret_access_key = secret
+
+[self]
+aws_access_key_id = selfKey
+aws_secret_access_key = secret
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(configFile, []byte(`[profile first]
+role_arn = arn:aws:iam::123456789012:role/first
+source_profile = base
+
+[profile second]
+role_arn = arn:aws:iam::123456789012:role/second
+source_profile = first
+region = eu-west-1
+
+[profile self]
+role_arn = arn:aws:iam::123456789012:role/self
+source_profile = self
+
+[profile env]
+role_arn = arn:aws:iam::123456789012:role/env
+credential_source = Environment
+
+[profile loop1]
+role_arn = arn:aws:iam::123456789012:role/loop1
+source_profile = loop2
+
+[profile loop2]
+role_arn = arn:aws:iam::123456789012:role/loop2
+source_profile = loop1
+
+[profile both]
+role_arn = arn:aws:iam::123456789012:role/both
+source_profile = base
+credential_source = Environment
+
+[profile orphan]
+role_arn = arn:aws:iam::123456789012:role/orphan
+
+[profile static]
+aws_access_key_id = staticKey
+aws_secret_access_key = secret
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := initAssumeRoleTestServer(t, "baseKey", "firstKey", "selfKey", "envKey")
+	t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "envKey")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	testCases := []struct {
+		profile   string
+		accessKey string
+		fail      bool
+	}{
+		{profile: "base", accessKey: "baseKey"},
+		{profile: "static", accessKey: "staticKey"},
+		{profile: "first", accessKey: "firstKey"},
+		{profile: "second", accessKey: "secondKey"},
+		{profile: "self", accessKey: "selfKey"},
+		{profile: "env", accessKey: "envKey"},
+		{profile: "loop1", fail: true},
+		{profile: "both", fail: true},
+		{profile: "orphan", fail: true},
+		{profile: "missing", fail: true},
+	}
+	for i, testCase := range testCases {
+		p := &FileAWSCredentials{Filename: credentialsFile, ConfigFilename: configFile, Profile: testCase.profile}
+		v, err := p.RetrieveWithCredContext(defaultCredContext)
+		if testCase.fail {
+			if err == nil {
+				t.Errorf("Test %d: expected profile %s to fail", i+1, testCase.profile)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test %d: unexpected failure for profile %s: %v", i+1, testCase.profile, err)
+		}
+		if v.AccessKeyID != testCase.accessKey {
+			t.Errorf("Test %d: expected access key %s, got %s", i+1, testCase.accessKey, v.AccessKeyID)
+		}
+	}
+}
+
+func TestFileAWSSSO(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	configFile := filepath.Join(home, "config")
+	err := os.WriteFile(configFile, []byte(`[profile dev]
+sso_session = my-sso
+sso_account_id = 123456789012
+sso_role_name = ReadOnly
+
+[profile legacy]
+sso_start_url = https://legacy.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = ReadOnly
+
+[sso-session my-sso]
+sso_start_url = https://my-sso.awsapps.com/start
+sso_region = eu-west-1
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cacheDir := filepath.Join(home, ".aws", "sso", "cache")
+	if err = os.MkdirAll(cacheDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	writeToken := func(key, token string, expiresAt time.Time) {
+		sum := sha1.Sum([]byte(key))
+		data := fmt.Sprintf(`{"accessToken":%q,"expiresAt":%q,"region":"eu-west-1"}`, token, expiresAt.UTC().Format(time.RFC3339))
+		if err := os.WriteFile(filepath.Join(cacheDir, hex.EncodeToString(sum[:])+".json"), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeToken("my-sso", "ssoToken", time.Now().Add(time.Hour))
+	writeToken("https://legacy.awsapps.com/start", "legacyToken", time.Now().Add(-time.Hour))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation/credentials" || r.Header.Get("x-amz-sso_bearer_token") != "ssoToken" ||
+			r.URL.Query().Get("account_id") != "123456789012" || r.URL.Query().Get("role_name") != "ReadOnly" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"ssoKey","secretAccessKey":"secret","sessionToken":"token","expiration":%d}}`,
+			time.Now().Add(time.Hour).UnixMilli())
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_SSO", server.URL)
+
+	p := &FileAWSCredentials{Filename: filepath.Join(home, "credentials"), ConfigFilename: configFile, Profile: "dev"}
+	v, err := p.RetrieveWithCredContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "ssoKey" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %+v", v)
+	}
+	if p.IsExpired() {
+		t.Fatal("Should not be expired")
+	}
+
+	// The legacy profile's cached token is expired.
+	p = &FileAWSCredentials{Filename: filepath.Join(home, "credentials"), ConfigFilename: configFile, Profile: "legacy"}
+	if _, err = p.RetrieveWithCredContext(defaultCredContext); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("Expected expired token failure, got %v", err)
+	}
+}
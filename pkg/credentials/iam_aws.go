@@ -73,8 +73,33 @@ type IAM struct {
 		RoleARN         string
 		RoleSessionName string
 	}
+
+	// Access to the EC2 instance metadata service (IMDS) - https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html
+	IMDS struct {
+		// Disabled refuses to fetch credentials from the instance
+		// metadata service, also set by AWS_EC2_METADATA_DISABLED=true.
+		Disabled bool
+
+		// V1Disabled refuses to fall back to IMDSv1 when no IMDSv2
+		// session token could be fetched, also set by
+		// AWS_EC2_METADATA_V1_DISABLED=true.
+		V1Disabled bool
+
+		// TokenTimeout bounds the session token request, one second
+		// if not set. The token response is dropped when it crosses
+		// more network hops than the hop limit of the instance allows,
+		// e.g. into containers on a bridge network with the default
+		// hop limit of 1, which is reported as ErrIMDSTokenTimeout if
+		// IMDSv1 is disabled.
+		TokenTimeout time.Duration
+	}
 }
 
+// ErrIMDSTokenTimeout is returned when no IMDSv2 session token was
+// received in time while IMDSv1 is disabled, usually as the response
+// hop limit of the instance is too low for the network of the caller.
+var ErrIMDSTokenTimeout = errors.New("IMDSv2 session token request timed out, the instance metadata response hop limit may be too low")
+
 // IAM Roles for Amazon EC2
 // http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html
 const (
@@ -108,7 +133,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 
 	tokenFile := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE")
 	if tokenFile == "" {
-		tokenFile = m.Container.AuthorizationToken
+		tokenFile = m.Container.AuthorizationTokenFile
 	}
 
 	relativeURI := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
@@ -157,15 +182,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 	switch {
 	case identityFile != "":
 		if len(endpoint) == 0 {
-			if region != "" {
-				if strings.HasPrefix(region, "cn-") {
-					endpoint = "https://sts." + region + ".amazonaws.com.cn"
-				} else {
-					endpoint = "https://sts." + region + ".amazonaws.com"
-				}
-			} else {
-				endpoint = DefaultSTSRoleEndpoint
-			}
+			endpoint = stsRegionalEndpoint(region)
 		}
 
 		creds := &STSWebIdentity{
@@ -204,9 +221,9 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 		if len(endpoint) == 0 {
 			endpoint = fullURI
 			var ok bool
-			if ok, err = isLoopback(endpoint); !ok {
+			if ok, err = isContainerHost(endpoint); !ok {
 				if err == nil {
-					err = fmt.Errorf("uri host is not a loopback address: %s", endpoint)
+					err = fmt.Errorf("uri host is not a loopback or container credentials address: %s", endpoint)
 				}
 				break
 			}
@@ -214,8 +231,15 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 
 		roleCreds, err = getEcsTaskCredentials(client, endpoint, token)
 
+	case m.IMDS.Disabled || envTrue("AWS_EC2_METADATA_DISABLED"):
+		err = errors.New("EC2 instance metadata service is disabled")
+
 	default:
-		roleCreds, err = getCredentials(client, endpoint)
+		if len(endpoint) == 0 {
+			endpoint = os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT")
+		}
+		v1Disabled := m.IMDS.V1Disabled || envTrue("AWS_EC2_METADATA_V1_DISABLED")
+		roleCreds, err = getCredentials(client, endpoint, v1Disabled, m.IMDS.TokenTimeout)
 	}
 
 	if err != nil {
@@ -343,8 +367,11 @@ func getEKSPodIdentityCredentials(client *http.Client, endpoint string, tokenFil
 	return ec2RoleCredRespBody{}, fmt.Errorf("getEKSPodIdentityCredentials: no tokenFile found")
 }
 
-func fetchIMDSToken(client *http.Client, endpoint string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+func fetchIMDSToken(client *http.Client, endpoint string, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint+TokenPath, nil)
@@ -372,13 +399,13 @@ func fetchIMDSToken(client *http.Client, endpoint string) (string, error) {
 //
 // If the credentials cannot be found, or there is an error
 // reading the response an error will be returned.
-func getCredentials(client *http.Client, endpoint string) (ec2RoleCredRespBody, error) {
+func getCredentials(client *http.Client, endpoint string, v1Disabled bool, tokenTimeout time.Duration) (ec2RoleCredRespBody, error) {
 	if endpoint == "" {
 		endpoint = DefaultIAMRoleEndpoint
 	}
 
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html
-	token, err := fetchIMDSToken(client, endpoint)
+	token, err := fetchIMDSToken(client, endpoint, tokenTimeout)
 	if err != nil {
 		// Return only errors for valid situations, if the IMDSv2 is not enabled
 		// we will not be able to get the token, in such a situation we have
@@ -387,6 +414,9 @@ func getCredentials(client *http.Client, endpoint string) (ec2RoleCredRespBody,
 		if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
 			return ec2RoleCredRespBody{}, err
 		}
+		if v1Disabled {
+			return ec2RoleCredRespBody{}, fmt.Errorf("%w: %v", ErrIMDSTokenTimeout, err)
+		}
 	}
 
 	// http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html
@@ -446,6 +476,48 @@ func getCredentials(client *http.Client, endpoint string) (ec2RoleCredRespBody,
 	return respCreds, nil
 }
 
+// stsRegionalEndpoint returns the AWS STS endpoint of the region,
+// DefaultSTSRoleEndpoint if the region is empty.
+func stsRegionalEndpoint(region string) string {
+	switch {
+	case region == "":
+		return DefaultSTSRoleEndpoint
+	case strings.HasPrefix(region, "cn-"):
+		return "https://sts." + region + ".amazonaws.com.cn"
+	}
+	return "https://sts." + region + ".amazonaws.com"
+}
+
+// Link-local addresses of the ECS task metadata endpoint and the
+// EKS Pod Identity agent.
+var containerCredentialsIPs = []net.IP{
+	net.ParseIP("169.254.170.2"),
+	net.ParseIP("169.254.170.23"),
+	net.ParseIP("fd00:ec2::23"),
+}
+
+// isContainerHost identifies if a uri's host is on a loopback address
+// or on the address of the ECS or EKS Pod Identity credentials endpoint.
+func isContainerHost(uri string) (bool, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false, err
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		for _, containerIP := range containerCredentialsIPs {
+			if ip.Equal(containerIP) {
+				return true, nil
+			}
+		}
+	}
+	return isLoopback(uri)
+}
+
+// envTrue reports whether the env variable is set to true.
+func envTrue(key string) bool {
+	return strings.EqualFold(os.Getenv(key), "true")
+}
+
 // isLoopback identifies if a uri's host is on a loopback address
 func isLoopback(uri string) (bool, error) {
 	u, err := url.Parse(uri)
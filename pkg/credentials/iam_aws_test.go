@@ -21,6 +21,7 @@
 package credentials
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -420,3 +421,92 @@ func TestIMDSv1Blocked(t *testing.T) {
 		t.Errorf("Unexpected IMDSv2 failure %s", err)
 	}
 }
+
+// Instance Metadata Service whose token responses are dropped, as
+// happens beyond the response hop limit, serving IMDSv1 requests.
+func initIMDSHopLimitServer(expireOn string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut:
+			<-r.Context().Done()
+		case r.URL.Path == "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprintln(w, "RoleName")
+		case r.URL.Path == "/latest/meta-data/iam/security-credentials/RoleName":
+			fmt.Fprintf(w, credsRespTmpl, expireOn)
+		default:
+			http.Error(w, "bad request", http.StatusBadRequest)
+		}
+	}))
+	return server
+}
+
+func TestIMDSHopLimit(t *testing.T) {
+	server := initIMDSHopLimitServer("2014-12-16T01:51:37Z")
+	defer server.Close()
+
+	p := &IAM{}
+	p.IMDS.TokenTimeout = 50 * time.Millisecond
+	t.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+	creds, err := p.RetrieveWithCredContext(defaultCredContext)
+	if err != nil {
+		t.Fatalf("Unexpected IMDSv1 fallback failure %s", err)
+	}
+	if creds.AccessKeyID != "accessKey" {
+		t.Errorf("Expected \"accessKey\", got %s", creds.AccessKeyID)
+	}
+
+	p = &IAM{Endpoint: server.URL}
+	p.IMDS.TokenTimeout = 50 * time.Millisecond
+	p.IMDS.V1Disabled = true
+	if _, err = p.RetrieveWithCredContext(defaultCredContext); !errors.Is(err, ErrIMDSTokenTimeout) {
+		t.Fatalf("Expected %v, got %v", ErrIMDSTokenTimeout, err)
+	}
+
+	p = &IAM{Endpoint: server.URL}
+	p.IMDS.TokenTimeout = 50 * time.Millisecond
+	t.Setenv("AWS_EC2_METADATA_V1_DISABLED", "true")
+	if _, err = p.RetrieveWithCredContext(defaultCredContext); !errors.Is(err, ErrIMDSTokenTimeout) {
+		t.Fatalf("Expected %v, got %v", ErrIMDSTokenTimeout, err)
+	}
+}
+
+func TestIMDSDisabled(t *testing.T) {
+	server := initIMDSv2Server("2014-12-16T01:51:37Z", false)
+	defer server.Close()
+
+	p := &IAM{Endpoint: server.URL}
+	p.IMDS.Disabled = true
+	if _, err := p.RetrieveWithCredContext(defaultCredContext); err == nil {
+		t.Fatal("Expected failure with disabled instance metadata")
+	}
+
+	p = &IAM{Endpoint: server.URL}
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	if _, err := p.RetrieveWithCredContext(defaultCredContext); err == nil {
+		t.Fatal("Expected failure with disabled instance metadata")
+	}
+}
+
+func TestIsContainerHost(t *testing.T) {
+	testCases := []struct {
+		uri string
+		ok  bool
+	}{
+		{"http://127.0.0.1/creds", true},
+		{"http://localhost:8080/creds", true},
+		{"http://169.254.170.2/v2/credentials", true},
+		{"http://169.254.170.23/v1/credentials", true},
+		{"http://[fd00:ec2::23]/v1/credentials", true},
+		{"http://169.254.169.254/latest", false},
+		{"http://192.0.2.1/creds", false},
+	}
+	for i, testCase := range testCases {
+		ok, err := isContainerHost(testCase.uri)
+		if err != nil {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if ok != testCase.ok {
+			t.Errorf("Test %d: expected %v for %s, got %v", i+1, testCase.ok, testCase.uri, ok)
+		}
+	}
+}
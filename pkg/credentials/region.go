@@ -0,0 +1,148 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// IMDSRegionPath is the instance metadata path of the region of
+// the EC2 instance.
+const IMDSRegionPath = "/latest/meta-data/placement/region"
+
+// DetectRegion returns the AWS region configured for the running
+// process, looked up in order from
+//
+//   - AWS_REGION or AWS_DEFAULT_REGION.
+//   - The region of the AWS profile in the shared config file.
+//   - The placement of the EC2 instance, read from its instance
+//     metadata with an IMDSv2 session token. This is skipped if
+//     AWS_EC2_METADATA_DISABLED is set to true, the endpoint can be
+//     overridden with AWS_EC2_METADATA_SERVICE_ENDPOINT.
+//
+// Empty string is returned if no region is found.
+func DetectRegion(ctx context.Context, client *http.Client) string {
+	if region := RegionFromEnv(); region != "" {
+		return region
+	}
+	if region, err := RegionFromSharedConfig("", ""); err == nil && region != "" {
+		return region
+	}
+	if envTrue("AWS_EC2_METADATA_DISABLED") {
+		return ""
+	}
+	region, err := RegionFromIMDS(ctx, client, os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"))
+	if err != nil {
+		return ""
+	}
+	return region
+}
+
+// RegionFromEnv returns the region set by AWS_REGION, or by
+// AWS_DEFAULT_REGION if the former is not set.
+func RegionFromEnv() string {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	return os.Getenv("AWS_DEFAULT_REGION")
+}
+
+// RegionFromSharedConfig returns the region of the profile in the
+// shared config file, empty string if the profile has no region.
+//
+// If filename is empty the AWS_CONFIG_FILE env variable is used, or
+// "$HOME/.aws/config" if that is not set either. If profile is empty
+// the AWS_PROFILE env variable is used, or "default".
+func RegionFromSharedConfig(filename, profile string) (string, error) {
+	if filename == "" {
+		filename = os.Getenv("AWS_CONFIG_FILE")
+		if filename == "" {
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				return "", err
+			}
+			filename = filepath.Join(homeDir, ".aws", "config")
+		}
+	}
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+		if profile == "" {
+			profile = "default"
+		}
+	}
+
+	// Unlike the credentials file, named profiles of the
+	// config file are prefixed with "profile ".
+	iniProfile, err := loadProfile(filename, "profile "+profile)
+	if err != nil && profile == "default" {
+		iniProfile, err = loadProfile(filename, profile)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(iniProfile.Key("region").String()), nil
+}
+
+// RegionFromIMDS returns the region of the EC2 instance from its
+// instance metadata service at endpoint, DefaultIAMRoleEndpoint if
+// empty. Only IMDSv2 is supported, the metadata is read with a session
+// token and each request gives up after a second.
+func RegionFromIMDS(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+	if endpoint == "" {
+		endpoint = DefaultIAMRoleEndpoint
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html
+	token, err := fetchIMDSToken(client, endpoint, time.Second)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+IMDSRegionPath, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Add(TokenRequestHeader, token)
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(resp.Status)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
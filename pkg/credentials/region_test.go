@@ -0,0 +1,98 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestIMDSServer(t *testing.T, region string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == TokenPath:
+			w.Write([]byte("token"))
+		case r.Method == http.MethodGet && r.URL.Path == IMDSRegionPath:
+			if r.Header.Get(TokenRequestHeader) != "token" {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(region))
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDetectRegion(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config")
+	err := os.WriteFile(config, []byte("[default]\nregion = eu-west-1\n\n[profile dev]\nregion = ap-south-1\n\n[profile none]\noutput = json\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imds := newTestIMDSServer(t, "us-west-2")
+
+	testCases := []struct {
+		env    map[string]string
+		region string
+	}{
+		{map[string]string{"AWS_REGION": "eu-central-1", "AWS_DEFAULT_REGION": "eu-north-1"}, "eu-central-1"},
+		{map[string]string{"AWS_DEFAULT_REGION": "eu-north-1"}, "eu-north-1"},
+		{map[string]string{"AWS_CONFIG_FILE": config}, "eu-west-1"},
+		{map[string]string{"AWS_CONFIG_FILE": config, "AWS_PROFILE": "dev"}, "ap-south-1"},
+		{map[string]string{"AWS_CONFIG_FILE": config, "AWS_PROFILE": "none"}, "us-west-2"},
+		{map[string]string{"AWS_CONFIG_FILE": filepath.Join(dir, "missing")}, "us-west-2"},
+		{map[string]string{"AWS_CONFIG_FILE": filepath.Join(dir, "missing"), "AWS_EC2_METADATA_DISABLED": "true"}, ""},
+	}
+	for i, testCase := range testCases {
+		t.Setenv("AWS_REGION", "")
+		t.Setenv("AWS_DEFAULT_REGION", "")
+		t.Setenv("AWS_PROFILE", "")
+		t.Setenv("AWS_EC2_METADATA_DISABLED", "")
+		t.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", imds.URL)
+		for k, v := range testCase.env {
+			t.Setenv(k, v)
+		}
+		if region := DetectRegion(context.Background(), nil); region != testCase.region {
+			t.Errorf("Test %d: expected region %q, got %q", i+1, testCase.region, region)
+		}
+	}
+}
+
+func TestRegionFromIMDSRequiresToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("us-west-2"))
+	}))
+	defer srv.Close()
+
+	if region, err := RegionFromIMDS(context.Background(), nil, srv.URL); err == nil {
+		t.Fatalf("expected IMDSv1 fallback to be refused, got region %q", region)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// VaultAWSOptions configures fetching credentials from the AWS secrets
+// engine of HashiCorp Vault.
+//
+// https://developer.hashicorp.com/vault/docs/secrets/aws
+type VaultAWSOptions struct {
+	// Address of the Vault server. If empty will look for
+	// "VAULT_ADDR" env variable.
+	Address string
+
+	// Token to authenticate with. If empty will look for
+	// "VAULT_TOKEN" env variable.
+	Token string
+
+	// Namespace of the secrets engine, Vault Enterprise only. If
+	// empty will look for "VAULT_NAMESPACE" env variable.
+	Namespace string
+
+	// Path the secrets engine is mounted at, defaults to "aws".
+	Mount string
+
+	// Role of the secrets engine to generate credentials for.
+	Role string
+
+	// STS requests temporary credentials of an assumed_role or
+	// federation_token role from the sts endpoint, instead of the
+	// credentials of an IAM user from the creds endpoint.
+	STS bool
+
+	// TTL of STS credentials, the role's default if zero.
+	TTL time.Duration
+}
+
+// vaultSecret is the response of the Vault secrets endpoints.
+type vaultSecret struct {
+	LeaseDuration int64 `json:"lease_duration"`
+	Data          struct {
+		AccessKey     string `json:"access_key"`
+		SecretKey     string `json:"secret_key"`
+		SessionToken  string `json:"session_token"`
+		SecurityToken string `json:"security_token"` // Before Vault 1.13.
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// NewVaultAWS returns a pointer to a new Credentials object fetching
+// credentials from the AWS secrets engine of HashiCorp Vault.
+func NewVaultAWS(opts VaultAWSOptions) *Credentials {
+	return NewFetch(VaultAWSFetchFunc(opts))
+}
+
+// VaultAWSFetchFunc returns a FetchFunc generating credentials with the
+// AWS secrets engine of HashiCorp Vault, which expire along with their
+// lease.
+func VaultAWSFetchFunc(opts VaultAWSOptions) FetchFunc {
+	return func(cc *CredContext) (Value, time.Time, error) {
+		address := opts.Address
+		if address == "" {
+			address = os.Getenv("VAULT_ADDR")
+		}
+		token := opts.Token
+		if token == "" {
+			token = os.Getenv("VAULT_TOKEN")
+		}
+		namespace := opts.Namespace
+		if namespace == "" {
+			namespace = os.Getenv("VAULT_NAMESPACE")
+		}
+		mount := strings.Trim(opts.Mount, "/")
+		if mount == "" {
+			mount = "aws"
+		}
+		if address == "" || token == "" || opts.Role == "" {
+			return Value{}, time.Time{}, errors.New("Vault address, token and role are mandatory")
+		}
+
+		u, err := url.Parse(address)
+		if err != nil {
+			return Value{}, time.Time{}, err
+		}
+		endpoint := "creds"
+		if opts.STS {
+			endpoint = "sts"
+		}
+		u.Path = path.Join(u.Path, "v1", mount, endpoint, opts.Role)
+		if opts.STS && opts.TTL > 0 {
+			u.RawQuery = url.Values{"ttl": []string{strconv.FormatInt(int64(opts.TTL/time.Second), 10) + "s"}}.Encode()
+		}
+
+		req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		if err != nil {
+			return Value{}, time.Time{}, err
+		}
+		req.Header.Set("X-Vault-Token", token)
+		if namespace != "" {
+			req.Header.Set("X-Vault-Namespace", namespace)
+		}
+
+		client := cc.Client
+		if client == nil {
+			client = defaultCredContext.Client
+		}
+		// Taken before the request, so the lease can't outlive
+		// the expiry time.
+		now := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			return Value{}, time.Time{}, err
+		}
+		defer closeResponse(resp)
+
+		var secret vaultSecret
+		if err = json.NewDecoder(resp.Body).Decode(&secret); err != nil && resp.StatusCode == http.StatusOK {
+			return Value{}, time.Time{}, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			if len(secret.Errors) > 0 {
+				return Value{}, time.Time{}, fmt.Errorf("Vault: %s", strings.Join(secret.Errors, ", "))
+			}
+			return Value{}, time.Time{}, fmt.Errorf("Vault: %s", resp.Status)
+		}
+
+		sessionToken := secret.Data.SessionToken
+		if sessionToken == "" {
+			sessionToken = secret.Data.SecurityToken
+		}
+		var expiry time.Time
+		if secret.LeaseDuration > 0 {
+			expiry = now.Add(time.Duration(secret.LeaseDuration) * time.Second)
+		}
+		return Value{
+			AccessKeyID:     secret.Data.AccessKey,
+			SecretAccessKey: secret.Data.SecretKey,
+			SessionToken:    sessionToken,
+			SignerType:      SignatureV4,
+		}, expiry, nil
+	}
+}
@@ -35,6 +35,8 @@ const (
 	SseKmsKeyID = SseGenericHeader + "-Aws-Kms-Key-Id"
 	// SseEncryptionContext is the AWS SSE-KMS Encryption Context data.
 	SseEncryptionContext = SseGenericHeader + "-Context"
+	// SseBucketKeyEnabled enables S3 Bucket Keys for SSE-KMS.
+	SseBucketKeyEnabled = SseGenericHeader + "-Bucket-Key-Enabled"
 
 	// SseCustomerAlgorithm is the AWS SSE-C algorithm HTTP header key.
 	SseCustomerAlgorithm = SseGenericHeader + "-Customer-Algorithm"
@@ -108,6 +110,36 @@ func NewSSEKMS(keyID string, context interface{}) (ServerSide, error) {
 	return kms{key: keyID, context: serializedContext, hasContext: true}, nil
 }
 
+// KMSOptions are the optional settings of SSE-KMS.
+type KMSOptions struct {
+	// Context is the KMS encryption context, it is passed to
+	// the KMS as additional authenticated data.
+	Context map[string]string
+
+	// BucketKey enables an S3 Bucket Key, which reduces the
+	// number of requests to the KMS.
+	BucketKey bool
+}
+
+// NewSSEKMSWithOptions returns a new server-side-encryption using SSE-KMS
+// with the provided key id, which may be empty for the default key.
+func NewSSEKMSWithOptions(keyID string, opts KMSOptions) (ServerSide, error) {
+	sse := kms{key: keyID, bucketKey: opts.BucketKey}
+	if len(opts.Context) > 0 {
+		for k := range opts.Context {
+			if k == "" {
+				return nil, errors.New("encrypt: KMS context keys must not be empty")
+			}
+		}
+		serializedContext, err := json.Marshal(opts.Context)
+		if err != nil {
+			return nil, err
+		}
+		sse.context, sse.hasContext = serializedContext, true
+	}
+	return sse, nil
+}
+
 // NewSSEC returns a new server-side-encryption using SSE-C and the provided key.
 // The key must be 32 bytes long.
 func NewSSEC(key []byte) (ServerSide, error) {
@@ -182,6 +214,7 @@ type kms struct {
 	key        string
 	context    []byte
 	hasContext bool
+	bucketKey  bool
 }
 
 func (s kms) Type() Type { return KMS }
@@ -194,4 +227,7 @@ func (s kms) Marshal(h http.Header) {
 	if s.hasContext {
 		h.Set(SseEncryptionContext, base64.StdEncoding.EncodeToString(s.context))
 	}
+	if s.bucketKey {
+		h.Set(SseBucketKeyEnabled, "true")
+	}
 }
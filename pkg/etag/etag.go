@@ -0,0 +1,127 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package etag computes the ETags S3 assigns to objects, to verify
+// local content against them.
+package etag
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Parts returns the number of parts of an object with a multipart
+// ETag like "d41d8cd98f00b204e9800998ecf8427e-3", 0 for other ETags.
+func Parts(etag string) int {
+	etag = strings.Trim(etag, `"`)
+	i := strings.LastIndexByte(etag, '-')
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(etag[i+1:])
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
+// Equal tells whether two ETags are equal, ignoring quotes and case.
+func Equal(a, b string) bool {
+	return strings.EqualFold(strings.Trim(a, `"`), strings.Trim(b, `"`))
+}
+
+// Multipart returns the ETag of a multipart object from the ETags of
+// its parts, i.e. the hex MD5 of the concatenated MD5 of the parts
+// followed by the number of parts.
+func Multipart(partETags []string) (string, error) {
+	h := md5.New()
+	for _, partETag := range partETags {
+		sum, err := hex.DecodeString(strings.Trim(partETag, `"`))
+		if err != nil || len(sum) != md5.Size {
+			return "", errors.New("etag: invalid part ETag " + partETag)
+		}
+		h.Write(sum)
+	}
+	return hex.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(partETags)), nil
+}
+
+// Compute returns the ETag of the content of r uploaded in parts of
+// partSize bytes, the last part being smaller. Content of at most
+// partSize bytes, or any content if partSize is not positive, is
+// assumed uploaded in a single request, its ETag being its MD5.
+func Compute(r io.Reader, partSize int64) (string, error) {
+	if partSize <= 0 {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+	var partETags []string
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		if n == 0 && len(partETags) > 0 {
+			break
+		}
+		partETags = append(partETags, hex.EncodeToString(h.Sum(nil)))
+		if n < partSize {
+			break
+		}
+	}
+	if len(partETags) == 1 {
+		return partETags[0], nil
+	}
+	return Multipart(partETags)
+}
+
+// ComputeParts returns the ETag of the content of r uploaded as a
+// multipart object with parts of the given sizes, e.g. those listed by
+// ObjectParts. The content must be as long as the parts.
+func ComputeParts(r io.Reader, partSizes []int64) (string, error) {
+	partETags := make([]string, 0, len(partSizes))
+	for _, size := range partSizes {
+		h := md5.New()
+		if _, err := io.CopyN(h, r, size); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+		partETags = append(partETags, hex.EncodeToString(h.Sum(nil)))
+	}
+	return Multipart(partETags)
+}
+
+// ComputeFile returns the ETag of the file uploaded in parts of
+// partSize bytes, see Compute.
+func ComputeFile(filePath string, partSize int64) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return Compute(f, partSize)
+}
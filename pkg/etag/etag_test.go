@@ -0,0 +1,112 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package etag
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func md5Hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestCompute(t *testing.T) {
+	data := bytes.Repeat([]byte("abcdefghij"), 25)
+	multipart, err := Multipart([]string{md5Hex(data[:100]), md5Hex(data[100:200]), `"` + md5Hex(data[200:]) + `"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	halves, err := Multipart([]string{md5Hex(data[:125]), md5Hex(data[125:])})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		partSize int64
+		data     []byte
+		etag     string
+	}{
+		{0, data, md5Hex(data)},
+		{250, data, md5Hex(data)},
+		{1000, data, md5Hex(data)},
+		{100, data, multipart},
+		{100, nil, md5Hex(nil)},
+		{125, data, halves},
+	}
+	for i, testCase := range testCases {
+		etag, err := Compute(bytes.NewReader(testCase.data), testCase.partSize)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if etag != testCase.etag {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.etag, etag)
+		}
+	}
+
+	etag, err := ComputeParts(bytes.NewReader(data), []int64{100, 100, 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if etag != multipart {
+		t.Errorf("expected %s, got %s", multipart, etag)
+	}
+	if _, err = ComputeParts(bytes.NewReader(data), []int64{200, 100}); err == nil {
+		t.Error("expected error for content shorter than the parts")
+	}
+
+	path := filepath.Join(t.TempDir(), "file")
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if etag, err = ComputeFile(path, 100); err != nil || etag != multipart {
+		t.Errorf("expected %s, got %s, %v", multipart, etag, err)
+	}
+}
+
+func TestPartsAndEqual(t *testing.T) {
+	testCases := []struct {
+		etag  string
+		parts int
+	}{
+		{`"d41d8cd98f00b204e9800998ecf8427e"`, 0},
+		{"d41d8cd98f00b204e9800998ecf8427e-3", 3},
+		{`"d41d8cd98f00b204e9800998ecf8427e-10000"`, 10000},
+		{"d41d8cd98f00b204e9800998ecf8427e-", 0},
+		{"d41d8cd98f00b204e9800998ecf8427e-0", 0},
+	}
+	for i, testCase := range testCases {
+		if parts := Parts(testCase.etag); parts != testCase.parts {
+			t.Errorf("Test %d: expected %d parts, got %d", i+1, testCase.parts, parts)
+		}
+	}
+	if !Equal(`"D41D8CD98F00B204E9800998ECF8427E"`, "d41d8cd98f00b204e9800998ecf8427e") {
+		t.Error("expected ETags to be equal")
+	}
+	if Equal("d41d8cd98f00b204e9800998ecf8427e", "d41d8cd98f00b204e9800998ecf8427e-1") {
+		t.Error("expected ETags to differ")
+	}
+	if _, err := Multipart([]string{"invalid"}); err == nil {
+		t.Error("expected error for invalid part ETag")
+	}
+}
@@ -0,0 +1,336 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package eventstream encodes and decodes messages of the AWS event
+// stream framing, used e.g. by the responses of S3 Select.
+//
+// A message is framed by a prelude holding its total length and the
+// length of its headers, both protected by a CRC32, followed by the
+// headers, the payload and a CRC32 of the whole message:
+//
+//	dec := eventstream.NewDecoder(resp.Body)
+//	for {
+//	    msg, err := dec.Decode()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if msg.Headers.String(eventstream.EventTypeHeader) == "End" {
+//	        return nil
+//	    }
+//	    ...
+//	}
+package eventstream
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+// Headers set on the messages of AWS services.
+const (
+	MessageTypeHeader  = ":message-type"
+	EventTypeHeader    = ":event-type"
+	ContentTypeHeader  = ":content-type"
+	ErrorCodeHeader    = ":error-code"
+	ErrorMessageHeader = ":error-message"
+	ExceptionHeader    = ":exception-type"
+)
+
+// Limits of the framing, larger messages are rejected.
+const (
+	MaxPayloadLen = 16 << 20
+	MaxHeadersLen = 128 << 10
+)
+
+// preludeLen is the length of the prelude and its CRC, msgCRCLen the
+// length of the CRC ending a message.
+const (
+	preludeLen = 12
+	msgCRCLen  = 4
+)
+
+// HeaderType is the type of a header value.
+type HeaderType uint8
+
+// Types of header values and the Go types they are decoded to.
+const (
+	BoolTrueType  HeaderType = iota // bool
+	BoolFalseType                   // bool
+	ByteType                        // int8
+	Int16Type                       // int16
+	Int32Type                       // int32
+	Int64Type                       // int64
+	BytesType                       // []byte
+	StringType                      // string
+	TimestampType                   // time.Time, in milliseconds
+	UUIDType                        // [16]byte
+)
+
+// Header is a header of a message.
+type Header struct {
+	Name  string
+	Value interface{}
+}
+
+// Headers are the headers of a message in their order.
+type Headers []Header
+
+// Get returns the value of the header name, nil if not present.
+func (h Headers) Get(name string) interface{} {
+	for _, header := range h {
+		if header.Name == name {
+			return header.Value
+		}
+	}
+	return nil
+}
+
+// String returns the value of the string header name, empty if not
+// present or of another type.
+func (h Headers) String(name string) string {
+	s, _ := h.Get(name).(string)
+	return s
+}
+
+// Set sets the header name to value, replacing an existing header.
+func (h *Headers) Set(name string, value interface{}) {
+	for i, header := range *h {
+		if header.Name == name {
+			(*h)[i].Value = value
+			return
+		}
+	}
+	*h = append(*h, Header{Name: name, Value: value})
+}
+
+// Message is a message of an event stream.
+type Message struct {
+	Headers Headers
+	Payload []byte
+}
+
+// Decoder reads messages from an event stream.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder returns a decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next message. It returns io.EOF at the end of the
+// stream and io.ErrUnexpectedEOF if the stream ends within a message.
+func (d *Decoder) Decode() (Message, error) {
+	var prelude [preludeLen]byte
+	if _, err := io.ReadFull(d.r, prelude[:]); err != nil {
+		return Message{}, err
+	}
+	totalLen := binary.BigEndian.Uint32(prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(prelude[4:8])
+	if crc := crc32.ChecksumIEEE(prelude[:8]); crc != binary.BigEndian.Uint32(prelude[8:12]) {
+		return Message{}, fmt.Errorf("eventstream: prelude checksum mismatch, 0x%X does not equal expected 0x%X", binary.BigEndian.Uint32(prelude[8:12]), crc)
+	}
+	if headersLen > MaxHeadersLen {
+		return Message{}, fmt.Errorf("eventstream: headers length %d exceeds %d", headersLen, MaxHeadersLen)
+	}
+	if totalLen < preludeLen+msgCRCLen+headersLen {
+		return Message{}, fmt.Errorf("eventstream: invalid message length %d", totalLen)
+	}
+	payloadLen := totalLen - preludeLen - msgCRCLen - headersLen
+	if payloadLen > MaxPayloadLen {
+		return Message{}, fmt.Errorf("eventstream: payload length %d exceeds %d", payloadLen, MaxPayloadLen)
+	}
+
+	buf := make([]byte, totalLen-preludeLen)
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return Message{}, err
+	}
+	crc := crc32.Update(crc32.ChecksumIEEE(prelude[:]), crc32.IEEETable, buf[:len(buf)-msgCRCLen])
+	if expect := binary.BigEndian.Uint32(buf[len(buf)-msgCRCLen:]); crc != expect {
+		return Message{}, fmt.Errorf("eventstream: message checksum mismatch, 0x%X does not equal expected 0x%X", expect, crc)
+	}
+
+	headers, err := decodeHeaders(buf[:headersLen])
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Headers: headers, Payload: buf[headersLen : len(buf)-msgCRCLen]}, nil
+}
+
+var errShortHeaders = errors.New("eventstream: truncated headers")
+
+// valueLen returns the encoded length of a value of type typ at the
+// start of b, -1 for unknown types.
+func valueLen(typ HeaderType, b []byte) int {
+	switch typ {
+	case BoolTrueType, BoolFalseType:
+		return 0
+	case ByteType:
+		return 1
+	case Int16Type:
+		return 2
+	case Int32Type:
+		return 4
+	case Int64Type, TimestampType:
+		return 8
+	case UUIDType:
+		return 16
+	case BytesType, StringType:
+		if len(b) < 2 {
+			return len(b) + 1 // truncated
+		}
+		return 2 + int(binary.BigEndian.Uint16(b))
+	}
+	return -1
+}
+
+func decodeHeaders(b []byte) (Headers, error) {
+	var headers Headers
+	for len(b) > 0 {
+		nameLen := int(b[0])
+		if len(b) < 2+nameLen {
+			return nil, errShortHeaders
+		}
+		name := string(b[1 : 1+nameLen])
+		typ := HeaderType(b[1+nameLen])
+		b = b[2+nameLen:]
+
+		n := valueLen(typ, b)
+		if n < 0 {
+			return nil, fmt.Errorf("eventstream: unknown type %d of header %s", typ, name)
+		}
+		if len(b) < n {
+			return nil, errShortHeaders
+		}
+		var value interface{}
+		switch typ {
+		case BoolTrueType:
+			value = true
+		case BoolFalseType:
+			value = false
+		case ByteType:
+			value = int8(b[0])
+		case Int16Type:
+			value = int16(binary.BigEndian.Uint16(b))
+		case Int32Type:
+			value = int32(binary.BigEndian.Uint32(b))
+		case Int64Type:
+			value = int64(binary.BigEndian.Uint64(b))
+		case TimestampType:
+			value = time.UnixMilli(int64(binary.BigEndian.Uint64(b))).UTC()
+		case UUIDType:
+			value = [16]byte(b[:16])
+		case BytesType:
+			value = append([]byte(nil), b[2:n]...)
+		case StringType:
+			value = string(b[2:n])
+		}
+		headers = append(headers, Header{Name: name, Value: value})
+		b = b[n:]
+	}
+	return headers, nil
+}
+
+// Encoder writes messages to an event stream.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes a message. Header values must be of the Go types of
+// the HeaderType constants.
+func (e *Encoder) Encode(msg Message) error {
+	headers, err := encodeHeaders(msg.Headers)
+	if err != nil {
+		return err
+	}
+	if len(headers) > MaxHeadersLen {
+		return fmt.Errorf("eventstream: headers length %d exceeds %d", len(headers), MaxHeadersLen)
+	}
+	if len(msg.Payload) > MaxPayloadLen {
+		return fmt.Errorf("eventstream: payload length %d exceeds %d", len(msg.Payload), MaxPayloadLen)
+	}
+
+	totalLen := preludeLen + len(headers) + len(msg.Payload) + msgCRCLen
+	buf := make([]byte, 0, totalLen)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(totalLen))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(headers)))
+	buf = binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf))
+	buf = append(buf, headers...)
+	buf = append(buf, msg.Payload...)
+	buf = binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf))
+	_, err = e.w.Write(buf)
+	return err
+}
+
+func encodeHeaders(headers Headers) ([]byte, error) {
+	var b []byte
+	for _, h := range headers {
+		if len(h.Name) == 0 || len(h.Name) > 255 {
+			return nil, fmt.Errorf("eventstream: invalid header name %q", h.Name)
+		}
+		b = append(b, byte(len(h.Name)))
+		b = append(b, h.Name...)
+		switch v := h.Value.(type) {
+		case bool:
+			if v {
+				b = append(b, byte(BoolTrueType))
+			} else {
+				b = append(b, byte(BoolFalseType))
+			}
+		case int8:
+			b = append(b, byte(ByteType), byte(v))
+		case int16:
+			b = binary.BigEndian.AppendUint16(append(b, byte(Int16Type)), uint16(v))
+		case int32:
+			b = binary.BigEndian.AppendUint32(append(b, byte(Int32Type)), uint32(v))
+		case int64:
+			b = binary.BigEndian.AppendUint64(append(b, byte(Int64Type)), uint64(v))
+		case []byte:
+			if len(v) > 1<<16-1 {
+				return nil, fmt.Errorf("eventstream: value of header %s too long", h.Name)
+			}
+			b = binary.BigEndian.AppendUint16(append(b, byte(BytesType)), uint16(len(v)))
+			b = append(b, v...)
+		case string:
+			if len(v) > 1<<16-1 {
+				return nil, fmt.Errorf("eventstream: value of header %s too long", h.Name)
+			}
+			b = binary.BigEndian.AppendUint16(append(b, byte(StringType)), uint16(len(v)))
+			b = append(b, v...)
+		case time.Time:
+			b = binary.BigEndian.AppendUint64(append(b, byte(TimestampType)), uint64(v.UnixMilli()))
+		case [16]byte:
+			b = append(append(b, byte(UUIDType)), v[:]...)
+		default:
+			return nil, fmt.Errorf("eventstream: unsupported type %T of header %s", h.Value, h.Name)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,123 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	msgs := []Message{
+		{
+			Headers: Headers{
+				{Name: MessageTypeHeader, Value: "event"},
+				{Name: EventTypeHeader, Value: "Records"},
+				{Name: "true", Value: true},
+				{Name: "false", Value: false},
+				{Name: "byte", Value: int8(-1)},
+				{Name: "int16", Value: int16(-2)},
+				{Name: "int32", Value: int32(3)},
+				{Name: "int64", Value: int64(-4)},
+				{Name: "bytes", Value: []byte{1, 2}},
+				{Name: "time", Value: time.UnixMilli(1700000000123).UTC()},
+				{Name: "uuid", Value: [16]byte{15: 1}},
+			},
+			Payload: []byte("a,b\n"),
+		},
+		{},
+	}
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for _, msg := range msgs {
+		if err := enc.Encode(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dec := NewDecoder(&buf)
+	for i, want := range msgs {
+		got, err := dec.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Payload) == 0 {
+			got.Payload = nil
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("message %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if msgs[0].Headers.String(EventTypeHeader) != "Records" || msgs[0].Headers.Get("missing") != nil || msgs[0].Headers.String("int32") != "" {
+		t.Fatal("unexpected header lookup")
+	}
+}
+
+func TestEncodeLayout(t *testing.T) {
+	// Prelude, a string header of 1+4+1+2+1 bytes, payload and CRC.
+	var want bytes.Buffer
+	binary.Write(&want, binary.BigEndian, uint32(12+9+3+4))
+	binary.Write(&want, binary.BigEndian, uint32(9))
+	binary.Write(&want, binary.BigEndian, crc32.ChecksumIEEE(want.Bytes()))
+	want.Write([]byte{4, 'n', 'a', 'm', 'e', 7, 0, 1, 'v'})
+	want.WriteString("abc")
+	binary.Write(&want, binary.BigEndian, crc32.ChecksumIEEE(want.Bytes()))
+
+	var got bytes.Buffer
+	if err := NewEncoder(&got).Encode(Message{Headers: Headers{{Name: "name", Value: "v"}}, Payload: []byte("abc")}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("expected %x, got %x", want.Bytes(), got.Bytes())
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(Message{Headers: Headers{{Name: "a", Value: "b"}}, Payload: []byte("payload")}); err != nil {
+		t.Fatal(err)
+	}
+	msg := buf.Bytes()
+
+	corrupt := append([]byte(nil), msg...)
+	corrupt[len(corrupt)-6] ^= 1
+	if _, err := NewDecoder(bytes.NewReader(corrupt)).Decode(); err == nil {
+		t.Fatal("expected a checksum mismatch")
+	}
+	corrupt = append([]byte(nil), msg...)
+	corrupt[1] ^= 1
+	if _, err := NewDecoder(bytes.NewReader(corrupt)).Decode(); err == nil {
+		t.Fatal("expected a prelude checksum mismatch")
+	}
+	if _, err := NewDecoder(bytes.NewReader(msg[:len(msg)-1])).Decode(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+
+	if err := NewEncoder(io.Discard).Encode(Message{Headers: Headers{{Name: "a", Value: 1}}}); err == nil {
+		t.Fatal("expected an unsupported header type to fail")
+	}
+}
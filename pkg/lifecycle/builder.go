@@ -0,0 +1,180 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"slices"
+	"time"
+)
+
+// RuleBuilder builds a Rule step by step.
+//
+//	rule, err := lifecycle.NewRule().ID("logs").Prefix("logs/").ExpireDays(30).NoncurrentVersions(5).Build()
+//
+// The filter conditions are combined with And if more than one is set.
+type RuleBuilder struct {
+	rule            Rule
+	prefix          string
+	tags            []Tag
+	sizeLessThan    int64
+	sizeGreaterThan int64
+}
+
+// NewRule returns a builder of an enabled rule which applies to all
+// objects of the bucket.
+func NewRule() *RuleBuilder {
+	return &RuleBuilder{rule: Rule{Status: "Enabled"}}
+}
+
+// ID sets the ID of the rule, which identifies it when the rules of a
+// configuration are merged.
+func (b *RuleBuilder) ID(id string) *RuleBuilder {
+	b.rule.ID = id
+	return b
+}
+
+// Disabled builds a rule which is not applied.
+func (b *RuleBuilder) Disabled() *RuleBuilder {
+	b.rule.Status = "Disabled"
+	return b
+}
+
+// Prefix limits the rule to objects whose name starts with prefix.
+func (b *RuleBuilder) Prefix(prefix string) *RuleBuilder {
+	b.prefix = prefix
+	return b
+}
+
+// Tag limits the rule to objects having the tag key=value, it can be
+// used several times to require more tags.
+func (b *RuleBuilder) Tag(key, value string) *RuleBuilder {
+	b.tags = append(b.tags, Tag{Key: key, Value: value})
+	return b
+}
+
+// SizeLessThan limits the rule to objects smaller than size bytes.
+func (b *RuleBuilder) SizeLessThan(size int64) *RuleBuilder {
+	b.sizeLessThan = size
+	return b
+}
+
+// SizeGreaterThan limits the rule to objects larger than size bytes.
+func (b *RuleBuilder) SizeGreaterThan(size int64) *RuleBuilder {
+	b.sizeGreaterThan = size
+	return b
+}
+
+// ExpireDays expires objects days after their creation.
+func (b *RuleBuilder) ExpireDays(days int) *RuleBuilder {
+	b.rule.Expiration.Days = ExpirationDays(days)
+	return b
+}
+
+// ExpireDate expires objects at midnight UTC of date.
+func (b *RuleBuilder) ExpireDate(date time.Time) *RuleBuilder {
+	b.rule.Expiration.Date = ExpirationDate{date}
+	return b
+}
+
+// ExpireDeleteMarkers removes delete markers without noncurrent
+// versions.
+func (b *RuleBuilder) ExpireDeleteMarkers() *RuleBuilder {
+	b.rule.Expiration.DeleteMarker = true
+	return b
+}
+
+// TransitionDays moves objects to storageClass days after their
+// creation.
+func (b *RuleBuilder) TransitionDays(days int, storageClass string) *RuleBuilder {
+	b.rule.Transition.Days = ExpirationDays(days)
+	b.rule.Transition.StorageClass = storageClass
+	return b
+}
+
+// TransitionDate moves objects to storageClass at midnight UTC of date.
+func (b *RuleBuilder) TransitionDate(date time.Time, storageClass string) *RuleBuilder {
+	b.rule.Transition.Date = ExpirationDate{date}
+	b.rule.Transition.StorageClass = storageClass
+	return b
+}
+
+// NoncurrentExpireDays expires versions days after they became
+// noncurrent.
+func (b *RuleBuilder) NoncurrentExpireDays(days int) *RuleBuilder {
+	b.rule.NoncurrentVersionExpiration.NoncurrentDays = ExpirationDays(days)
+	return b
+}
+
+// NoncurrentVersions keeps the n newest noncurrent versions from
+// expiring.
+func (b *RuleBuilder) NoncurrentVersions(n int) *RuleBuilder {
+	b.rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = n
+	return b
+}
+
+// NoncurrentTransitionDays moves versions to storageClass days after
+// they became noncurrent.
+func (b *RuleBuilder) NoncurrentTransitionDays(days int, storageClass string) *RuleBuilder {
+	b.rule.NoncurrentVersionTransition.NoncurrentDays = ExpirationDays(days)
+	b.rule.NoncurrentVersionTransition.StorageClass = storageClass
+	return b
+}
+
+// AbortIncompleteUploadDays aborts multipart uploads days after they
+// were initiated.
+func (b *RuleBuilder) AbortIncompleteUploadDays(days int) *RuleBuilder {
+	b.rule.AbortIncompleteMultipartUpload.DaysAfterInitiation = ExpirationDays(days)
+	return b
+}
+
+// DeleteMarkerExpireDays removes delete markers days after their
+// creation, along with all versions of the object. MinIO only.
+func (b *RuleBuilder) DeleteMarkerExpireDays(days int) *RuleBuilder {
+	b.rule.DelMarkerExpiration.Days = days
+	return b
+}
+
+// Build returns the rule, if it is valid.
+func (b *RuleBuilder) Build() (Rule, error) {
+	rule := b.rule
+	conditions := len(b.tags)
+	for _, set := range []bool{b.prefix != "", b.sizeLessThan != 0, b.sizeGreaterThan != 0} {
+		if set {
+			conditions++
+		}
+	}
+	if conditions > 1 {
+		rule.RuleFilter.And = And{
+			Prefix:                b.prefix,
+			Tags:                  slices.Clone(b.tags),
+			ObjectSizeLessThan:    b.sizeLessThan,
+			ObjectSizeGreaterThan: b.sizeGreaterThan,
+		}
+	} else {
+		rule.RuleFilter.Prefix = b.prefix
+		if len(b.tags) == 1 {
+			rule.RuleFilter.Tag = b.tags[0]
+		}
+		rule.RuleFilter.ObjectSizeLessThan = b.sizeLessThan
+		rule.RuleFilter.ObjectSizeGreaterThan = b.sizeGreaterThan
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
@@ -0,0 +1,177 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRuleBuilder(t *testing.T) {
+	rule, err := NewRule().ID("logs").Prefix("logs/").ExpireDays(30).NoncurrentVersions(5).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := xml.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<Rule><Expiration><Days>30</Days></Expiration><ID>logs</ID><Filter><Prefix>logs/</Prefix></Filter><NoncurrentVersionExpiration><NewerNoncurrentVersions>5</NewerNoncurrentVersions></NoncurrentVersionExpiration><Status>Enabled</Status></Rule>`
+	if string(got) != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	// Several conditions are combined with And.
+	rule, err = NewRule().Prefix("tmp/").Tag("env", "dev").SizeGreaterThan(1024).TransitionDays(7, "WARM").ExpireDays(30).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	and := rule.RuleFilter.And
+	if and.Prefix != "tmp/" || len(and.Tags) != 1 || and.ObjectSizeGreaterThan != 1024 || rule.RuleFilter.Prefix != "" {
+		t.Fatalf("unexpected filter %+v", rule.RuleFilter)
+	}
+
+	if _, err = NewRule().Prefix("tmp/").Build(); err == nil {
+		t.Fatal("expected a rule without action to fail")
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	midnight := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		rule *RuleBuilder
+		err  string
+	}{
+		{NewRule().ExpireDays(10).TransitionDays(5, "WARM"), ""},
+		{NewRule().ExpireDate(midnight).TransitionDate(midnight.AddDate(0, 0, -1), "WARM"), ""},
+		{NewRule().ExpireDays(5).TransitionDays(5, "WARM"), "expiration days must be greater than transition days"},
+		{NewRule().ExpireDate(midnight).TransitionDate(midnight, "WARM"), "expiration date must be after transition date"},
+		{NewRule().ExpireDate(midnight.Add(time.Hour)), "expiration date must be midnight UTC"},
+		{NewRule().ExpireDays(1).ExpireDate(midnight), "expiration cannot set both days and date"},
+		{NewRule().ExpireDeleteMarkers().ExpireDays(1), "expiration of delete markers cannot be combined with days or date"},
+		{NewRule().ExpireDeleteMarkers().Tag("a", "b"), "expiration of delete markers cannot be used with tag filters"},
+		{NewRule().TransitionDays(3, ""), "storage-class cannot be empty"},
+		{NewRule().NoncurrentExpireDays(3).NoncurrentTransitionDays(3, "WARM"), "noncurrent expiration days must be greater than noncurrent transition days"},
+		{NewRule().AbortIncompleteUploadDays(1).Tag("a", "b"), "aborting incomplete uploads cannot be used with tag filters"},
+		{NewRule().ExpireDays(-1), "days cannot be negative"},
+		{NewRule().ExpireDays(1).SizeGreaterThan(10).SizeLessThan(11), "object size range of the filter is empty"},
+		{NewRule().ExpireDays(1).ID(strings.Repeat("a", 256)), "ID cannot be longer than 255 characters"},
+	}
+	for i, tc := range testCases {
+		_, err := tc.rule.Build()
+		if tc.err == "" && err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Errorf("Test %d: expected error %q, got %v", i+1, tc.err, err)
+		}
+	}
+
+	// A hand written filter setting a tag and a prefix without And.
+	rule := Rule{Status: "Enabled", Expiration: Expiration{Days: 1}, RuleFilter: Filter{Prefix: "a", Tag: Tag{Key: "k"}}}
+	if err := rule.Validate(); err == nil {
+		t.Fatal("expected a tag and a prefix without And to fail")
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	mustBuild := func(b *RuleBuilder) Rule {
+		rule, err := b.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rule
+	}
+	config := &Configuration{Rules: []Rule{
+		mustBuild(NewRule().ID("a").Prefix("logs/").ExpireDays(30)),
+		mustBuild(NewRule().ID("b").Prefix("logs/").TransitionDays(7, "WARM")),
+		mustBuild(NewRule().ID("c").Prefix("tmp/").ExpireDays(1)),
+	}}
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	config.Rules = append(config.Rules, mustBuild(NewRule().ID("d").Prefix("logs/").ExpireDays(60)))
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), `"a" and "d"`) {
+		t.Fatalf("expected conflicting rules, got %v", err)
+	}
+	config.Rules[3] = mustBuild(NewRule().ID("d").Prefix("logs/").ExpireDays(60).Disabled())
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	config.Rules[3].ID = "a"
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "not unique") {
+		t.Fatalf("expected duplicate IDs, got %v", err)
+	}
+}
+
+func TestConfigurationMergeDiff(t *testing.T) {
+	mustBuild := func(b *RuleBuilder) Rule {
+		rule, err := b.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rule
+	}
+	old := &Configuration{Rules: []Rule{
+		mustBuild(NewRule().ID("logs").Prefix("logs/").ExpireDays(30)),
+		mustBuild(NewRule().ID("tmp").Prefix("tmp/").ExpireDays(1)),
+		mustBuild(NewRule().Prefix("old/").ExpireDays(365)),
+	}}
+
+	// Round trip through XML, as the configuration is read from a server.
+	buf, err := xml.Marshal(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := NewConfiguration()
+	if err = xml.Unmarshal(buf, config); err != nil {
+		t.Fatal(err)
+	}
+	if d := Diff(old, config); !d.IsEmpty() {
+		t.Fatalf("expected no changes, got %+v", d)
+	}
+
+	if err = config.SetRule(mustBuild(NewRule().ID("logs").Prefix("logs/").ExpireDays(90))); err != nil {
+		t.Fatal(err)
+	}
+	if err = config.Merge(&Configuration{Rules: []Rule{mustBuild(NewRule().ID("new").Prefix("new/").ExpireDays(7))}}); err != nil {
+		t.Fatal(err)
+	}
+	if !config.RemoveRule("tmp") || config.RemoveRule("tmp") {
+		t.Fatal("expected the rule to be removed once")
+	}
+	if err = config.SetRule(mustBuild(NewRule().Prefix("x/").ExpireDays(1))); err == nil {
+		t.Fatal("expected a rule without ID to fail")
+	}
+
+	d := Diff(old, config)
+	if len(d.Added) != 1 || d.Added[0].ID != "new" ||
+		len(d.Removed) != 1 || d.Removed[0].ID != "tmp" ||
+		len(d.Changed) != 1 || d.Changed[0].Old.Expiration.Days != 30 || d.Changed[0].New.Expiration.Days != 90 {
+		t.Fatalf("unexpected diff %+v", d)
+	}
+	if rule, ok := config.Rule("logs"); !ok || rule.Expiration.Days != 90 {
+		t.Fatalf("unexpected rule %+v", rule)
+	}
+	if len(config.Rules) != 3 {
+		t.Fatalf("expected 3 rules, got %d", len(config.Rules))
+	}
+}
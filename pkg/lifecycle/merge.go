@@ -0,0 +1,152 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var errMissingRuleID = errors.New("rule ID cannot be empty")
+
+// Equal returns true if both rules have the same ID, filter and
+// actions.
+func (r Rule) Equal(other Rule) bool {
+	a, erra := json.Marshal(r)
+	b, errb := json.Marshal(other)
+	return erra == nil && errb == nil && bytes.Equal(a, b)
+}
+
+// Rule returns the rule with the ID id.
+func (c *Configuration) Rule(id string) (Rule, bool) {
+	if c == nil {
+		return Rule{}, false
+	}
+	for _, r := range c.Rules {
+		if r.ID == id {
+			return r, true
+		}
+	}
+	return Rule{}, false
+}
+
+// SetRule replaces the rule with the ID of rule, or adds rule if there
+// is none, keeping all other rules.
+func (c *Configuration) SetRule(rule Rule) error {
+	if rule.ID == "" {
+		return errMissingRuleID
+	}
+	for i, r := range c.Rules {
+		if r.ID == rule.ID {
+			c.Rules[i] = rule
+			return nil
+		}
+	}
+	c.Rules = append(c.Rules, rule)
+	return nil
+}
+
+// RemoveRule removes the rule with the ID id and returns whether there
+// was one.
+func (c *Configuration) RemoveRule(id string) bool {
+	for i, r := range c.Rules {
+		if r.ID == id {
+			c.Rules = append(c.Rules[:i:i], c.Rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Merge sets all rules of other in c, see SetRule.
+func (c *Configuration) Merge(other *Configuration) error {
+	if other.Empty() {
+		return nil
+	}
+	for _, r := range other.Rules {
+		if r.ID == "" {
+			return errMissingRuleID
+		}
+	}
+	for _, r := range other.Rules {
+		c.SetRule(r)
+	}
+	return nil
+}
+
+// RuleChange is a rule whose filter or actions changed.
+type RuleChange struct {
+	Old, New Rule
+}
+
+// ConfigurationDiff lists the differences between two configurations.
+type ConfigurationDiff struct {
+	Added   []Rule
+	Removed []Rule
+	Changed []RuleChange
+}
+
+// IsEmpty returns true if both configurations have the same rules.
+func (d ConfigurationDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff returns the changes from the configuration from to the
+// configuration to. Rules are matched by ID, rules without ID only
+// match equal rules.
+func Diff(from, to *Configuration) ConfigurationDiff {
+	var d ConfigurationDiff
+	var fromRules, toRules []Rule
+	if !from.Empty() {
+		fromRules = from.Rules
+	}
+	if !to.Empty() {
+		toRules = to.Rules
+	}
+
+	matched := make([]bool, len(toRules))
+	for _, old := range fromRules {
+		found := false
+		for j, r := range toRules {
+			if matched[j] {
+				continue
+			}
+			if old.ID != "" && r.ID == old.ID {
+				if !old.Equal(r) {
+					d.Changed = append(d.Changed, RuleChange{Old: old, New: r})
+				}
+				matched[j], found = true, true
+				break
+			}
+			if old.ID == "" && r.ID == "" && old.Equal(r) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			d.Removed = append(d.Removed, old)
+		}
+	}
+	for j, r := range toRules {
+		if !matched[j] {
+			d.Added = append(d.Added, r)
+		}
+	}
+	return d
+}
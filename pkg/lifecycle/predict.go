@@ -0,0 +1,188 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"strings"
+	"time"
+)
+
+// Object describes an object version for Predict.
+type Object struct {
+	Name         string
+	Size         int64
+	ModTime      time.Time
+	StorageClass string
+	UserTags     map[string]string
+
+	// IsLatest is false for noncurrent versions.
+	IsLatest bool
+	// DeleteMarker is true if the version is a delete marker.
+	DeleteMarker bool
+	// NumVersions is the number of versions of the object, used to
+	// find delete markers without noncurrent versions.
+	NumVersions int
+
+	// SuccessorModTime is the time a noncurrent version became
+	// noncurrent, the modification time of the next newer version.
+	SuccessorModTime time.Time
+	// NewerNoncurrentVersions is the number of noncurrent versions
+	// newer than this noncurrent version.
+	NewerNoncurrentVersions int
+}
+
+// Prediction is when lifecycle rules expire or transition an object
+// version. Zero times mean the rules never do.
+type Prediction struct {
+	Expiration       time.Time
+	ExpirationRuleID string
+
+	// ExpireAllVersions is true if all versions of the object are
+	// removed along with this one. MinIO only.
+	ExpireAllVersions bool
+
+	Transition             time.Time
+	TransitionStorageClass string
+	TransitionRuleID       string
+}
+
+// IsEmpty returns true if no rule expires or transitions the object.
+func (p Prediction) IsEmpty() bool {
+	return p.Expiration.IsZero() && p.Transition.IsZero()
+}
+
+// ExpectedExpiryTime returns the time days after modTime, rounded up to
+// the next midnight UTC, as S3 and MinIO compute lifecycle due dates.
+func ExpectedExpiryTime(modTime time.Time, days int) time.Time {
+	if days == 0 {
+		return modTime
+	}
+	t := modTime.UTC().Add(time.Duration(days+1) * 24 * time.Hour)
+	return t.Truncate(24 * time.Hour)
+}
+
+// Predict evaluates the enabled rules of the configuration for obj and
+// returns the earliest expiration and transition. Permanent removal
+// takes precedence over transition, a transition due at or after the
+// expiration is not reported.
+func (c *Configuration) Predict(obj Object) Prediction {
+	var p Prediction
+	if c.Empty() {
+		return p
+	}
+	expire := func(due time.Time, id string, all bool) {
+		if !due.IsZero() && (p.Expiration.IsZero() || due.Before(p.Expiration)) {
+			p.Expiration, p.ExpirationRuleID, p.ExpireAllVersions = due, id, all
+		}
+	}
+	transition := func(due time.Time, id, storageClass string) {
+		// Nothing to do for objects already in the storage class.
+		if due.IsZero() || storageClass == "" || strings.EqualFold(storageClass, obj.StorageClass) {
+			return
+		}
+		if p.Transition.IsZero() || due.Before(p.Transition) {
+			p.Transition, p.TransitionRuleID, p.TransitionStorageClass = due, id, storageClass
+		}
+	}
+
+	for _, r := range c.Rules {
+		if r.Status != "Enabled" || !r.matches(obj) {
+			continue
+		}
+		switch {
+		case obj.DeleteMarker:
+			if !obj.IsLatest {
+				break
+			}
+			if !r.DelMarkerExpiration.IsNull() {
+				expire(ExpectedExpiryTime(obj.ModTime, r.DelMarkerExpiration.Days), r.ID, true)
+			}
+			if r.Expiration.IsDeleteMarkerExpirationEnabled() && obj.NumVersions == 1 {
+				expire(obj.ModTime, r.ID, false)
+			}
+		case obj.IsLatest:
+			if !r.AllVersionsExpiration.IsNull() && !r.AllVersionsExpiration.DeleteMarker.IsEnabled() {
+				expire(ExpectedExpiryTime(obj.ModTime, r.AllVersionsExpiration.Days), r.ID, true)
+			}
+			if !r.Expiration.IsDaysNull() {
+				expire(ExpectedExpiryTime(obj.ModTime, int(r.Expiration.Days)), r.ID, r.Expiration.DeleteAll.IsEnabled())
+			}
+			if !r.Expiration.IsDateNull() {
+				expire(r.Expiration.Date.Time, r.ID, false)
+			}
+			if !r.Transition.IsDateNull() {
+				transition(r.Transition.Date.Time, r.ID, r.Transition.StorageClass)
+			} else if !r.Transition.IsNull() {
+				// Zero days transition objects right away.
+				transition(ExpectedExpiryTime(obj.ModTime, int(r.Transition.Days)), r.ID, r.Transition.StorageClass)
+			}
+		default:
+			if obj.SuccessorModTime.IsZero() {
+				break
+			}
+			exp := r.NoncurrentVersionExpiration
+			if !exp.isNull() && obj.NewerNoncurrentVersions >= exp.NewerNoncurrentVersions {
+				expire(ExpectedExpiryTime(obj.SuccessorModTime, int(exp.NoncurrentDays)), r.ID, false)
+			}
+			if !r.NoncurrentVersionTransition.isNull() {
+				transition(ExpectedExpiryTime(obj.SuccessorModTime, int(r.NoncurrentVersionTransition.NoncurrentDays)),
+					r.ID, r.NoncurrentVersionTransition.StorageClass)
+			}
+		}
+	}
+	if !p.Expiration.IsZero() && !p.Transition.Before(p.Expiration) {
+		p.Transition, p.TransitionRuleID, p.TransitionStorageClass = time.Time{}, "", ""
+	}
+	return p
+}
+
+// matches returns true if the filter of the rule selects obj.
+func (r Rule) matches(obj Object) bool {
+	f := r.RuleFilter
+	prefix := r.Prefix
+	var tags []Tag
+	lessThan, greaterThan := f.ObjectSizeLessThan, f.ObjectSizeGreaterThan
+	if !f.And.IsEmpty() {
+		prefix, tags = f.And.Prefix, f.And.Tags
+		lessThan, greaterThan = f.And.ObjectSizeLessThan, f.And.ObjectSizeGreaterThan
+	} else {
+		if f.Prefix != "" {
+			prefix = f.Prefix
+		}
+		if !f.Tag.IsEmpty() {
+			tags = []Tag{f.Tag}
+		}
+	}
+
+	if !strings.HasPrefix(obj.Name, prefix) {
+		return false
+	}
+	for _, tag := range tags {
+		if v, ok := obj.UserTags[tag.Key]; !ok || v != tag.Value {
+			return false
+		}
+	}
+	// Size limits do not apply to delete markers.
+	if obj.DeleteMarker {
+		return true
+	}
+	if lessThan > 0 && obj.Size >= lessThan {
+		return false
+	}
+	return greaterThan == 0 || obj.Size > greaterThan
+}
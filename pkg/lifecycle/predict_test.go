@@ -0,0 +1,96 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPredict(t *testing.T) {
+	mustBuild := func(b *RuleBuilder) Rule {
+		rule, err := b.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rule
+	}
+	day := func(d int) time.Time {
+		return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	config := &Configuration{Rules: []Rule{
+		mustBuild(NewRule().ID("logs").Prefix("logs/").TransitionDays(7, "WARM").ExpireDays(30)),
+		mustBuild(NewRule().ID("big").Prefix("logs/").SizeGreaterThan(1000).Tag("env", "dev").ExpireDays(5)),
+		mustBuild(NewRule().ID("off").Prefix("logs/").ExpireDays(1).Disabled()),
+		mustBuild(NewRule().ID("old").Prefix("logs/").NoncurrentExpireDays(3).NoncurrentVersions(2)),
+		mustBuild(NewRule().ID("markers").ExpireDeleteMarkers()),
+	}}
+	// Created in the afternoon, due dates are rounded up to midnight.
+	modTime := day(1).Add(15 * time.Hour)
+
+	testCases := []struct {
+		obj  Object
+		want Prediction
+	}{
+		{
+			Object{Name: "logs/a", Size: 10, ModTime: modTime, IsLatest: true},
+			Prediction{Expiration: day(1).AddDate(0, 0, 31), ExpirationRuleID: "logs", Transition: day(9), TransitionStorageClass: "WARM", TransitionRuleID: "logs"},
+		},
+		{
+			// Already in the storage class.
+			Object{Name: "logs/a", Size: 10, ModTime: modTime, StorageClass: "WARM", IsLatest: true},
+			Prediction{Expiration: day(1).AddDate(0, 0, 31), ExpirationRuleID: "logs"},
+		},
+		{
+			// Expires before the transition.
+			Object{Name: "logs/a", Size: 2000, ModTime: modTime, IsLatest: true, UserTags: map[string]string{"env": "dev"}},
+			Prediction{Expiration: day(7), ExpirationRuleID: "big"},
+		},
+		{
+			// The size limit is exclusive.
+			Object{Name: "logs/a", Size: 1000, ModTime: modTime, IsLatest: true, UserTags: map[string]string{"env": "dev"}},
+			Prediction{Expiration: day(1).AddDate(0, 0, 31), ExpirationRuleID: "logs", Transition: day(9), TransitionStorageClass: "WARM", TransitionRuleID: "logs"},
+		},
+		{
+			Object{Name: "tmp/a", Size: 10, ModTime: modTime, IsLatest: true},
+			Prediction{},
+		},
+		{
+			Object{Name: "logs/a", ModTime: modTime, SuccessorModTime: day(10), NewerNoncurrentVersions: 2},
+			Prediction{Expiration: day(14), ExpirationRuleID: "old"},
+		},
+		{
+			// Kept as one of the newest noncurrent versions.
+			Object{Name: "logs/a", ModTime: modTime, SuccessorModTime: day(10), NewerNoncurrentVersions: 1},
+			Prediction{},
+		},
+		{
+			Object{Name: "tmp/a", ModTime: modTime, IsLatest: true, DeleteMarker: true, NumVersions: 1},
+			Prediction{Expiration: modTime, ExpirationRuleID: "markers"},
+		},
+		{
+			Object{Name: "tmp/a", ModTime: modTime, IsLatest: true, DeleteMarker: true, NumVersions: 2},
+			Prediction{},
+		},
+	}
+	for i, tc := range testCases {
+		if got := config.Predict(tc.obj); got != tc.want {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxRules is the maximum number of rules of a configuration.
+const maxRules = 1000
+
+// Validate checks the rule for mistakes which servers reject, or which
+// make the rule never apply.
+func (r Rule) Validate() error {
+	if r.Status != "Enabled" && r.Status != "Disabled" {
+		return errors.New("status must be Enabled or Disabled")
+	}
+	if len(r.ID) > 255 {
+		return errors.New("ID cannot be longer than 255 characters")
+	}
+	if err := r.validateFilter(); err != nil {
+		return err
+	}
+	hasTags := !r.RuleFilter.Tag.IsEmpty() || len(r.RuleFilter.And.Tags) > 0
+
+	if r.Expiration.IsNull() && r.Transition.IsNull() && r.Transition.IsDaysNull() && r.Transition.IsDateNull() &&
+		r.NoncurrentVersionExpiration.isNull() && r.NoncurrentVersionTransition.isNull() && r.NoncurrentVersionTransition.IsDaysNull() &&
+		r.AbortIncompleteMultipartUpload.IsDaysNull() && r.DelMarkerExpiration.IsNull() && r.AllVersionsExpiration.IsNull() {
+		return errors.New("rule must have at least one action")
+	}
+	for _, days := range []ExpirationDays{
+		r.Expiration.Days,
+		r.Transition.Days,
+		r.NoncurrentVersionExpiration.NoncurrentDays,
+		r.NoncurrentVersionTransition.NoncurrentDays,
+		r.AbortIncompleteMultipartUpload.DaysAfterInitiation,
+		ExpirationDays(r.DelMarkerExpiration.Days),
+		ExpirationDays(r.AllVersionsExpiration.Days),
+	} {
+		if days < 0 {
+			return errors.New("days cannot be negative")
+		}
+	}
+	if r.NoncurrentVersionExpiration.NewerNoncurrentVersions < 0 || r.NoncurrentVersionTransition.NewerNoncurrentVersions < 0 {
+		return errors.New("number of newer noncurrent versions cannot be negative")
+	}
+
+	// Expiration
+	if !r.Expiration.IsDaysNull() && !r.Expiration.IsDateNull() {
+		return errors.New("expiration cannot set both days and date")
+	}
+	if r.Expiration.IsDeleteMarkerExpirationEnabled() {
+		if !r.Expiration.IsDaysNull() || !r.Expiration.IsDateNull() {
+			return errors.New("expiration of delete markers cannot be combined with days or date")
+		}
+		if hasTags {
+			return errors.New("expiration of delete markers cannot be used with tag filters")
+		}
+	}
+	if !isMidnightUTC(r.Expiration.Date) {
+		return errors.New("expiration date must be midnight UTC")
+	}
+
+	// Transition
+	if !r.Transition.IsDaysNull() && !r.Transition.IsDateNull() {
+		return errors.New("transition cannot set both days and date")
+	}
+	if r.Transition.IsNull() && (!r.Transition.IsDaysNull() || !r.Transition.IsDateNull()) {
+		return errMissingStorageClass
+	}
+	if !isMidnightUTC(r.Transition.Date) {
+		return errors.New("transition date must be midnight UTC")
+	}
+	if !r.Transition.IsNull() {
+		switch {
+		case !r.Expiration.IsDaysNull() && r.Transition.IsDateNull() && r.Expiration.Days <= r.Transition.Days:
+			return errors.New("expiration days must be greater than transition days")
+		case !r.Expiration.IsDateNull() && !r.Transition.IsDateNull() && !r.Expiration.Date.After(r.Transition.Date.Time):
+			return errors.New("expiration date must be after transition date")
+		}
+	}
+
+	// Noncurrent versions
+	if r.NoncurrentVersionTransition.isNull() && !r.NoncurrentVersionTransition.IsDaysNull() {
+		return errMissingStorageClass
+	}
+	if !r.NoncurrentVersionTransition.isNull() && !r.NoncurrentVersionExpiration.IsDaysNull() &&
+		r.NoncurrentVersionExpiration.NoncurrentDays <= r.NoncurrentVersionTransition.NoncurrentDays {
+		return errors.New("noncurrent expiration days must be greater than noncurrent transition days")
+	}
+
+	if hasTags && !r.AbortIncompleteMultipartUpload.IsDaysNull() {
+		return errors.New("aborting incomplete uploads cannot be used with tag filters")
+	}
+	if hasTags && !r.DelMarkerExpiration.IsNull() {
+		return errors.New("delete marker expiration cannot be used with tag filters")
+	}
+	return nil
+}
+
+func (r Rule) validateFilter() error {
+	f := r.RuleFilter
+	if r.Prefix != "" && !f.IsNull() {
+		return errors.New("rule cannot set both a prefix and a filter")
+	}
+	lessThan, greaterThan := f.ObjectSizeLessThan, f.ObjectSizeGreaterThan
+	if !f.And.IsEmpty() {
+		if f.Prefix != "" || !f.Tag.IsEmpty() || lessThan != 0 || greaterThan != 0 {
+			return errors.New("filter cannot combine And with other conditions")
+		}
+		for _, tag := range f.And.Tags {
+			if tag.IsEmpty() {
+				return errors.New("filter tag key cannot be empty")
+			}
+		}
+		lessThan, greaterThan = f.And.ObjectSizeLessThan, f.And.ObjectSizeGreaterThan
+	} else if !f.Tag.IsEmpty() && (f.Prefix != "" || lessThan != 0 || greaterThan != 0) {
+		// Only one of them is sent to the server.
+		return errors.New("filter must use And to combine a tag with other conditions")
+	}
+	if lessThan < 0 || greaterThan < 0 {
+		return errors.New("object size limits cannot be negative")
+	}
+	if lessThan > 0 && greaterThan > 0 && lessThan <= greaterThan+1 {
+		return errors.New("object size range of the filter is empty")
+	}
+	return nil
+}
+
+func isMidnightUTC(date ExpirationDate) bool {
+	if date.IsZero() {
+		return true
+	}
+	t := date.UTC()
+	return t.Equal(t.Truncate(24 * time.Hour))
+}
+
+// Validate checks all rules and reports rules with the same ID, and
+// enabled rules with the same filter which set the same action.
+func (c *Configuration) Validate() error {
+	if c.Empty() {
+		return nil
+	}
+	if len(c.Rules) > maxRules {
+		return fmt.Errorf("configuration cannot have more than %d rules", maxRules)
+	}
+	ids := make(map[string]bool, len(c.Rules))
+	for i, r := range c.Rules {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("rule %s: %w", ruleName(i, r), err)
+		}
+		if r.ID != "" {
+			if ids[r.ID] {
+				return fmt.Errorf("rule %s: ID is not unique", ruleName(i, r))
+			}
+			ids[r.ID] = true
+		}
+	}
+	for i, a := range c.Rules {
+		for j := i + 1; j < len(c.Rules); j++ {
+			b := c.Rules[j]
+			if a.Status != "Enabled" || b.Status != "Enabled" || !sameFilter(a, b) {
+				continue
+			}
+			if action := sharedAction(a, b); action != "" {
+				return fmt.Errorf("rules %s and %s both set %s for the same filter", ruleName(i, a), ruleName(j, b), action)
+			}
+		}
+	}
+	return nil
+}
+
+// ruleName names the rule at index i in errors.
+func ruleName(i int, r Rule) string {
+	if r.ID != "" {
+		return fmt.Sprintf("%q", r.ID)
+	}
+	return fmt.Sprintf("#%d", i+1)
+}
+
+func sameFilter(a, b Rule) bool {
+	fa, erra := json.Marshal(a.RuleFilter)
+	fb, errb := json.Marshal(b.RuleFilter)
+	return erra == nil && errb == nil && a.Prefix == b.Prefix && string(fa) == string(fb)
+}
+
+// sharedAction returns the name of an action set by both rules.
+func sharedAction(a, b Rule) string {
+	switch {
+	case !a.Expiration.IsNull() && !b.Expiration.IsNull():
+		return "Expiration"
+	case !a.Transition.IsNull() && !b.Transition.IsNull():
+		return "Transition"
+	case !a.NoncurrentVersionExpiration.isNull() && !b.NoncurrentVersionExpiration.isNull():
+		return "NoncurrentVersionExpiration"
+	case !a.NoncurrentVersionTransition.isNull() && !b.NoncurrentVersionTransition.isNull():
+		return "NoncurrentVersionTransition"
+	case !a.AbortIncompleteMultipartUpload.IsDaysNull() && !b.AbortIncompleteMultipartUpload.IsDaysNull():
+		return "AbortIncompleteMultipartUpload"
+	}
+	return ""
+}
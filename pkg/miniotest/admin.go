@@ -0,0 +1,204 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// adminPrefix is the path of the MinIO admin API.
+const adminPrefix = "/minio/admin/v3/"
+
+type bucketQuota struct {
+	Quota     uint64 `json:"quota"`
+	Size      uint64 `json:"size"`
+	QuotaType string `json:"quotatype,omitempty"`
+}
+
+type bucketUsageInfo struct {
+	Size                 uint64            `json:"size"`
+	ObjectsCount         uint64            `json:"objectsCount"`
+	VersionsCount        uint64            `json:"versionsCount"`
+	DeleteMarkersCount   uint64            `json:"deleteMarkersCount"`
+	ObjectSizesHistogram map[string]uint64 `json:"objectsSizesHistogram"`
+}
+
+type dataUsageInfo struct {
+	LastUpdate         time.Time                  `json:"lastUpdate"`
+	ObjectsCount       uint64                     `json:"objectsCount"`
+	VersionsCount      uint64                     `json:"versionsCount"`
+	DeleteMarkersCount uint64                     `json:"deleteMarkersCount"`
+	ObjectsTotalSize   uint64                     `json:"objectsTotalSize"`
+	BucketsCount       uint64                     `json:"bucketsCount"`
+	BucketsUsage       map[string]bucketUsageInfo `json:"bucketsUsageInfo"`
+}
+
+// adminHandler serves the subset of the MinIO admin API managing
+// bucket quotas and remote tiers and reporting data usage. Quotas are
+// stored, not enforced, tiers are never contacted and the usage is
+// computed on every request.
+func (s *Server) adminHandler(w http.ResponseWriter, r *http.Request) {
+	var err *apiError
+	switch api := strings.TrimPrefix(r.URL.Path, adminPrefix); {
+	case api == "set-bucket-quota" && r.Method == http.MethodPut:
+		err = s.setBucketQuota(w, r)
+	case api == "get-bucket-quota" && r.Method == http.MethodGet:
+		err = s.getBucketQuota(w, r)
+	case api == "datausageinfo" && r.Method == http.MethodGet:
+		err = s.dataUsageInfo(w)
+	case api == "tier" && r.Method == http.MethodPut:
+		err = s.addTier(w, r)
+	case api == "tier" && r.Method == http.MethodGet:
+		err = s.listTiers(w)
+	case strings.HasPrefix(api, "tier/"):
+		err = s.tierHandler(w, r, strings.TrimPrefix(api, "tier/"))
+	default:
+		err = errNotImplemented()
+	}
+	if err != nil {
+		writeAdminError(w, r, err)
+	}
+}
+
+// writeAdminError writes an error of the admin API, which is JSON
+// encoded unlike the errors of the S3 API.
+func writeAdminError(w http.ResponseWriter, r *http.Request, e *apiError) {
+	resp := *e
+	resp.Resource = r.URL.Path
+	resp.RequestID = w.Header().Get("x-amz-request-id")
+	resp.HostID = "miniotest"
+	writeJSON(w, resp.status, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func (s *Server) setBucketQuota(w http.ResponseWriter, r *http.Request) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	var quota bucketQuota
+	if jerr := json.Unmarshal(body, &quota); jerr != nil {
+		return &apiError{Code: "XMinioAdminInvalidArgument", Message: "Invalid arguments specified.", status: http.StatusBadRequest}
+	}
+	if quota.Size == 0 {
+		quota.Size = quota.Quota
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(r.URL.Query().Get("bucket"))
+	if err != nil {
+		return err
+	}
+	if quota.Size == 0 {
+		b.quota = nil
+	} else {
+		b.quota = &quota
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) getBucketQuota(w http.ResponseWriter, r *http.Request) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(r.URL.Query().Get("bucket"))
+	if err != nil {
+		return err
+	}
+	if b.quota == nil {
+		return &apiError{Code: "XMinioAdminNoSuchQuotaConfiguration", Message: "The quota configuration does not exist", status: http.StatusNotFound}
+	}
+	writeJSON(w, http.StatusOK, b.quota)
+	return nil
+}
+
+func (s *Server) dataUsageInfo(w http.ResponseWriter) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info := dataUsageInfo{
+		LastUpdate:   time.Now().UTC(),
+		BucketsCount: uint64(len(s.buckets)),
+		BucketsUsage: make(map[string]bucketUsageInfo, len(s.buckets)),
+	}
+	for name, b := range s.buckets {
+		usage := bucketUsageInfo{ObjectSizesHistogram: make(map[string]uint64)}
+		for _, versions := range b.objects {
+			for i, v := range versions {
+				if v.deleteMarker {
+					usage.DeleteMarkersCount++
+					continue
+				}
+				usage.VersionsCount++
+				usage.Size += uint64(len(v.data))
+				if i == len(versions)-1 {
+					usage.ObjectsCount++
+					usage.ObjectSizesHistogram[sizeHistogramBucket(len(v.data))]++
+				}
+			}
+		}
+		info.ObjectsCount += usage.ObjectsCount
+		info.VersionsCount += usage.VersionsCount
+		info.DeleteMarkersCount += usage.DeleteMarkersCount
+		info.ObjectsTotalSize += usage.Size
+		info.BucketsUsage[name] = usage
+	}
+	writeJSON(w, http.StatusOK, info)
+	return nil
+}
+
+// sizeHistogramBucket returns the MinIO object size histogram
+// interval of an object of size bytes.
+func sizeHistogramBucket(size int) string {
+	switch {
+	case size < 1024:
+		return "LESS_THAN_1024_B"
+	case size < 64<<10:
+		return "BETWEEN_1024_B_AND_64_KB"
+	case size < 256<<10:
+		return "BETWEEN_64_KB_AND_256_KB"
+	case size < 512<<10:
+		return "BETWEEN_256_KB_AND_512_KB"
+	case size < 1<<20:
+		return "BETWEEN_512_KB_AND_1_MB"
+	case size < 10<<20:
+		return "BETWEEN_1_MB_AND_10_MB"
+	case size < 64<<20:
+		return "BETWEEN_10_MB_AND_64_MB"
+	case size < 128<<20:
+		return "BETWEEN_64_MB_AND_128_MB"
+	case size < 512<<20:
+		return "BETWEEN_128_MB_AND_512_MB"
+	default:
+		return "GREATER_THAN_512_MB"
+	}
+}
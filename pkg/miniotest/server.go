@@ -0,0 +1,2025 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package miniotest provides an in-process, in-memory S3 compatible
+// server for testing code that uses this client.
+//
+//	srv := miniotest.NewServer(t)
+//	clnt, err := minio.New(srv.Endpoint(), &minio.Options{
+//	    Creds: credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+//	})
+//
+// The server implements bucket and object CRUD, ListObjects (V1, V2
+// and versions), multi-object delete, versioning, default bucket
+// encryption, object lock, lifecycle and replication configurations
+// (stored, not applied), bucket and object tagging, object retention and legal
+// holds, restores of archived objects, multipart uploads including
+// part copies, conditional requests, the bucket quota (stored, not
+// enforced), data usage and remote tier (stored, not used) APIs of
+// MinIO and verification of
+// signature V4 headers and presigned URLs. It is not meant to be a
+// complete S3 implementation, unsupported sub-resources return
+// NotImplemented.
+//
+// Recorder records interactions with a real server to a cassette file
+// and replays them later without network access.
+package miniotest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Default credentials and region used by NewServer.
+const (
+	DefaultAccessKey = "miniotest"
+	DefaultSecretKey = "miniotest-secret"
+	DefaultRegion    = "us-east-1"
+)
+
+// Server is an in-memory S3 compatible server listening on a local
+// address. All state is lost when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	// Credentials accepted by the server.
+	AccessKey string
+	SecretKey string
+
+	// Region reported by GetBucketLocation for buckets created
+	// without an explicit location constraint.
+	Region string
+
+	// AllowAnonymous accepts unsigned requests when set.
+	AllowAnonymous bool
+
+	// RestoreDelay is the time restores of archived objects, i.e.
+	// objects in the GLACIER and DEEP_ARCHIVE storage classes, take
+	// to complete.
+	RestoreDelay time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	tiers   map[string]*tierConfig
+}
+
+// NewServer starts a new server which is closed automatically when
+// the test and all its subtests complete.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		AccessKey: DefaultAccessKey,
+		SecretKey: DefaultSecretKey,
+		Region:    DefaultRegion,
+		buckets:   make(map[string]*bucket),
+		tiers:     make(map[string]*tierConfig),
+	}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Endpoint returns the host:port of the server suitable for minio.New.
+func (s *Server) Endpoint() string {
+	return s.Listener.Addr().String()
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := strings.ToUpper(mustRandomID()[:16])
+	w.Header().Set("x-amz-request-id", requestID)
+	w.Header().Set("Server", "miniotest")
+
+	bucketName, objectName, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	if err := s.authenticate(r); err != nil {
+		writeError(w, r, err, bucketName, objectName)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, adminPrefix) {
+		s.adminHandler(w, r)
+		return
+	}
+
+	var err *apiError
+	switch {
+	case bucketName == "":
+		err = s.serviceHandler(w, r)
+	case objectName == "":
+		err = s.bucketHandler(w, r, bucketName)
+	default:
+		err = s.objectHandler(w, r, bucketName, objectName)
+	}
+	if err != nil {
+		writeError(w, r, err, bucketName, objectName)
+	}
+}
+
+func (s *Server) authenticate(r *http.Request) *apiError {
+	auth, err := parseV4Auth(r)
+	if err != nil {
+		return err
+	}
+	if auth == nil {
+		if s.AllowAnonymous {
+			return nil
+		}
+		return errAccessDenied
+	}
+	if auth.accessKey != s.AccessKey {
+		return errInvalidAccessKey
+	}
+	return auth.verify(r, s.SecretKey)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, e *apiError, bucketName, objectName string) {
+	resp := *e
+	resp.BucketName = bucketName
+	resp.Key = objectName
+	resp.Resource = r.URL.Path
+	resp.RequestID = w.Header().Get("x-amz-request-id")
+	resp.HostID = "miniotest"
+	if r.Method == http.MethodHead {
+		// HEAD responses carry no body.
+		w.WriteHeader(resp.status)
+		return
+	}
+	writeXML(w, resp.status, resp)
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(body)))
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	w.Write(body)
+}
+
+func readXML(r *http.Request, v interface{}) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	if xerr := xml.Unmarshal(body, v); xerr != nil {
+		return &apiError{Code: "MalformedXML", Message: xerr.Error(), status: http.StatusBadRequest}
+	}
+	return nil
+}
+
+// readBody reads the request payload, decoding aws-chunked bodies
+// and verifying Content-Md5 and X-Amz-Content-Sha256 when present.
+func readBody(r *http.Request) ([]byte, *apiError) {
+	data, _, err := readBodyTrailer(r)
+	return data, err
+}
+
+// readBodyTrailer is readBody also returning the trailing headers of
+// aws-chunked bodies.
+func readBodyTrailer(r *http.Request) ([]byte, http.Header, *apiError) {
+	var (
+		data    []byte
+		trailer http.Header
+		err     error
+	)
+	if isAWSChunked(r) {
+		data, trailer, err = readAWSChunked(r.Body)
+	} else {
+		data, err = io.ReadAll(r.Body)
+	}
+	if err != nil {
+		return nil, nil, &apiError{Code: "IncompleteBody", Message: err.Error(), status: http.StatusBadRequest}
+	}
+	if md5B64 := r.Header.Get("Content-Md5"); md5B64 != "" {
+		sum, _ := hex.DecodeString(md5Hex(data))
+		if base64.StdEncoding.EncodeToString(sum) != md5B64 {
+			return nil, nil, &apiError{Code: "BadDigest", Message: "The Content-Md5 you specified did not match what we received.", status: http.StatusBadRequest}
+		}
+	}
+	if sha := r.Header.Get("X-Amz-Content-Sha256"); len(sha) == 64 {
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != sha {
+			return nil, nil, &apiError{Code: "XAmzContentSHA256Mismatch", Message: "The provided 'x-amz-content-sha256' header does not match what was computed.", status: http.StatusBadRequest}
+		}
+	}
+	return data, trailer, nil
+}
+
+func errNoSuchBucket() *apiError {
+	return &apiError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", status: http.StatusNotFound}
+}
+
+func errNoSuchKey() *apiError {
+	return &apiError{Code: "NoSuchKey", Message: "The specified key does not exist.", status: http.StatusNotFound}
+}
+
+func errNoSuchUpload() *apiError {
+	return &apiError{Code: "NoSuchUpload", Message: "The specified multipart upload does not exist.", status: http.StatusNotFound}
+}
+
+func errNotImplemented() *apiError {
+	return &apiError{Code: "NotImplemented", Message: "A header you provided implies functionality that is not implemented", status: http.StatusNotImplemented}
+}
+
+func errInvalidArgument(msg string) *apiError {
+	return &apiError{Code: "InvalidArgument", Message: msg, status: http.StatusBadRequest}
+}
+
+func errInvalidObjectState() *apiError {
+	return &apiError{Code: "InvalidObjectState", Message: "The operation is not valid for the object's storage class", status: http.StatusForbidden}
+}
+
+func errPreconditionFailed() *apiError {
+	return &apiError{Code: "PreconditionFailed", Message: "At least one of the pre-conditions you specified did not hold", status: http.StatusPreconditionFailed}
+}
+
+// getBucket returns the named bucket, callers must hold s.mu.
+func (s *Server) getBucket(name string) (*bucket, *apiError) {
+	b, ok := s.buckets[name]
+	if !ok {
+		return nil, errNoSuchBucket()
+	}
+	return b, nil
+}
+
+func (s *Server) serviceHandler(w http.ResponseWriter, r *http.Request) *apiError {
+	if r.Method != http.MethodGet {
+		return errNotImplemented()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := listAllMyBucketsResult{XMLNS: xmlNS, Owner: owner{ID: s.AccessKey, DisplayName: s.AccessKey}}
+	for _, b := range s.buckets {
+		res.Buckets.Bucket = append(res.Buckets.Bucket, bucketEntry{Name: b.name, CreationDate: b.created})
+	}
+	sortBuckets(res.Buckets.Bucket)
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+func sortBuckets(entries []bucketEntry) {
+	for i := 1; i < len(entries); i++ {
+		for j := i; j > 0 && entries[j].Name < entries[j-1].Name; j-- {
+			entries[j], entries[j-1] = entries[j-1], entries[j]
+		}
+	}
+}
+
+func (s *Server) bucketHandler(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodPut:
+		if query.Has("versioning") {
+			return s.putBucketVersioning(w, r, bucketName)
+		}
+		if query.Has("tagging") {
+			return s.putBucketTagging(w, r, bucketName)
+		}
+		if query.Has("encryption") {
+			return s.putBucketEncryption(w, r, bucketName)
+		}
+		if query.Has("lifecycle") {
+			return s.putBucketLifecycle(w, r, bucketName)
+		}
+		if query.Has("replication") {
+			return s.putBucketReplication(w, r, bucketName)
+		}
+		if query.Has("object-lock") {
+			return s.putBucketObjectLock(w, r, bucketName)
+		}
+		if len(query) > 0 {
+			return errNotImplemented()
+		}
+		return s.makeBucket(w, r, bucketName)
+	case http.MethodHead:
+		s.mu.Lock()
+		b, err := s.getBucket(bucketName)
+		s.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		w.Header().Set("X-Amz-Bucket-Region", b.region)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case http.MethodDelete:
+		if query.Has("tagging") {
+			return s.deleteBucketTagging(w, bucketName)
+		}
+		if query.Has("encryption") {
+			return s.deleteBucketEncryption(w, bucketName)
+		}
+		if query.Has("lifecycle") {
+			return s.deleteBucketLifecycle(w, bucketName)
+		}
+		if query.Has("replication") {
+			return s.deleteBucketReplication(w, bucketName)
+		}
+		if len(query) > 0 {
+			return errNotImplemented()
+		}
+		return s.removeBucket(w, r, bucketName)
+	case http.MethodPost:
+		if query.Has("delete") {
+			return s.deleteObjects(w, r, bucketName)
+		}
+		return errNotImplemented()
+	case http.MethodGet:
+		switch {
+		case query.Has("location"):
+			s.mu.Lock()
+			b, err := s.getBucket(bucketName)
+			s.mu.Unlock()
+			if err != nil {
+				return err
+			}
+			location := b.region
+			if location == "us-east-1" {
+				location = ""
+			}
+			writeXML(w, http.StatusOK, locationConstraint{XMLNS: xmlNS, Location: location})
+			return nil
+		case query.Has("versioning"):
+			s.mu.Lock()
+			b, err := s.getBucket(bucketName)
+			s.mu.Unlock()
+			if err != nil {
+				return err
+			}
+			writeXML(w, http.StatusOK, versioningConfiguration{
+				XMLNS:            xmlNS,
+				Status:           b.versioning,
+				ExcludedPrefixes: b.excludedPrefixes,
+				ExcludeFolders:   b.excludeFolders,
+			})
+			return nil
+		case query.Has("tagging"):
+			return s.getBucketTagging(w, bucketName)
+		case query.Has("encryption"):
+			return s.getBucketEncryption(w, bucketName)
+		case query.Has("lifecycle"):
+			return s.getBucketLifecycle(w, bucketName)
+		case query.Has("replication"):
+			return s.getBucketReplication(w, bucketName)
+		case query.Has("object-lock"):
+			return s.getBucketObjectLock(w, bucketName)
+		case query.Has("versions"):
+			return s.listObjectVersions(w, r, bucketName)
+		case query.Has("uploads"):
+			return s.listMultipartUploads(w, r, bucketName)
+		case query.Get("list-type") == "2":
+			return s.listObjectsV2(w, r, bucketName)
+		}
+		for k := range query {
+			switch k {
+			case "prefix", "delimiter", "marker", "max-keys", "encoding-type":
+			default:
+				return errNotImplemented()
+			}
+		}
+		return s.listObjectsV1(w, r, bucketName)
+	}
+	return errNotImplemented()
+}
+
+func (s *Server) makeBucket(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	region := s.Region
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		var cfg createBucketConfiguration
+		if xerr := xml.Unmarshal(body, &cfg); xerr != nil {
+			return &apiError{Code: "MalformedXML", Message: xerr.Error(), status: http.StatusBadRequest}
+		}
+		if cfg.Location != "" {
+			region = cfg.Location
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.buckets[bucketName]; ok {
+		return &apiError{Code: "BucketAlreadyOwnedByYou", Message: "Your previous request to create the named bucket succeeded and you already own it.", status: http.StatusConflict}
+	}
+	b := newBucket(bucketName, region)
+	if r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true" {
+		b.versioning = "Enabled"
+	}
+	s.buckets[bucketName] = b
+	w.Header().Set("Location", "/"+bucketName)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) removeBucket(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if len(b.objects) > 0 && r.Header.Get("X-Minio-Force-Delete") != "true" {
+		return &apiError{Code: "BucketNotEmpty", Message: "The bucket you tried to delete is not empty", status: http.StatusConflict}
+	}
+	delete(s.buckets, bucketName)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) putBucketVersioning(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	var cfg versioningConfiguration
+	if err := readXML(r, &cfg); err != nil {
+		return err
+	}
+	if cfg.Status != "Enabled" && cfg.Status != "Suspended" {
+		return &apiError{Code: "IllegalVersioningConfigurationException", Message: "The versioning configuration specified in the request is invalid.", status: http.StatusBadRequest}
+	}
+	if cfg.Status != "Enabled" && (len(cfg.ExcludedPrefixes) > 0 || cfg.ExcludeFolders) {
+		return &apiError{Code: "IllegalVersioningConfigurationException", Message: "Excluded prefixes and folders require versioning to be enabled.", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.versioning = cfg.Status
+	b.excludedPrefixes = cfg.ExcludedPrefixes
+	b.excludeFolders = cfg.ExcludeFolders
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) getBucketTagging(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if len(b.tags) == 0 {
+		return &apiError{Code: "NoSuchTagSet", Message: "The TagSet does not exist", status: http.StatusNotFound}
+	}
+	out := tagging{XMLNS: xmlNS}
+	for k, val := range b.tags {
+		out.TagSet.Tags = append(out.TagSet.Tags, tag{Key: k, Value: val})
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) putBucketTagging(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	var in tagging
+	if err := readXML(r, &in); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.tags = make(map[string]string, len(in.TagSet.Tags))
+	for _, t := range in.TagSet.Tags {
+		b.tags[t.Key] = t.Value
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) deleteBucketTagging(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.tags = nil
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) getBucketEncryption(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if b.encryption == nil {
+		return &apiError{Code: "ServerSideEncryptionConfigurationNotFoundError", Message: "The server side encryption configuration was not found", status: http.StatusNotFound}
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b.encryption)
+	return nil
+}
+
+func (s *Server) putBucketEncryption(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	var cfg struct {
+		XMLName xml.Name `xml:"ServerSideEncryptionConfiguration"`
+	}
+	if xerr := xml.Unmarshal(body, &cfg); xerr != nil {
+		return &apiError{Code: "MalformedXML", Message: xerr.Error(), status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.encryption = body
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) deleteBucketEncryption(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.encryption = nil
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) getBucketLifecycle(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if b.lifecycle == nil {
+		return &apiError{Code: "NoSuchLifecycleConfiguration", Message: "The lifecycle configuration does not exist", status: http.StatusNotFound}
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b.lifecycle)
+	return nil
+}
+
+func (s *Server) getBucketObjectLock(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if b.objectLock == nil {
+		return &apiError{Code: "ObjectLockConfigurationNotFoundError", Message: "Object Lock configuration does not exist for this bucket", status: http.StatusNotFound}
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b.objectLock)
+	return nil
+}
+
+func (s *Server) putBucketObjectLock(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	var cfg struct {
+		XMLName           xml.Name `xml:"ObjectLockConfiguration"`
+		ObjectLockEnabled string
+	}
+	if xerr := xml.Unmarshal(body, &cfg); xerr != nil || cfg.ObjectLockEnabled != "Enabled" {
+		return &apiError{Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema.", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.objectLock = body
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) putBucketLifecycle(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	var cfg struct {
+		XMLName xml.Name   `xml:"LifecycleConfiguration"`
+		Rules   []struct{} `xml:"Rule"`
+	}
+	if xerr := xml.Unmarshal(body, &cfg); xerr != nil || len(cfg.Rules) == 0 {
+		return &apiError{Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema.", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.lifecycle = body
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) deleteBucketLifecycle(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.lifecycle = nil
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) getBucketReplication(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if b.replication == nil {
+		return &apiError{Code: "ReplicationConfigurationNotFoundError", Message: "The replication configuration was not found", status: http.StatusNotFound}
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b.replication)
+	return nil
+}
+
+func (s *Server) putBucketReplication(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	var cfg struct {
+		XMLName xml.Name   `xml:"ReplicationConfiguration"`
+		Rules   []struct{} `xml:"Rule"`
+	}
+	if xerr := xml.Unmarshal(body, &cfg); xerr != nil || len(cfg.Rules) == 0 {
+		return &apiError{Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema.", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if b.versioning != "Enabled" {
+		return &apiError{Code: "InvalidRequest", Message: "Versioning must be 'Enabled' on the bucket to apply a replication configuration", status: http.StatusBadRequest}
+	}
+	b.replication = body
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) deleteBucketReplication(w http.ResponseWriter, bucketName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.replication = nil
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func parseMaxKeys(v string, def int) (int, *apiError) {
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, errInvalidArgument("Argument max-keys must be an integer between 0 and 2147483647")
+	}
+	if n > def {
+		n = def
+	}
+	return n, nil
+}
+
+func (s *Server) objectEntry(b *bucket, key string, fetchOwner bool) objectEntry {
+	v := b.latest(key)
+	e := objectEntry{
+		Key:          key,
+		LastModified: v.modTime,
+		ETag:         `"` + v.etag + `"`,
+		Size:         int64(len(v.data)),
+		StorageClass: storageClass(v.header),
+	}
+	if fetchOwner {
+		e.Owner = &owner{ID: s.AccessKey, DisplayName: s.AccessKey}
+	}
+	return e
+}
+
+// listMetadata returns the metadata and the URL encoded tags of v,
+// the way MinIO includes them in listings.
+func listMetadata(v *objectVersion) (userMetadata, string) {
+	meta := make(userMetadata)
+	for k := range v.header {
+		meta[k] = v.header.Get(k)
+	}
+	tags := make(url.Values)
+	for k, t := range v.tags {
+		tags.Set(k, t)
+	}
+	return meta, tags.Encode()
+}
+
+func storageClass(h http.Header) string {
+	if sc := h.Get("X-Amz-Storage-Class"); sc != "" {
+		return sc
+	}
+	return "STANDARD"
+}
+
+// isLive reports whether the latest version of key is an object
+// rather than a delete marker.
+func isLive(b *bucket) func(string) bool {
+	return func(key string) bool {
+		v := b.latest(key)
+		return v != nil && !v.deleteMarker
+	}
+}
+
+func (s *Server) listObjectsV2(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	query := r.URL.Query()
+	maxKeys, err := parseMaxKeys(query.Get("max-keys"), 1000)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	marker := query.Get("start-after")
+	if token := query.Get("continuation-token"); token != "" {
+		decoded, derr := base64.StdEncoding.DecodeString(token)
+		if derr != nil {
+			return errInvalidArgument("The continuation token provided is incorrect")
+		}
+		marker = string(decoded)
+	}
+
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	res := b.list(prefix, marker, delimiter, maxKeys, isLive(b))
+	out := listBucketV2Result{
+		XMLNS:             xmlNS,
+		Name:              bucketName,
+		Prefix:            prefix,
+		StartAfter:        query.Get("start-after"),
+		ContinuationToken: query.Get("continuation-token"),
+		MaxKeys:           maxKeys,
+		Delimiter:         delimiter,
+		IsTruncated:       res.truncated,
+		KeyCount:          len(res.keys) + len(res.prefixes),
+	}
+	if res.truncated {
+		out.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(res.nextMarker))
+	}
+	fetchOwner := query.Get("fetch-owner") == "true"
+	withMetadata := query.Get("metadata") == "true"
+	for _, k := range res.keys {
+		e := s.objectEntry(b, k, fetchOwner)
+		if withMetadata {
+			e.UserMetadata, e.UserTags = listMetadata(b.latest(k))
+		}
+		out.Contents = append(out.Contents, e)
+	}
+	for _, p := range res.prefixes {
+		out.CommonPrefixes = append(out.CommonPrefixes, commonPrefix{Prefix: p})
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) listObjectsV1(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	query := r.URL.Query()
+	maxKeys, err := parseMaxKeys(query.Get("max-keys"), 1000)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	prefix, delimiter, marker := query.Get("prefix"), query.Get("delimiter"), query.Get("marker")
+	res := b.list(prefix, marker, delimiter, maxKeys, isLive(b))
+	out := listBucketResult{
+		XMLNS:       xmlNS,
+		Name:        bucketName,
+		Prefix:      prefix,
+		Marker:      marker,
+		NextMarker:  res.nextMarker,
+		MaxKeys:     maxKeys,
+		Delimiter:   delimiter,
+		IsTruncated: res.truncated,
+	}
+	for _, k := range res.keys {
+		out.Contents = append(out.Contents, s.objectEntry(b, k, true))
+	}
+	for _, p := range res.prefixes {
+		out.CommonPrefixes = append(out.CommonPrefixes, commonPrefix{Prefix: p})
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) listObjectVersions(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	query := r.URL.Query()
+	maxKeys, err := parseMaxKeys(query.Get("max-keys"), 1000)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	keyMarker, versionMarker := query.Get("key-marker"), query.Get("version-id-marker")
+	withMetadata := query.Get("metadata") == "true"
+	out := listVersionsResult{
+		XMLNS:           xmlNS,
+		Name:            bucketName,
+		Prefix:          prefix,
+		KeyMarker:       keyMarker,
+		VersionIDMarker: versionMarker,
+		MaxKeys:         maxKeys,
+		Delimiter:       delimiter,
+	}
+
+	// Resume inside the versions of keyMarker when a version marker is given.
+	startKey := keyMarker
+	if versionMarker != "" && keyMarker != "" {
+		startKey = ""
+	}
+
+	count := 0
+	seen := make(map[string]struct{})
+	for _, k := range b.sortedKeys(prefix) {
+		if startKey != "" && k <= startKey || keyMarker != "" && k < keyMarker {
+			continue
+		}
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				p := k[:len(prefix)+i+len(delimiter)]
+				if _, ok := seen[p]; ok || (keyMarker != "" && p <= keyMarker) {
+					continue
+				}
+				if count >= maxKeys {
+					out.IsTruncated = true
+					break
+				}
+				seen[p] = struct{}{}
+				out.CommonPrefixes = append(out.CommonPrefixes, commonPrefix{Prefix: p})
+				out.NextKeyMarker, out.NextVersionIDMarker = p, ""
+				count++
+				continue
+			}
+		}
+		versions := b.objects[k]
+		skipping := k == keyMarker && versionMarker != ""
+		for i := len(versions) - 1; i >= 0; i-- {
+			v := versions[i]
+			if skipping {
+				if v.versionID == versionMarker {
+					skipping = false
+				}
+				continue
+			}
+			if count >= maxKeys {
+				out.IsTruncated = true
+				break
+			}
+			e := versionEntry{
+				XMLName:      xml.Name{Local: "Version"},
+				Key:          k,
+				VersionID:    v.versionID,
+				IsLatest:     i == len(versions)-1,
+				LastModified: v.modTime,
+				Owner:        owner{ID: s.AccessKey, DisplayName: s.AccessKey},
+			}
+			if v.deleteMarker {
+				e.XMLName.Local = "DeleteMarker"
+			} else {
+				e.ETag = `"` + v.etag + `"`
+				e.Size = int64(len(v.data))
+				e.StorageClass = storageClass(v.header)
+				if withMetadata {
+					e.UserMetadata, e.UserTags = listMetadata(v)
+				}
+			}
+			out.Entries = append(out.Entries, e)
+			out.NextKeyMarker, out.NextVersionIDMarker = k, v.versionID
+			count++
+		}
+		if out.IsTruncated {
+			break
+		}
+	}
+	if !out.IsTruncated {
+		out.NextKeyMarker, out.NextVersionIDMarker = "", ""
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) deleteObjects(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	var req deleteRequest
+	if err := readXML(r, &req); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	res := deleteResult{XMLNS: xmlNS}
+	for _, obj := range req.Objects {
+		_, marker := b.remove(obj.Key, obj.VersionID)
+		d := deletedEntry{Key: obj.Key, VersionID: obj.VersionID}
+		if marker != nil {
+			d.DeleteMarker = true
+			d.DeleteMarkerVersionID = marker.versionID
+		}
+		if !req.Quiet {
+			res.Deleted = append(res.Deleted, d)
+		}
+	}
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) listMultipartUploads(w http.ResponseWriter, r *http.Request, bucketName string) *apiError {
+	query := r.URL.Query()
+	maxUploads, err := parseMaxKeys(query.Get("max-uploads"), 1000)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	keyMarker, uploadIDMarker := query.Get("key-marker"), query.Get("upload-id-marker")
+	uploads := make([]*multipartUpload, 0, len(b.uploads))
+	for _, u := range b.uploads {
+		if strings.HasPrefix(u.key, prefix) {
+			uploads = append(uploads, u)
+		}
+	}
+	sortUploads(uploads)
+
+	out := listMultipartUploadsResult{
+		XMLNS:          xmlNS,
+		Bucket:         bucketName,
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+		MaxUploads:     maxUploads,
+	}
+	seen := make(map[string]struct{})
+	for _, u := range uploads {
+		if keyMarker != "" && (u.key < keyMarker || u.key == keyMarker && (uploadIDMarker == "" || u.id <= uploadIDMarker)) {
+			continue
+		}
+		if delimiter != "" {
+			if i := strings.Index(u.key[len(prefix):], delimiter); i >= 0 {
+				p := u.key[:len(prefix)+i+len(delimiter)]
+				if _, ok := seen[p]; !ok {
+					seen[p] = struct{}{}
+					out.CommonPrefixes = append(out.CommonPrefixes, commonPrefix{Prefix: p})
+				}
+				continue
+			}
+		}
+		if len(out.Uploads) >= maxUploads {
+			out.IsTruncated = true
+			break
+		}
+		out.Uploads = append(out.Uploads, uploadEntry{Key: u.key, UploadID: u.id, Initiated: u.initiated, StorageClass: storageClass(u.header)})
+		out.NextKeyMarker, out.NextUploadIDMarker = u.key, u.id
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func sortUploads(uploads []*multipartUpload) {
+	less := func(a, b *multipartUpload) bool {
+		if a.key != b.key {
+			return a.key < b.key
+		}
+		return a.id < b.id
+	}
+	for i := 1; i < len(uploads); i++ {
+		for j := i; j > 0 && less(uploads[j], uploads[j-1]); j-- {
+			uploads[j], uploads[j-1] = uploads[j-1], uploads[j]
+		}
+	}
+}
+
+func (s *Server) objectHandler(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodPut:
+		switch {
+		case query.Has("tagging"):
+			return s.putObjectTagging(w, r, bucketName, objectName)
+		case query.Has("retention"):
+			return s.putObjectRetention(w, r, bucketName, objectName)
+		case query.Has("legal-hold"):
+			return s.putObjectLegalHold(w, r, bucketName, objectName)
+		case query.Has("uploadId") && query.Has("partNumber"):
+			return s.uploadPart(w, r, bucketName, objectName)
+		case r.Header.Get("X-Amz-Copy-Source") != "":
+			return s.copyObject(w, r, bucketName, objectName)
+		}
+		return s.putObject(w, r, bucketName, objectName)
+	case http.MethodGet, http.MethodHead:
+		switch {
+		case query.Has("tagging"):
+			return s.getObjectTagging(w, r, bucketName, objectName)
+		case query.Has("retention"):
+			return s.getObjectRetention(w, r, bucketName, objectName)
+		case query.Has("legal-hold"):
+			return s.getObjectLegalHold(w, r, bucketName, objectName)
+		case query.Has("uploadId"):
+			return s.listParts(w, r, bucketName, objectName)
+		case query.Has("attributes") && r.Method == http.MethodGet:
+			return s.getObjectAttributes(w, r, bucketName, objectName)
+		}
+		return s.getObject(w, r, bucketName, objectName)
+	case http.MethodDelete:
+		switch {
+		case query.Has("tagging"):
+			return s.deleteObjectTagging(w, r, bucketName, objectName)
+		case query.Has("uploadId"):
+			return s.abortMultipartUpload(w, r, bucketName, objectName)
+		}
+		return s.deleteObject(w, r, bucketName, objectName)
+	case http.MethodPost:
+		switch {
+		case query.Has("uploads"):
+			return s.newMultipartUpload(w, r, bucketName, objectName)
+		case query.Has("uploadId"):
+			return s.completeMultipartUpload(w, r, bucketName, objectName)
+		case query.Has("restore"):
+			return s.restoreObject(w, r, bucketName, objectName)
+		}
+	}
+	return errNotImplemented()
+}
+
+// storedHeaders are the request headers persisted with an object
+// and returned on GET and HEAD.
+var storedHeaders = []string{
+	"Content-Type",
+	"Content-Encoding",
+	"Content-Disposition",
+	"Content-Language",
+	"Cache-Control",
+	"Expires",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+	"X-Amz-Object-Lock-Mode",
+	"X-Amz-Object-Lock-Retain-Until-Date",
+	"X-Amz-Object-Lock-Legal-Hold",
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id",
+	"X-Amz-Server-Side-Encryption-Bucket-Key-Enabled",
+	"X-Amz-Server-Side-Encryption-Context",
+}
+
+// setEncryptionHeaders returns the server side encryption of an
+// object in write responses. Objects are not actually encrypted.
+func setEncryptionHeaders(w http.ResponseWriter, h http.Header) {
+	for k, vv := range h {
+		if strings.HasPrefix(k, "X-Amz-Server-Side-Encryption") {
+			w.Header()[k] = vv
+		}
+	}
+}
+
+func objectHeader(r *http.Request) http.Header {
+	h := make(http.Header)
+	for _, k := range storedHeaders {
+		if v := r.Header.Get(k); v != "" {
+			h.Set(k, v)
+		}
+	}
+	for k, v := range r.Header {
+		if strings.HasPrefix(k, "X-Amz-Meta-") {
+			h[k] = v
+		}
+	}
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", "application/octet-stream")
+	}
+	return h
+}
+
+func parseTagHeader(v string) (map[string]string, *apiError) {
+	if v == "" {
+		return nil, nil
+	}
+	values, err := url.ParseQuery(v)
+	if err != nil {
+		return nil, &apiError{Code: "InvalidTag", Message: err.Error(), status: http.StatusBadRequest}
+	}
+	tags := make(map[string]string, len(values))
+	for k := range values {
+		tags[k] = values.Get(k)
+	}
+	return tags, nil
+}
+
+// checkPreconditions evaluates If-Match and If-None-Match against
+// the current version v, which may be nil. The returned status is
+// 0 when the request may proceed.
+func checkPreconditions(r *http.Request, v *objectVersion) int {
+	exists := v != nil && !v.deleteMarker
+	if m := r.Header.Get("If-Match"); m != "" {
+		if !exists || (m != "*" && strings.Trim(m, `"`) != v.etag) {
+			return http.StatusPreconditionFailed
+		}
+	}
+	if m := r.Header.Get("If-None-Match"); m != "" && exists {
+		if m == "*" || strings.Trim(m, `"`) == v.etag {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	}
+	if !exists {
+		return 0
+	}
+	if t, err := http.ParseTime(r.Header.Get("If-Unmodified-Since")); err == nil && v.modTime.Truncate(time.Second).After(t) {
+		return http.StatusPreconditionFailed
+	}
+	if t, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && !v.modTime.Truncate(time.Second).After(t) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return http.StatusNotModified
+		}
+	}
+	return 0
+}
+
+func (s *Server) putObject(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	data, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	tags, err := parseTagHeader(r.Header.Get("X-Amz-Tagging"))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if checkPreconditions(r, b.latest(objectName)) != 0 {
+		return errPreconditionFailed()
+	}
+	v := &objectVersion{
+		data:    data,
+		etag:    md5Hex(data),
+		modTime: time.Now().UTC(),
+		header:  objectHeader(r),
+		tags:    tags,
+	}
+	b.put(objectName, v)
+
+	w.Header().Set("ETag", `"`+v.etag+`"`)
+	if v.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+	setEncryptionHeaders(w, v.header)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// parseCopySource parses the X-Amz-Copy-Source header into bucket,
+// object and version ID.
+func parseCopySource(v string) (bucketName, objectName, versionID string, err *apiError) {
+	src, query, _ := strings.Cut(v, "?")
+	src, uerr := url.PathUnescape(strings.TrimPrefix(src, "/"))
+	if uerr != nil {
+		return "", "", "", errInvalidArgument("Invalid copy source")
+	}
+	bucketName, objectName, ok := strings.Cut(src, "/")
+	if !ok || objectName == "" {
+		return "", "", "", errInvalidArgument("Invalid copy source")
+	}
+	if values, perr := url.ParseQuery(query); perr == nil {
+		versionID = values.Get("versionId")
+	}
+	return bucketName, objectName, versionID, nil
+}
+
+// copySource resolves the source version of a copy request, callers
+// must hold s.mu.
+func (s *Server) copySource(r *http.Request) (*objectVersion, *apiError) {
+	srcBucket, srcObject, srcVersion, err := parseCopySource(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		return nil, err
+	}
+	sb, err := s.getBucket(srcBucket)
+	if err != nil {
+		return nil, err
+	}
+	src := sb.version(srcObject, srcVersion)
+	if src == nil || src.deleteMarker {
+		return nil, errNoSuchKey()
+	}
+	if m := r.Header.Get("X-Amz-Copy-Source-If-Match"); m != "" && strings.Trim(m, `"`) != src.etag {
+		return nil, errPreconditionFailed()
+	}
+	if m := r.Header.Get("X-Amz-Copy-Source-If-None-Match"); m != "" && strings.Trim(m, `"`) == src.etag {
+		return nil, errPreconditionFailed()
+	}
+	return src, nil
+}
+
+func (s *Server) copyObject(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	src, err := s.copySource(r)
+	if err != nil {
+		return err
+	}
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if checkPreconditions(r, b.latest(objectName)) != 0 {
+		return errPreconditionFailed()
+	}
+
+	v := &objectVersion{
+		data:      src.data,
+		etag:      src.etag,
+		modTime:   time.Now().UTC(),
+		header:    src.header.Clone(),
+		tags:      src.tags,
+		partSizes: src.partSizes,
+
+		partChecksums: src.partChecksums,
+	}
+	if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+		v.header = objectHeader(r)
+	}
+	if r.Header.Get("X-Amz-Tagging-Directive") == "REPLACE" {
+		if v.tags, err = parseTagHeader(r.Header.Get("X-Amz-Tagging")); err != nil {
+			return err
+		}
+	}
+	b.put(objectName, v)
+
+	if v.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+	setEncryptionHeaders(w, v.header)
+	writeXML(w, http.StatusOK, copyObjectResult{ETag: `"` + v.etag + `"`, LastModified: v.modTime})
+	return nil
+}
+
+// parseRange parses a single "bytes=" range against size.
+func parseRange(spec string, size int64) (start, end int64, ok bool) {
+	spec, found := strings.CutPrefix(spec, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	first, last, _ := strings.Cut(spec, "-")
+	switch {
+	case first == "":
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, 0, false
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, size - 1, size > 0
+	default:
+		s, err := strconv.ParseInt(first, 10, 64)
+		if err != nil || s >= size {
+			return 0, 0, false
+		}
+		e := size - 1
+		if last != "" {
+			if e, err = strconv.ParseInt(last, 10, 64); err != nil || e < s {
+				return 0, 0, false
+			}
+			if e >= size {
+				e = size - 1
+			}
+		}
+		return s, e, true
+	}
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	query := r.URL.Query()
+	s.mu.Lock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	versionID := query.Get("versionId")
+	v := b.version(objectName, versionID)
+	s.mu.Unlock()
+
+	if v == nil {
+		if versionID != "" {
+			return &apiError{Code: "NoSuchVersion", Message: "The specified version does not exist.", status: http.StatusNotFound}
+		}
+		return errNoSuchKey()
+	}
+	if v.deleteMarker {
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+		if versionID != "" {
+			return &apiError{Code: "MethodNotAllowed", Message: "The specified method is not allowed against this resource.", status: http.StatusMethodNotAllowed}
+		}
+		return errNoSuchKey()
+	}
+
+	h := w.Header()
+	for k, vv := range v.header {
+		h[k] = vv
+	}
+	h.Set("ETag", `"`+v.etag+`"`)
+	h.Set("Last-Modified", v.modTime.Format(http.TimeFormat))
+	h.Set("Accept-Ranges", "bytes")
+	if v.versionID != nullVersionID {
+		h.Set("X-Amz-Version-Id", v.versionID)
+	}
+	if len(v.tags) > 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(len(v.tags)))
+	}
+	if sc := v.header.Get("X-Amz-Storage-Class"); sc == "" {
+		h.Del("X-Amz-Storage-Class")
+	}
+	restored := true
+	if !v.restoreStart.IsZero() {
+		if time.Now().Before(v.restoreStart.Add(s.RestoreDelay)) {
+			restored = false
+			h.Set("X-Amz-Restore", `ongoing-request="true"`)
+		} else {
+			h.Set("X-Amz-Restore", `ongoing-request="false", expiry-date="`+v.restoreExpiry.Format(http.TimeFormat)+`"`)
+		}
+	}
+	if r.Method == http.MethodGet && v.archived() && (v.restoreStart.IsZero() || !restored) {
+		return errInvalidObjectState()
+	}
+	for k, vv := range query {
+		if name, ok := strings.CutPrefix(k, "response-"); ok {
+			h.Set(http.CanonicalHeaderKey(name), vv[0])
+		}
+	}
+
+	switch checkPreconditions(r, v) {
+	case http.StatusNotModified:
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	case http.StatusPreconditionFailed:
+		return errPreconditionFailed()
+	}
+
+	data := v.data
+	status := http.StatusOK
+	if pn := query.Get("partNumber"); pn != "" {
+		n, perr := strconv.Atoi(pn)
+		if perr != nil || n < 1 {
+			return errInvalidArgument("Part number must be an integer between 1 and 10000, inclusive")
+		}
+		sizes := v.partSizes
+		if len(sizes) == 0 {
+			sizes = []int64{int64(len(v.data))}
+		}
+		if n > len(sizes) {
+			return &apiError{Code: "InvalidPartNumber", Message: "The requested partnumber is not satisfiable", status: http.StatusRequestedRangeNotSatisfiable}
+		}
+		var start int64
+		for _, sz := range sizes[:n-1] {
+			start += sz
+		}
+		end := start + sizes[n-1] - 1
+		h.Set("X-Amz-Mp-Parts-Count", strconv.Itoa(len(sizes)))
+		h.Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10)+"/"+strconv.Itoa(len(v.data)))
+		data = v.data[start : end+1]
+		status = http.StatusPartialContent
+	} else if rng := r.Header.Get("Range"); rng != "" {
+		start, end, ok := parseRange(rng, int64(len(v.data)))
+		if !ok {
+			h.Set("Content-Range", "bytes */"+strconv.Itoa(len(v.data)))
+			return &apiError{Code: "InvalidRange", Message: "The requested range is not satisfiable", status: http.StatusRequestedRangeNotSatisfiable}
+		}
+		h.Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10)+"/"+strconv.Itoa(len(v.data)))
+		data = v.data[start : end+1]
+		status = http.StatusPartialContent
+	}
+	h.Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		w.Write(data)
+	}
+	return nil
+}
+
+func (s *Server) restoreObject(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	var in restoreRequest
+	if err := readXML(r, &in); err != nil {
+		return err
+	}
+	if in.Days < 1 {
+		in.Days = 1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return errNoSuchKey()
+	}
+	if !v.archived() {
+		return &apiError{Code: "InvalidObjectState", Message: "Restore is not allowed for the object's current storage class", status: http.StatusForbidden}
+	}
+	now := time.Now()
+	if !v.restoreStart.IsZero() {
+		if now.Before(v.restoreStart.Add(s.RestoreDelay)) {
+			return &apiError{Code: "RestoreAlreadyInProgress", Message: "Object restore is already in progress", status: http.StatusConflict}
+		}
+		// Restoring a restored object extends its expiry.
+		v.restoreExpiry = now.Add(time.Duration(in.Days) * 24 * time.Hour).UTC()
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	v.restoreStart = now
+	v.restoreExpiry = now.Add(s.RestoreDelay + time.Duration(in.Days)*24*time.Hour).UTC()
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	versionID := r.URL.Query().Get("versionId")
+	deleted, marker := b.remove(objectName, versionID)
+	switch {
+	case marker != nil:
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+		w.Header().Set("X-Amz-Version-Id", marker.versionID)
+	case deleted != nil:
+		w.Header().Set("X-Amz-Version-Id", deleted.versionID)
+		if deleted.deleteMarker {
+			w.Header().Set("X-Amz-Delete-Marker", "true")
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) getObjectTagging(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return errNoSuchKey()
+	}
+	out := tagging{XMLNS: xmlNS}
+	for k, val := range v.tags {
+		out.TagSet.Tags = append(out.TagSet.Tags, tag{Key: k, Value: val})
+	}
+	if v.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) putObjectTagging(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	var in tagging
+	if err := readXML(r, &in); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return errNoSuchKey()
+	}
+	tags := make(map[string]string, len(in.TagSet.Tags))
+	for _, t := range in.TagSet.Tags {
+		tags[t.Key] = t.Value
+	}
+	v.tags = tags
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) deleteObjectTagging(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return errNoSuchKey()
+	}
+	v.tags = nil
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) getObjectAttributes(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	maxParts, err := parseMaxKeys(r.Header.Get("X-Amz-Max-Parts"), 1000)
+	if err != nil {
+		return err
+	}
+	marker, _ := strconv.Atoi(r.Header.Get("X-Amz-Part-Number-Marker"))
+	attrs := make(map[string]bool)
+	for _, a := range strings.Split(r.Header.Get("X-Amz-Object-Attributes"), ",") {
+		attrs[strings.TrimSpace(a)] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return errNoSuchKey()
+	}
+
+	out := objectAttributes{XMLNS: xmlNS}
+	if attrs["ETag"] {
+		out.ETag = v.etag
+	}
+	if attrs["StorageClass"] {
+		out.StorageClass = storageClass(v.header)
+	}
+	if attrs["ObjectSize"] {
+		size := int64(len(v.data))
+		out.ObjectSize = &size
+	}
+	if attrs["ObjectParts"] && len(v.partSizes) > 0 {
+		parts := &objectAttributesParts{
+			PartsCount:       len(v.partSizes),
+			PartNumberMarker: marker,
+			MaxParts:         maxParts,
+		}
+		for i := marker; i < len(v.partSizes); i++ {
+			if len(parts.Parts) >= maxParts {
+				parts.IsTruncated = true
+				break
+			}
+			var checksums map[string]string
+			if i < len(v.partChecksums) {
+				checksums = v.partChecksums[i]
+			}
+			parts.Parts = append(parts.Parts, objectAttributesPart{
+				PartNumber:        i + 1,
+				Size:              v.partSizes[i],
+				ChecksumCRC32:     checksums["X-Amz-Checksum-Crc32"],
+				ChecksumCRC32C:    checksums["X-Amz-Checksum-Crc32c"],
+				ChecksumSHA1:      checksums["X-Amz-Checksum-Sha1"],
+				ChecksumSHA256:    checksums["X-Amz-Checksum-Sha256"],
+				ChecksumCRC64NVME: checksums["X-Amz-Checksum-Crc64nvme"],
+			})
+			parts.NextPartNumberMarker = i + 1
+		}
+		out.ObjectParts = parts
+	}
+	if v.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+	w.Header().Set("Last-Modified", v.modTime.Format(http.TimeFormat))
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+// lockedVersion returns the object version addressed by r for object
+// lock requests, callers must hold s.mu.
+func (s *Server) lockedVersion(r *http.Request, bucketName, objectName string) (*objectVersion, *apiError) {
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	v := b.version(objectName, r.URL.Query().Get("versionId"))
+	if v == nil || v.deleteMarker {
+		return nil, errNoSuchKey()
+	}
+	return v, nil
+}
+
+func (s *Server) getObjectRetention(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.lockedVersion(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	mode := v.header.Get("X-Amz-Object-Lock-Mode")
+	if mode == "" {
+		return &apiError{Code: "NoSuchObjectLockConfiguration", Message: "The specified object does not have a ObjectLock configuration", status: http.StatusNotFound}
+	}
+	out := retention{XMLNS: xmlNS, Mode: mode}
+	if until, terr := time.Parse(time.RFC3339, v.header.Get("X-Amz-Object-Lock-Retain-Until-Date")); terr == nil {
+		out.RetainUntilDate = &until
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+// putObjectRetention enforces the object lock rules: compliance mode
+// retention cannot be shortened or removed, governance mode retention
+// only with the bypass header.
+func (s *Server) putObjectRetention(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	var in retention
+	if err := readXML(r, &in); err != nil {
+		return err
+	}
+	if in.Mode != "" && in.Mode != "GOVERNANCE" && in.Mode != "COMPLIANCE" {
+		return &apiError{Code: "MalformedXML", Message: "Invalid retention mode", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.lockedVersion(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	mode := v.header.Get("X-Amz-Object-Lock-Mode")
+	until, terr := time.Parse(time.RFC3339, v.header.Get("X-Amz-Object-Lock-Retain-Until-Date"))
+	if mode != "" && terr == nil && until.After(time.Now()) {
+		shortened := in.RetainUntilDate == nil || in.RetainUntilDate.Before(until) || in.Mode != mode
+		bypass := mode == "GOVERNANCE" && r.Header.Get("X-Amz-Bypass-Governance-Retention") == "true"
+		if shortened && !bypass {
+			return &apiError{Code: "AccessDenied", Message: "Access Denied.", status: http.StatusForbidden}
+		}
+	}
+	if in.Mode == "" {
+		v.header.Del("X-Amz-Object-Lock-Mode")
+		v.header.Del("X-Amz-Object-Lock-Retain-Until-Date")
+	} else {
+		if in.RetainUntilDate == nil {
+			return &apiError{Code: "MalformedXML", Message: "RetainUntilDate is required", status: http.StatusBadRequest}
+		}
+		v.header.Set("X-Amz-Object-Lock-Mode", in.Mode)
+		v.header.Set("X-Amz-Object-Lock-Retain-Until-Date", in.RetainUntilDate.UTC().Format(time.RFC3339))
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) getObjectLegalHold(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.lockedVersion(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	status := v.header.Get("X-Amz-Object-Lock-Legal-Hold")
+	if status == "" {
+		status = "OFF"
+	}
+	writeXML(w, http.StatusOK, legalHold{XMLNS: xmlNS, Status: status})
+	return nil
+}
+
+func (s *Server) putObjectLegalHold(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	var in legalHold
+	if err := readXML(r, &in); err != nil {
+		return err
+	}
+	if in.Status != "ON" && in.Status != "OFF" {
+		return &apiError{Code: "MalformedXML", Message: "Invalid legal hold status", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.lockedVersion(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	v.header.Set("X-Amz-Object-Lock-Legal-Hold", in.Status)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) newMultipartUpload(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	tags, err := parseTagHeader(r.Header.Get("X-Amz-Tagging"))
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	u := &multipartUpload{
+		id:        mustRandomID(),
+		key:       objectName,
+		initiated: time.Now().UTC(),
+		header:    objectHeader(r),
+		tags:      tags,
+		parts:     make(map[int]*part),
+	}
+	b.uploads[u.id] = u
+	writeXML(w, http.StatusOK, initiateMultipartUploadResult{XMLNS: xmlNS, Bucket: bucketName, Key: objectName, UploadID: u.id})
+	return nil
+}
+
+// getUpload returns the upload referenced by the request, callers
+// must hold s.mu.
+func (s *Server) getUpload(r *http.Request, bucketName, objectName string) (*bucket, *multipartUpload, *apiError) {
+	b, err := s.getBucket(bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, ok := b.uploads[r.URL.Query().Get("uploadId")]
+	if !ok || u.key != objectName {
+		return nil, nil, errNoSuchUpload()
+	}
+	return b, u, nil
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	partNumber, perr := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if perr != nil || partNumber < 1 || partNumber > 10000 {
+		return errInvalidArgument("Part number must be an integer between 1 and 10000, inclusive")
+	}
+
+	var (
+		data    []byte
+		trailer http.Header
+	)
+	copySource := r.Header.Get("X-Amz-Copy-Source")
+	if copySource == "" {
+		var err *apiError
+		if data, trailer, err = readBodyTrailer(r); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, u, err := s.getUpload(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if copySource != "" {
+		src, err := s.copySource(r)
+		if err != nil {
+			return err
+		}
+		data = src.data
+		if rng := r.Header.Get("X-Amz-Copy-Source-Range"); rng != "" {
+			start, end, ok := parseRange(rng, int64(len(src.data)))
+			if !ok {
+				return errInvalidArgument("Range specified is not valid for source object")
+			}
+			data = src.data[start : end+1]
+		}
+	}
+	p := &part{number: partNumber, data: data, etag: md5Hex(data), modTime: time.Now().UTC(), checksums: partChecksums(r.Header, trailer)}
+	u.parts[partNumber] = p
+
+	if copySource != "" {
+		writeXML(w, http.StatusOK, copyPartResult{ETag: `"` + p.etag + `"`, LastModified: p.modTime})
+		return nil
+	}
+	w.Header().Set("ETag", `"`+p.etag+`"`)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// partChecksums returns the checksums sent with a part, either as
+// headers or as trailers. They are stored as sent, not verified.
+func partChecksums(header, trailer http.Header) map[string]string {
+	checksums := make(map[string]string)
+	for _, h := range []http.Header{header, trailer} {
+		for k, v := range h {
+			k = http.CanonicalHeaderKey(k)
+			if strings.HasPrefix(k, "X-Amz-Checksum-") && k != "X-Amz-Checksum-Type" && k != "X-Amz-Checksum-Algorithm" && len(v) > 0 {
+				checksums[k] = v[0]
+			}
+		}
+	}
+	return checksums
+}
+
+func (s *Server) listParts(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	query := r.URL.Query()
+	maxParts, err := parseMaxKeys(query.Get("max-parts"), 1000)
+	if err != nil {
+		return err
+	}
+	marker, _ := strconv.Atoi(query.Get("part-number-marker"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, u, err := s.getUpload(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	out := listPartsResult{
+		XMLNS:            xmlNS,
+		Bucket:           bucketName,
+		Key:              objectName,
+		UploadID:         u.id,
+		StorageClass:     storageClass(u.header),
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	for n := marker + 1; n <= 10000; n++ {
+		p, ok := u.parts[n]
+		if !ok {
+			continue
+		}
+		if len(out.Parts) >= maxParts {
+			out.IsTruncated = true
+			break
+		}
+		out.Parts = append(out.Parts, partEntry{
+			PartNumber:        n,
+			LastModified:      p.modTime,
+			ETag:              `"` + p.etag + `"`,
+			Size:              int64(len(p.data)),
+			ChecksumCRC32:     p.checksums["X-Amz-Checksum-Crc32"],
+			ChecksumCRC32C:    p.checksums["X-Amz-Checksum-Crc32c"],
+			ChecksumSHA1:      p.checksums["X-Amz-Checksum-Sha1"],
+			ChecksumSHA256:    p.checksums["X-Amz-Checksum-Sha256"],
+			ChecksumCRC64NVME: p.checksums["X-Amz-Checksum-Crc64nvme"],
+		})
+		out.NextPartNumberMarker = n
+	}
+	writeXML(w, http.StatusOK, out)
+	return nil
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	var req completeMultipartUpload
+	if err := readXML(r, &req); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, u, err := s.getUpload(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if len(req.Parts) == 0 {
+		return &apiError{Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema.", status: http.StatusBadRequest}
+	}
+	if checkPreconditions(r, b.latest(objectName)) != 0 {
+		return errPreconditionFailed()
+	}
+
+	var (
+		data      []byte
+		etags     []string
+		sizes     []int64
+		checksums []map[string]string
+		last      int
+	)
+	for i, cp := range req.Parts {
+		if cp.PartNumber <= last {
+			return &apiError{Code: "InvalidPartOrder", Message: "The list of parts was not in ascending order.", status: http.StatusBadRequest}
+		}
+		last = cp.PartNumber
+		p, ok := u.parts[cp.PartNumber]
+		if !ok || strings.Trim(cp.ETag, `"`) != p.etag {
+			return &apiError{Code: "InvalidPart", Message: "One or more of the specified parts could not be found.", status: http.StatusBadRequest}
+		}
+		if i < len(req.Parts)-1 && len(p.data) < minPartSize {
+			return &apiError{Code: "EntityTooSmall", Message: "Your proposed upload is smaller than the minimum allowed object size.", status: http.StatusBadRequest}
+		}
+		data = append(data, p.data...)
+		etags = append(etags, p.etag)
+		sizes = append(sizes, int64(len(p.data)))
+		checksums = append(checksums, p.checksums)
+	}
+
+	v := &objectVersion{
+		data:      data,
+		etag:      multipartETag(etags),
+		modTime:   time.Now().UTC(),
+		header:    u.header,
+		tags:      u.tags,
+		partSizes: sizes,
+
+		partChecksums: checksums,
+	}
+	b.put(objectName, v)
+	delete(b.uploads, u.id)
+
+	if v.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+	setEncryptionHeaders(w, v.header)
+	writeXML(w, http.StatusOK, completeMultipartUploadResult{
+		XMLNS:    xmlNS,
+		Location: s.URL + "/" + bucketName + "/" + objectName,
+		Bucket:   bucketName,
+		Key:      objectName,
+		ETag:     `"` + v.etag + `"`,
+	})
+	return nil
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, r *http.Request, bucketName, objectName string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, u, err := s.getUpload(r, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	delete(b.uploads, u.id)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// minPartSize is the minimum size of all but the last part of a
+// multipart upload, same as S3.
+const minPartSize = 5 << 20
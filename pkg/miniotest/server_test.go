@@ -0,0 +1,231 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7"
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func newClient(t *testing.T, srv *miniotest.Server, secretKey string) *minio.Client {
+	t.Helper()
+	clnt, err := minio.New(srv.Endpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, secretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clnt
+}
+
+func TestServerObjects(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	clnt := newClient(t, srv, srv.SecretKey)
+	ctx := context.Background()
+
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := clnt.BucketExists(ctx, "bucket"); err != nil || !ok {
+		t.Fatalf("BucketExists: %v %v", ok, err)
+	}
+
+	keys := []string{"a/1", "a/2", "b", "c d/e"}
+	for _, k := range keys {
+		data := []byte("data of " + k)
+		_, err := clnt.PutObject(ctx, "bucket", k, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+			ContentType:  "text/plain",
+			UserMetadata: map[string]string{"Origin": "test"},
+		})
+		if err != nil {
+			t.Fatalf("PutObject %s: %v", k, err)
+		}
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "b", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "data of b" {
+		t.Fatalf("unexpected content %q", data)
+	}
+
+	info, err := clnt.StatObject(ctx, "bucket", "c d/e", minio.StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "text/plain" || info.UserMetadata["Origin"] != "test" {
+		t.Fatalf("unexpected object info %+v", info)
+	}
+
+	opts := minio.GetObjectOptions{}
+	opts.SetRange(2, 5)
+	obj, err = clnt.GetObject(ctx, "bucket", "b", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ = io.ReadAll(obj); string(data) != "ta o" {
+		t.Fatalf("unexpected range content %q", data)
+	}
+
+	for _, useV1 := range []bool{false, true} {
+		var got []string
+		for info := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{UseV1: useV1, MaxKeys: 1}) {
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			got = append(got, info.Key)
+		}
+		if want := []string{"a/", "b", "c d/"}; strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("UseV1=%v: expected %v, got %v", useV1, want, got)
+		}
+	}
+
+	if err = clnt.RemoveObject(ctx, "bucket", "b", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(ctx, "bucket", "b", minio.StatObjectOptions{}); minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+	if err = clnt.RemoveBucket(ctx, "bucket"); minio.ToErrorResponse(err).Code != "BucketNotEmpty" {
+		t.Fatalf("expected BucketNotEmpty, got %v", err)
+	}
+}
+
+func TestServerVersioning(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	clnt := newClient(t, srv, srv.SecretKey)
+	ctx := context.Background()
+
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := clnt.PutObject(ctx, "bucket", "obj", strings.NewReader("x"), 1, minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := clnt.RemoveObject(ctx, "bucket", "obj", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions, markers int
+	for info := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{WithVersions: true}) {
+		if info.Err != nil {
+			t.Fatal(info.Err)
+		}
+		if info.IsDeleteMarker {
+			markers++
+		} else {
+			versions++
+		}
+	}
+	if versions != 2 || markers != 1 {
+		t.Fatalf("expected 2 versions and 1 delete marker, got %d and %d", versions, markers)
+	}
+}
+
+func TestServerMultipart(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	clnt := newClient(t, srv, srv.SecretKey)
+	ctx := context.Background()
+
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("0123456789abcdef"), (11<<20)/16)
+	info, err := clnt.PutObject(ctx, "bucket", "large", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		PartSize: 5 << 20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(info.ETag, "-3") {
+		t.Fatalf("expected multipart ETag, got %s", info.ETag)
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "large", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("multipart content mismatch")
+	}
+}
+
+func TestServerAuth(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	clnt := newClient(t, srv, srv.SecretKey)
+	ctx := context.Background()
+
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clnt.PutObject(ctx, "bucket", "obj", strings.NewReader("presigned"), 9, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := newClient(t, srv, "wrong-secret")
+	if _, err := bad.StatObject(ctx, "bucket", "obj", minio.StatObjectOptions{}); minio.ToErrorResponse(err).StatusCode != http.StatusForbidden {
+		t.Fatalf("expected request with wrong secret to be rejected, got %v", err)
+	}
+
+	u, err := clnt.PresignedGetObject(ctx, "bucket", "obj", time.Minute, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(u.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "presigned" {
+		t.Fatalf("presigned GET failed: %d %s", resp.StatusCode, body)
+	}
+
+	resp, err = http.Get(strings.Replace(u.String(), "X-Amz-Signature=", "X-Amz-Signature=0", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected tampered presigned URL to be rejected, got %d", resp.StatusCode)
+	}
+}
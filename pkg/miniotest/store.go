@@ -0,0 +1,264 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const nullVersionID = "null"
+
+// objectVersion is a single stored version of an object, or a
+// delete marker.
+type objectVersion struct {
+	versionID    string
+	deleteMarker bool
+	data         []byte
+	etag         string
+	modTime      time.Time
+	header       http.Header // Content-Type, user metadata etc.
+	tags         map[string]string
+	partSizes    []int64 // non-empty for multipart objects.
+	// checksums of the parts by header name, like partSizes.
+	partChecksums []map[string]string
+
+	// Restore of an archived object, zero if none was requested.
+	restoreStart  time.Time
+	restoreExpiry time.Time
+}
+
+// archived returns true if the version is stored in an archive
+// storage class and must be restored before it can be read.
+func (v *objectVersion) archived() bool {
+	switch v.header.Get("X-Amz-Storage-Class") {
+	case "GLACIER", "DEEP_ARCHIVE":
+		return true
+	}
+	return false
+}
+
+// part is a single uploaded part of a multipart upload.
+type part struct {
+	number  int
+	data    []byte
+	etag    string
+	modTime time.Time
+	// checksums by header name, e.g. X-Amz-Checksum-Crc32c.
+	checksums map[string]string
+}
+
+// multipartUpload is an incomplete multipart upload.
+type multipartUpload struct {
+	id        string
+	key       string
+	initiated time.Time
+	header    http.Header
+	tags      map[string]string
+	parts     map[int]*part
+}
+
+// bucket holds all objects of a bucket. Versions of a key are
+// ordered oldest first, the last element is the latest version.
+type bucket struct {
+	name        string
+	created     time.Time
+	region      string
+	versioning  string // "", "Enabled" or "Suspended"
+	tags        map[string]string
+	encryption  []byte // raw default encryption configuration
+	lifecycle   []byte // raw lifecycle configuration
+	objectLock  []byte // raw object lock configuration
+	replication []byte // raw replication configuration
+	quota       *bucketQuota
+	objects     map[string][]*objectVersion
+	uploads     map[string]*multipartUpload
+
+	// MinIO versioning extensions, stored but not honored.
+	excludedPrefixes []excludedPrefix
+	excludeFolders   bool
+}
+
+func newBucket(name, region string) *bucket {
+	return &bucket{
+		name:    name,
+		created: time.Now().UTC().Truncate(time.Millisecond),
+		region:  region,
+		objects: make(map[string][]*objectVersion),
+		uploads: make(map[string]*multipartUpload),
+	}
+}
+
+func mustRandomID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b[:])
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// latest returns the latest version of key, which may be a delete
+// marker, or nil if key does not exist.
+func (b *bucket) latest(key string) *objectVersion {
+	versions := b.objects[key]
+	if len(versions) == 0 {
+		return nil
+	}
+	return versions[len(versions)-1]
+}
+
+// version returns the version of key identified by versionID.
+func (b *bucket) version(key, versionID string) *objectVersion {
+	if versionID == "" {
+		return b.latest(key)
+	}
+	for _, v := range b.objects[key] {
+		if v.versionID == versionID {
+			return v
+		}
+	}
+	return nil
+}
+
+// put stores v as the latest version of key honoring the bucket
+// versioning state.
+func (b *bucket) put(key string, v *objectVersion) {
+	if b.versioning == "Enabled" {
+		v.versionID = mustRandomID()
+		b.objects[key] = append(b.objects[key], v)
+		return
+	}
+	v.versionID = nullVersionID
+	versions := b.objects[key][:0]
+	for _, old := range b.objects[key] {
+		if old.versionID != nullVersionID {
+			versions = append(versions, old)
+		}
+	}
+	b.objects[key] = append(versions, v)
+}
+
+// remove deletes key. Without a version ID this either removes
+// the object or, on versioned buckets, adds a delete marker.
+func (b *bucket) remove(key, versionID string) (deleted *objectVersion, marker *objectVersion) {
+	if versionID != "" {
+		versions := b.objects[key]
+		for i, v := range versions {
+			if v.versionID == versionID {
+				b.objects[key] = append(versions[:i:i], versions[i+1:]...)
+				if len(b.objects[key]) == 0 {
+					delete(b.objects, key)
+				}
+				return v, nil
+			}
+		}
+		return nil, nil
+	}
+	if b.versioning == "" {
+		delete(b.objects, key)
+		return nil, nil
+	}
+	marker = &objectVersion{deleteMarker: true, modTime: time.Now().UTC()}
+	b.put(key, marker)
+	return nil, marker
+}
+
+// sortedKeys returns all keys with prefix in lexical order.
+func (b *bucket) sortedKeys(prefix string) []string {
+	keys := make([]string, 0, len(b.objects))
+	for k := range b.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// listResult is the result of a delimited, paginated walk over keys.
+type listResult struct {
+	keys       []string
+	prefixes   []string
+	truncated  bool
+	nextMarker string
+}
+
+// list walks all keys after marker which carry prefix, grouping
+// them by delimiter. Keys for which include returns false are
+// skipped entirely.
+func (b *bucket) list(prefix, marker, delimiter string, maxKeys int, include func(string) bool) listResult {
+	var res listResult
+	seen := make(map[string]struct{})
+	for _, k := range b.sortedKeys(prefix) {
+		if k <= marker || !include(k) {
+			continue
+		}
+		entry := k
+		isPrefix := false
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				entry = k[:len(prefix)+i+len(delimiter)]
+				isPrefix = true
+			}
+		}
+		if isPrefix {
+			if _, ok := seen[entry]; ok {
+				continue
+			}
+			if entry <= marker {
+				continue
+			}
+		}
+		if len(res.keys)+len(res.prefixes) >= maxKeys {
+			res.truncated = true
+			break
+		}
+		if isPrefix {
+			seen[entry] = struct{}{}
+			res.prefixes = append(res.prefixes, entry)
+		} else {
+			res.keys = append(res.keys, entry)
+		}
+		res.nextMarker = entry
+	}
+	if !res.truncated {
+		res.nextMarker = ""
+	}
+	return res
+}
+
+// multipartETag computes the S3 style ETag of a multipart object.
+func multipartETag(etags []string) string {
+	h := md5.New()
+	for _, etag := range etags {
+		b, _ := hex.DecodeString(etag)
+		h.Write(b)
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), len(etags))
+}
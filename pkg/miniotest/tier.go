@@ -0,0 +1,236 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// tierConfig is a remote tier, its target is kept as sent.
+type tierConfig struct {
+	Version string                 `json:",omitempty"`
+	Type    string                 `json:",omitempty"`
+	Name    string                 `json:",omitempty"`
+	S3      map[string]interface{} `json:",omitempty"`
+	Azure   map[string]interface{} `json:",omitempty"`
+	GCS     map[string]interface{} `json:",omitempty"`
+	MinIO   map[string]interface{} `json:",omitempty"`
+}
+
+type tierCreds struct {
+	AccessKey string `json:"access,omitempty"`
+	SecretKey string `json:"secret,omitempty"`
+	CredsJSON []byte `json:"creds,omitempty"`
+}
+
+// target returns the configuration of the storage of the tier.
+func (t *tierConfig) target() map[string]interface{} {
+	switch t.Type {
+	case "s3":
+		return t.S3
+	case "azure":
+		return t.Azure
+	case "gcs":
+		return t.GCS
+	case "minio":
+		return t.MinIO
+	}
+	return nil
+}
+
+// redacted returns a copy of the tier without secrets, as listed by MinIO.
+func (t *tierConfig) redacted() tierConfig {
+	out := *t
+	target := make(map[string]interface{}, len(t.target()))
+	for k, v := range t.target() {
+		switch k {
+		case "SecretKey", "AccountKey", "Creds":
+			v = "REDACTED"
+		case "SPAuth":
+			v = map[string]interface{}{"ClientSecret": "REDACTED"}
+		}
+		target[k] = v
+	}
+	switch t.Type {
+	case "s3":
+		out.S3 = target
+	case "azure":
+		out.Azure = target
+	case "gcs":
+		out.GCS = target
+	case "minio":
+		out.MinIO = target
+	}
+	return out
+}
+
+func errTierInvalidArgument() *apiError {
+	return &apiError{Code: "XMinioAdminInvalidArgument", Message: "Invalid arguments specified.", status: http.StatusBadRequest}
+}
+
+func errNoSuchTier() *apiError {
+	return &apiError{Code: "XMinioAdminTierNotFound", Message: "Specified remote tier was not found", status: http.StatusNotFound}
+}
+
+// readEncryptedJSON decodes the body of requests carrying secrets,
+// encrypted with the secret key of the server.
+func (s *Server) readEncryptedJSON(r *http.Request, v interface{}) *apiError {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	data, derr := decryptAdminData(s.SecretKey, body)
+	if derr != nil {
+		return &apiError{Code: "XMinioAdminConfigBadJSON", Message: derr.Error(), status: http.StatusBadRequest}
+	}
+	if json.Unmarshal(data, v) != nil {
+		return errTierInvalidArgument()
+	}
+	return nil
+}
+
+func (s *Server) addTier(w http.ResponseWriter, r *http.Request) *apiError {
+	var cfg tierConfig
+	if err := s.readEncryptedJSON(r, &cfg); err != nil {
+		return err
+	}
+	if cfg.target() == nil {
+		return errTierInvalidArgument()
+	}
+	if cfg.Name != strings.ToUpper(cfg.Name) {
+		return &apiError{Code: "XMinioAdminTierNameNotUpperCase", Message: "Tier name must be in uppercase", status: http.StatusBadRequest}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tiers[cfg.Name] != nil {
+		return &apiError{Code: "XMinioAdminTierAlreadyExists", Message: "Specified remote tier already exists", status: http.StatusConflict}
+	}
+	s.tiers[cfg.Name] = &cfg
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) listTiers(w http.ResponseWriter) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tiers := []tierConfig{}
+	for _, cfg := range s.tiers {
+		tiers = append(tiers, cfg.redacted())
+	}
+	writeJSON(w, http.StatusOK, tiers)
+	return nil
+}
+
+// tierHandler edits, verifies and removes the tier named name.
+func (s *Server) tierHandler(w http.ResponseWriter, r *http.Request, name string) *apiError {
+	var creds tierCreds
+	if r.Method == http.MethodPost {
+		if err := s.readEncryptedJSON(r, &creds); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cfg := s.tiers[name]
+	if cfg == nil {
+		return errNoSuchTier()
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodDelete:
+		delete(s.tiers, name)
+	case http.MethodPost:
+		target := cfg.target()
+		switch cfg.Type {
+		case "s3", "minio":
+			target["AccessKey"], target["SecretKey"] = creds.AccessKey, creds.SecretKey
+		case "azure":
+			target["AccountKey"] = creds.SecretKey
+		case "gcs":
+			target["Creds"] = base64.URLEncoding.EncodeToString(creds.CredsJSON)
+		}
+	default:
+		return errNotImplemented()
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// decryptAdminData decrypts data encrypted like with the EncryptData
+// function of MinIO's madmin package: salt | AEAD ID | nonce | DARE
+// stream of fragments of 16 KiB.
+func decryptAdminData(password string, data []byte) ([]byte, error) {
+	const saltSize, nonceSize, fragmentSize = 32, 8, 16 << 10
+	if len(data) < saltSize+1+nonceSize {
+		return nil, errors.New("encrypted data too short")
+	}
+	salt, id, nonce := data[:saltSize], data[saltSize], data[saltSize+1:saltSize+1+nonceSize]
+	data = data[saltSize+1+nonceSize:]
+
+	key := argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32)
+	var aead cipher.AEAD
+	switch id {
+	case 0x00:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if aead, err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
+	case 0x01:
+		var err error
+		if aead, err = chacha20poly1305.New(key); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported encryption")
+	}
+
+	fragmentNonce := make([]byte, aead.NonceSize())
+	copy(fragmentNonce, nonce)
+	additionalData := aead.Seal([]byte{0x00}, fragmentNonce, nil, nil)
+	var plaintext []byte
+	for seqNum := uint32(1); ; seqNum++ {
+		fragment := data
+		if len(fragment) > fragmentSize+aead.Overhead() {
+			fragment = fragment[:fragmentSize+aead.Overhead()]
+		} else {
+			additionalData[0] = 0x80
+		}
+		binary.LittleEndian.PutUint32(fragmentNonce[nonceSize:], seqNum)
+		var err error
+		if plaintext, err = aead.Open(plaintext, fragmentNonce, fragment, additionalData); err != nil {
+			return nil, err
+		}
+		data = data[len(fragment):]
+		if additionalData[0] == 0x80 {
+			return plaintext, nil
+		}
+	}
+}
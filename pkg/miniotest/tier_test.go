@@ -0,0 +1,38 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDecryptAdminData(t *testing.T) {
+	// Encrypted by the EncryptData function of madmin.
+	data, err := hex.DecodeString("8c158d8cd7c8c5a4e3114811f7c8d16e32599fe2768325cf897198f8a66ab2e30035892990c7d1e8deb3c0ef7cc4d3db14f3402cd95f0d310e800e27e3df0b47ad10fd4879771ef86f62706b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := decryptAdminData("secret", data)
+	if err != nil || string(plaintext) != "madmin known answer" {
+		t.Fatalf("unexpected plaintext %q, %v", plaintext, err)
+	}
+	if _, err = decryptAdminData("wrong", data); err == nil {
+		t.Fatal("expected decryption with another password to fail")
+	}
+}
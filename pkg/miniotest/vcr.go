@@ -0,0 +1,313 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Recorder talks to a real server.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette only, requests
+	// without a recorded interaction fail.
+	ModeReplay Mode = iota
+	// ModeRecord forwards all requests to the server and writes the
+	// interactions to the cassette on Stop.
+	ModeRecord
+)
+
+// signingHeaders and signingParams change on every request signed
+// by the client, or carry credentials. They are removed before an
+// interaction is stored and ignored when matching requests.
+var (
+	signingHeaders = []string{
+		"Authorization",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Decoded-Content-Length",
+		"User-Agent",
+		"Amz-Sdk-Invocation-Id",
+		"Amz-Sdk-Request",
+	}
+	signingParams = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-SignedHeaders",
+		"X-Amz-Signature",
+		"X-Amz-Security-Token",
+	}
+)
+
+// secretHeaders carry SSE-C keys, they are redacted in recorded
+// requests and responses.
+var secretHeaders = []string{
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Server-Side-Encryption-Customer-Key-Md5",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key-Md5",
+}
+
+// secretBodyValues matches the credentials returned in responses, like
+// the temporary credentials of STS, in XML and JSON.
+var secretBodyValues = regexp.MustCompile(`(<(SecretAccessKey|SessionToken)>)[^<]*(</(SecretAccessKey|SessionToken)>)|("(secretKey|sessionToken|SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`)
+
+// redacted replaces secrets in cassettes.
+const redacted = "REDACTED"
+
+// RecordedRequest is the scrubbed request of an Interaction.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// Host is the host name of the request without port, it holds the
+	// bucket of virtual host style requests.
+	Host   string      `json:"host,omitempty"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	// BodySHA256 is the hex encoded SHA256 of the decoded payload,
+	// aws-chunked framing and chunk signatures are stripped.
+	BodySHA256 string `json:"bodySHA256"`
+}
+
+// RecordedResponse is the response of an Interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
+
+// Interaction is a single request/response pair of a cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Cassette is the on-disk format of recorded interactions.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper which records S3 interactions to
+// a cassette file and replays them later, for deterministic tests
+// without network access.
+//
+// Signatures, dates and credentials are scrubbed from the recorded
+// requests and excluded from matching, so a client replaying a
+// cassette may use any credentials and does not fail on signatures
+// computed at a different time. SSE-C keys and credentials returned
+// by the server are redacted. Requests match recorded ones of the same
+// host name on any port.
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In
+// ModeReplay the cassette must exist. In ModeRecord requests are
+// sent using transport, http.DefaultTransport is used if nil.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path, transport: transport}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("miniotest: invalid cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, errors.New("miniotest: invalid recorder mode")
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop writes recorded interactions to the cassette file, it is a
+// no-op in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(&r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0o644)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := scrubRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       secretBodyValues.ReplaceAll(respBody, []byte("${1}${5}"+redacted+"${3}${7}")),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the first unused interaction matching recorded.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !matchRequest(in.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("miniotest: no recorded interaction for %s %s", recorded.Method, recorded.URL)
+}
+
+// matchRequest returns true if b matches the recorded request a,
+// cassettes recorded without hosts match any host.
+func matchRequest(a, b RecordedRequest) bool {
+	return a.Method == b.Method && (a.Host == "" || a.Host == b.Host) && a.URL == b.URL && a.BodySHA256 == b.BodySHA256
+}
+
+// requestPayload reads the request body and restores it so the
+// request can still be sent. aws-chunked bodies are decoded since
+// their chunk signatures differ on every request.
+func requestPayload(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	if isAWSChunked(req) {
+		payload, _, err := readAWSChunked(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	return data, nil
+}
+
+// scrubRequest returns the recorded form of req. The URL keeps only
+// path and query, so cassettes replay against any port.
+func scrubRequest(req *http.Request, body []byte) RecordedRequest {
+	query := req.URL.Query()
+	for _, k := range signingParams {
+		query.Del(k)
+	}
+	header := scrubHeader(req.Header)
+	for _, k := range signingHeaders {
+		header.Del(k)
+	}
+	// The framed length of signed chunks depends on the signatures.
+	if strings.HasPrefix(req.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		header.Del("Content-Length")
+	}
+	sum := sha256.Sum256(body)
+	u := url.URL{Path: req.URL.Path, RawPath: req.URL.RawPath, RawQuery: query.Encode()}
+	return RecordedRequest{
+		Method:     req.Method,
+		Host:       req.URL.Hostname(),
+		URL:        u.RequestURI(),
+		Header:     header,
+		BodySHA256: hex.EncodeToString(sum[:]),
+	}
+}
+
+// scrubHeader returns a copy of h with the SSE-C keys redacted.
+func scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range secretHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
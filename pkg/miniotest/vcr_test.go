@@ -0,0 +1,180 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7"
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func runCassetteScenario(t *testing.T, endpoint, accessKey, secretKey string, rec *miniotest.Recorder) {
+	t.Helper()
+	clnt, err := minio.New(endpoint, &minio.Options{
+		Creds:     credentials.NewStaticV4(accessKey, secretKey, ""),
+		Region:    miniotest.DefaultRegion,
+		Transport: rec,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.PutObject(ctx, "bucket", "obj", strings.NewReader("recorded"), 8, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "obj", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "recorded" {
+		t.Fatalf("unexpected content %q", data)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	srv := miniotest.NewServer(t)
+	cassette := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+
+	rec, err := miniotest.NewRecorder(cassette, miniotest.ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runCassetteScenario(t, srv.Endpoint(), srv.AccessKey, srv.SecretKey, rec)
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "Signature") || strings.Contains(string(data), "Credential") {
+		t.Fatal("cassette contains signing information")
+	}
+
+	// Replay against an unreachable endpoint with different credentials.
+	srv.Close()
+	rec, err = miniotest.NewRecorder(cassette, miniotest.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runCassetteScenario(t, "127.0.0.1:1", "other", "other-secret", rec)
+
+	// All interactions have been consumed.
+	clnt, err := minio.New("127.0.0.1:1", &minio.Options{
+		Creds:      credentials.NewStaticV4("other", "other-secret", ""),
+		Region:     miniotest.DefaultRegion,
+		Transport:  rec,
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.StatObject(context.Background(), "bucket", "obj", minio.StatObjectOptions{}); err == nil {
+		t.Fatal("expected unrecorded request to fail")
+	}
+}
+
+// transportFunc is an http.RoundTripper function.
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRecorderScrubbing(t *testing.T) {
+	const key = "MzJieXRlc2xvbmdzZWNyZXRrZXltdXN0cHJvdmlkZWQ="
+	server := transportFunc(func(req *http.Request) (*http.Response, error) {
+		body := "<Credentials><AccessKeyId>access</AccessKeyId><SecretAccessKey>secret-" + req.URL.Hostname() +
+			"</SecretAccessKey><SessionToken>token</SessionToken></Credentials>"
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"X-Amz-Server-Side-Encryption-Customer-Key-Md5": {"key-md5"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+	request := func(host string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "http://"+host+":9000/object", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key", key)
+		req.Header.Set("X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key", key)
+		return req
+	}
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := miniotest.NewRecorder(cassette, miniotest.ModeRecord, server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, host := range []string{"a.localhost", "b.localhost"} {
+		resp, err := rec.RoundTrip(request(host))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{key, "secret-", "token", "key-md5"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("cassette contains %q", secret)
+		}
+	}
+
+	// Virtual host style requests of other buckets do not match.
+	rec, err = miniotest.NewRecorder(cassette, miniotest.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rec.RoundTrip(request("c.localhost")); err == nil {
+		t.Fatal("expected request of another host not to match")
+	}
+	resp, err := rec.RoundTrip(request("b.localhost"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "<SecretAccessKey>REDACTED</SecretAccessKey>") {
+		t.Fatalf("unexpected body %s", body)
+	}
+	if _, err = rec.RoundTrip(request("b.localhost")); err == nil {
+		t.Fatal("expected the interaction of b.localhost to be used")
+	}
+}
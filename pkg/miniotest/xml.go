@@ -0,0 +1,361 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"encoding/xml"
+	"sort"
+	"time"
+)
+
+const xmlNS = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// apiError is the S3 error document returned for failed requests.
+type apiError struct {
+	XMLName    xml.Name `xml:"Error" json:"-"`
+	Code       string
+	Message    string
+	BucketName string `xml:",omitempty"`
+	Key        string `xml:",omitempty"`
+	Resource   string `xml:",omitempty"`
+	RequestID  string `xml:"RequestId"`
+	HostID     string `xml:"HostId"`
+
+	status int
+}
+
+type owner struct {
+	ID          string
+	DisplayName string
+}
+
+type bucketEntry struct {
+	Name         string
+	CreationDate time.Time
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	XMLNS   string   `xml:"xmlns,attr"`
+	Owner   owner
+	Buckets struct {
+		Bucket []bucketEntry
+	}
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"LocationConstraint"`
+	XMLNS    string   `xml:"xmlns,attr"`
+	Location string   `xml:",chardata"`
+}
+
+type createBucketConfiguration struct {
+	XMLName  xml.Name `xml:"CreateBucketConfiguration"`
+	Location string   `xml:"LocationConstraint"`
+}
+
+type versioningConfiguration struct {
+	XMLName          xml.Name         `xml:"VersioningConfiguration"`
+	XMLNS            string           `xml:"xmlns,attr,omitempty"`
+	Status           string           `xml:"Status,omitempty"`
+	ExcludedPrefixes []excludedPrefix `xml:",omitempty"`
+	ExcludeFolders   bool             `xml:",omitempty"`
+}
+
+type excludedPrefix struct {
+	Prefix string
+}
+
+type objectEntry struct {
+	Key          string
+	LastModified time.Time
+	ETag         string
+	Size         int64
+	StorageClass string
+	Owner        *owner `xml:",omitempty"`
+
+	// MinIO extensions, returned for ?metadata=true.
+	UserMetadata userMetadata `xml:",omitempty"`
+	UserTags     string       `xml:",omitempty"`
+}
+
+// userMetadata is encoded as one element per header.
+type userMetadata map[string]string
+
+func (m userMetadata) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := e.EncodeElement(m[k], xml.StartElement{Name: xml.Name{Local: k}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+type listBucketV2Result struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	XMLNS                 string   `xml:"xmlns,attr"`
+	Name                  string
+	Prefix                string
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+	KeyCount              int
+	MaxKeys               int
+	Delimiter             string `xml:",omitempty"`
+	IsTruncated           bool
+	Contents              []objectEntry
+	CommonPrefixes        []commonPrefix
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name `xml:"ListBucketResult"`
+	XMLNS          string   `xml:"xmlns,attr"`
+	Name           string
+	Prefix         string
+	Marker         string
+	NextMarker     string `xml:",omitempty"`
+	MaxKeys        int
+	Delimiter      string `xml:",omitempty"`
+	IsTruncated    bool
+	Contents       []objectEntry
+	CommonPrefixes []commonPrefix
+}
+
+// versionEntry is marshaled as either <Version> or <DeleteMarker>
+// depending on its XMLName, preserving the listing order.
+type versionEntry struct {
+	XMLName      xml.Name
+	Key          string
+	VersionID    string `xml:"VersionId"`
+	IsLatest     bool
+	LastModified time.Time
+	ETag         string `xml:",omitempty"`
+	Size         int64  `xml:",omitempty"`
+	StorageClass string `xml:",omitempty"`
+	Owner        owner
+
+	// MinIO extensions, returned for ?metadata=true.
+	UserMetadata userMetadata `xml:",omitempty"`
+	UserTags     string       `xml:",omitempty"`
+}
+
+type listVersionsResult struct {
+	XMLName             xml.Name `xml:"ListVersionsResult"`
+	XMLNS               string   `xml:"xmlns,attr"`
+	Name                string
+	Prefix              string
+	KeyMarker           string
+	VersionIDMarker     string `xml:"VersionIdMarker"`
+	NextKeyMarker       string `xml:",omitempty"`
+	NextVersionIDMarker string `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int
+	Delimiter           string `xml:",omitempty"`
+	IsTruncated         bool
+	Entries             []versionEntry
+	CommonPrefixes      []commonPrefix
+}
+
+type deleteRequest struct {
+	XMLName xml.Name `xml:"Delete"`
+	Quiet   bool
+	Objects []struct {
+		Key       string
+		VersionID string `xml:"VersionId"`
+	} `xml:"Object"`
+}
+
+type deletedEntry struct {
+	Key                   string
+	VersionID             string `xml:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:",omitempty"`
+	DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty"`
+}
+
+type deleteErrorEntry struct {
+	Key       string
+	VersionID string `xml:"VersionId,omitempty"`
+	Code      string
+	Message   string
+}
+
+type deleteResult struct {
+	XMLName xml.Name           `xml:"DeleteResult"`
+	XMLNS   string             `xml:"xmlns,attr"`
+	Deleted []deletedEntry     `xml:"Deleted"`
+	Errors  []deleteErrorEntry `xml:"Error"`
+}
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	ETag         string
+	LastModified time.Time
+}
+
+type copyPartResult struct {
+	XMLName      xml.Name `xml:"CopyPartResult"`
+	ETag         string
+	LastModified time.Time
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	XMLNS    string   `xml:"xmlns,attr"`
+	Bucket   string
+	Key      string
+	UploadID string `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name `xml:"CompleteMultipartUpload"`
+	Parts   []struct {
+		PartNumber int
+		ETag       string
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	XMLNS    string   `xml:"xmlns,attr"`
+	Location string
+	Bucket   string
+	Key      string
+	ETag     string
+}
+
+type partEntry struct {
+	PartNumber        int
+	LastModified      time.Time
+	ETag              string
+	Size              int64
+	ChecksumCRC32     string `xml:",omitempty"`
+	ChecksumCRC32C    string `xml:",omitempty"`
+	ChecksumSHA1      string `xml:",omitempty"`
+	ChecksumSHA256    string `xml:",omitempty"`
+	ChecksumCRC64NVME string `xml:",omitempty"`
+}
+
+type objectAttributesPart struct {
+	PartNumber        int
+	Size              int64
+	ChecksumCRC32     string `xml:",omitempty"`
+	ChecksumCRC32C    string `xml:",omitempty"`
+	ChecksumSHA1      string `xml:",omitempty"`
+	ChecksumSHA256    string `xml:",omitempty"`
+	ChecksumCRC64NVME string `xml:",omitempty"`
+}
+
+type objectAttributesParts struct {
+	PartsCount           int
+	PartNumberMarker     int
+	NextPartNumberMarker int
+	MaxParts             int
+	IsTruncated          bool
+	Parts                []objectAttributesPart `xml:"Part"`
+}
+
+type objectAttributes struct {
+	XMLName      xml.Name `xml:"GetObjectAttributesResponse"`
+	XMLNS        string   `xml:"xmlns,attr"`
+	ETag         string   `xml:",omitempty"`
+	StorageClass string   `xml:",omitempty"`
+	ObjectSize   *int64   `xml:",omitempty"`
+	ObjectParts  *objectAttributesParts
+}
+
+type listPartsResult struct {
+	XMLName              xml.Name `xml:"ListPartsResult"`
+	XMLNS                string   `xml:"xmlns,attr"`
+	Bucket               string
+	Key                  string
+	UploadID             string `xml:"UploadId"`
+	StorageClass         string
+	PartNumberMarker     int
+	NextPartNumberMarker int
+	MaxParts             int
+	IsTruncated          bool
+	Parts                []partEntry `xml:"Part"`
+}
+
+type uploadEntry struct {
+	Key          string
+	UploadID     string `xml:"UploadId"`
+	Initiated    time.Time
+	StorageClass string
+}
+
+type listMultipartUploadsResult struct {
+	XMLName            xml.Name `xml:"ListMultipartUploadsResult"`
+	XMLNS              string   `xml:"xmlns,attr"`
+	Bucket             string
+	KeyMarker          string
+	UploadIDMarker     string `xml:"UploadIdMarker"`
+	NextKeyMarker      string
+	NextUploadIDMarker string `xml:"NextUploadIdMarker"`
+	Prefix             string
+	Delimiter          string `xml:",omitempty"`
+	MaxUploads         int
+	IsTruncated        bool
+	Uploads            []uploadEntry `xml:"Upload"`
+	CommonPrefixes     []commonPrefix
+}
+
+type tag struct {
+	Key   string
+	Value string
+}
+
+type tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	XMLNS   string   `xml:"xmlns,attr,omitempty"`
+	TagSet  struct {
+		Tags []tag `xml:"Tag"`
+	}
+}
+
+type retention struct {
+	XMLName         xml.Name   `xml:"Retention"`
+	XMLNS           string     `xml:"xmlns,attr,omitempty"`
+	Mode            string     `xml:",omitempty"`
+	RetainUntilDate *time.Time `xml:",omitempty"`
+}
+
+type legalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	XMLNS   string   `xml:"xmlns,attr,omitempty"`
+	Status  string
+}
+
+type restoreRequest struct {
+	XMLName xml.Name `xml:"RestoreRequest"`
+	Days    int      `xml:"Days"`
+}
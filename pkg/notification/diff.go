@@ -0,0 +1,151 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+// Equivalent tells whether two configurations have the same events and
+// filters, regardless of their order, their IDs and their ARNs.
+func (t Config) Equivalent(o Config) bool {
+	return EqualEventTypeList(t.Events, o.Events) && EqualFilterRuleList(t.filterRules(), o.filterRules())
+}
+
+// ConfigurationDiff lists the configurations only present in one of two
+// notification configurations. A modified configuration is listed as
+// removed and added.
+type ConfigurationDiff struct {
+	AddedTopics    []TopicConfig
+	RemovedTopics  []TopicConfig
+	AddedQueues    []QueueConfig
+	RemovedQueues  []QueueConfig
+	AddedLambdas   []LambdaConfig
+	RemovedLambdas []LambdaConfig
+}
+
+// IsEmpty tells whether the configurations compared are equivalent.
+func (d ConfigurationDiff) IsEmpty() bool {
+	return len(d.AddedTopics) == 0 && len(d.RemovedTopics) == 0 &&
+		len(d.AddedQueues) == 0 && len(d.RemovedQueues) == 0 &&
+		len(d.AddedLambdas) == 0 && len(d.RemovedLambdas) == 0
+}
+
+// Diff returns the semantic difference between two notification
+// configurations, comparing configurations by target, events and
+// filters. IDs, usually assigned by the server, and the order of
+// configurations, events and filter rules are ignored.
+func Diff(from, to Configuration) ConfigurationDiff {
+	var d ConfigurationDiff
+	d.AddedTopics = missingTopics(to.TopicConfigs, from.TopicConfigs)
+	d.RemovedTopics = missingTopics(from.TopicConfigs, to.TopicConfigs)
+	d.AddedQueues = missingQueues(to.QueueConfigs, from.QueueConfigs)
+	d.RemovedQueues = missingQueues(from.QueueConfigs, to.QueueConfigs)
+	d.AddedLambdas = missingLambdas(to.LambdaConfigs, from.LambdaConfigs)
+	d.RemovedLambdas = missingLambdas(from.LambdaConfigs, to.LambdaConfigs)
+	return d
+}
+
+// missingTopics returns the configurations of a without equivalent in b.
+func missingTopics(a, b []TopicConfig) (missing []TopicConfig) {
+	for _, c := range a {
+		if findTopic(b, c.Topic, c.Config) < 0 {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// missingQueues returns the configurations of a without equivalent in b.
+func missingQueues(a, b []QueueConfig) (missing []QueueConfig) {
+	for _, c := range a {
+		if findQueue(b, c.Queue, c.Config) < 0 {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// missingLambdas returns the configurations of a without equivalent in b.
+func missingLambdas(a, b []LambdaConfig) (missing []LambdaConfig) {
+	for _, c := range a {
+		if findLambda(b, c.Lambda, c.Config) < 0 {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+func findTopic(configs []TopicConfig, topic string, config Config) int {
+	for i, c := range configs {
+		if c.Topic == topic && c.Equivalent(config) {
+			return i
+		}
+	}
+	return -1
+}
+
+func findQueue(configs []QueueConfig, queue string, config Config) int {
+	for i, c := range configs {
+		if c.Queue == queue && c.Equivalent(config) {
+			return i
+		}
+	}
+	return -1
+}
+
+func findLambda(configs []LambdaConfig, lambda string, config Config) int {
+	for i, c := range configs {
+		if c.Lambda == lambda && c.Equivalent(config) {
+			return i
+		}
+	}
+	return -1
+}
+
+// EnsureTopicConfig adds the topic configuration unless an equivalent
+// one, with the same ARN, events and filters, is present. It returns
+// whether the configuration was added, so the notification
+// configuration only needs to be set on the bucket if it was.
+func (b *Configuration) EnsureTopicConfig(topicConfig Config) bool {
+	if findTopic(b.TopicConfigs, topicConfig.Arn.String(), topicConfig) >= 0 {
+		return false
+	}
+	b.TopicConfigs = append(b.TopicConfigs, TopicConfig{Config: topicConfig, Topic: topicConfig.Arn.String()})
+	return true
+}
+
+// EnsureQueueConfig adds the queue configuration unless an equivalent
+// one, with the same ARN, events and filters, is present. It returns
+// whether the configuration was added, so the notification
+// configuration only needs to be set on the bucket if it was.
+func (b *Configuration) EnsureQueueConfig(queueConfig Config) bool {
+	if findQueue(b.QueueConfigs, queueConfig.Arn.String(), queueConfig) >= 0 {
+		return false
+	}
+	b.QueueConfigs = append(b.QueueConfigs, QueueConfig{Config: queueConfig, Queue: queueConfig.Arn.String()})
+	return true
+}
+
+// EnsureLambdaConfig adds the lambda configuration unless an equivalent
+// one, with the same ARN, events and filters, is present. It returns
+// whether the configuration was added, so the notification
+// configuration only needs to be set on the bucket if it was.
+func (b *Configuration) EnsureLambdaConfig(lambdaConfig Config) bool {
+	if findLambda(b.LambdaConfigs, lambdaConfig.Arn.String(), lambdaConfig) >= 0 {
+		return false
+	}
+	b.LambdaConfigs = append(b.LambdaConfigs, LambdaConfig{Config: lambdaConfig, Lambda: lambdaConfig.Arn.String()})
+	return true
+}
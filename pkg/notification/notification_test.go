@@ -19,6 +19,8 @@ package notification
 
 import (
 	"encoding/xml"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -1433,3 +1435,166 @@ func TestNewArnFromString(t *testing.T) {
 		}
 	})
 }
+
+func TestConfiguration_Validate(t *testing.T) {
+	queueArn := NewArn("minio", "sqs", "", "1", "webhook")
+	newQueue := func(id, prefix, suffix string, events ...EventType) QueueConfig {
+		config := NewConfig(queueArn)
+		config.ID = id
+		config.AddEvents(events...)
+		if prefix != "" {
+			config.AddFilterPrefix(prefix)
+		}
+		if suffix != "" {
+			config.AddFilterSuffix(suffix)
+		}
+		return QueueConfig{Config: config, Queue: queueArn.String()}
+	}
+
+	tests := []struct {
+		name    string
+		config  Configuration
+		invalid bool
+	}{
+		{
+			name: "disjoint filters",
+			config: Configuration{QueueConfigs: []QueueConfig{
+				newQueue("1", "images/", "", ObjectCreatedAll),
+				newQueue("2", "videos/", "", ObjectCreatedAll),
+				newQueue("3", "images/", "", ObjectRemovedAll),
+			}},
+		},
+		{
+			name: "overlapping prefixes",
+			config: Configuration{QueueConfigs: []QueueConfig{
+				newQueue("1", "images/", "", ObjectCreatedPut),
+				newQueue("2", "images/raw/", ".jpg", ObjectCreatedAll),
+			}},
+			invalid: true,
+		},
+		{
+			name: "duplicate IDs",
+			config: Configuration{QueueConfigs: []QueueConfig{
+				newQueue("1", "images/", "", ObjectCreatedAll),
+				newQueue("1", "videos/", "", ObjectCreatedAll),
+			}},
+			invalid: true,
+		},
+		{
+			name:    "no events",
+			config:  Configuration{QueueConfigs: []QueueConfig{newQueue("1", "", "")}},
+			invalid: true,
+		},
+		{
+			name:    "unknown event",
+			config:  Configuration{QueueConfigs: []QueueConfig{newQueue("1", "", "", "s3:ObjectCreated:Unknown")}},
+			invalid: true,
+		},
+		{
+			name:    "filter value too long",
+			config:  Configuration{QueueConfigs: []QueueConfig{newQueue("1", strings.Repeat("a", MaxFilterValueLength+1), "", ObjectCreatedAll)}},
+			invalid: true,
+		},
+		{
+			name: "queue ARN for topic",
+			config: Configuration{TopicConfigs: []TopicConfig{
+				{Config: newQueue("1", "", "", ObjectCreatedAll).Config, Topic: queueArn.String()},
+			}},
+			invalid: true,
+		},
+		{
+			name: "missing target",
+			config: Configuration{QueueConfigs: []QueueConfig{
+				{Config: newQueue("1", "", "", ObjectCreatedAll).Config},
+			}},
+			invalid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if (err != nil) != tt.invalid {
+				t.Fatalf("Validate() = %v, expected invalid %v", err, tt.invalid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidConfiguration) {
+				t.Fatalf("expected %v to wrap ErrInvalidConfiguration", err)
+			}
+		})
+	}
+}
+
+func TestParseConfiguration(t *testing.T) {
+	var config Configuration
+	queueArn := NewArn("minio", "sqs", "", "1", "webhook")
+	queueConfig := NewConfig(queueArn)
+	queueConfig.AddEvents(ObjectCreatedAll)
+	queueConfig.AddFilterPrefix("images/")
+	config.AddQueue(queueConfig)
+
+	data, err := xml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseConfiguration(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = parsed.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if parsed.QueueConfigs[0].Arn != queueArn {
+		t.Fatalf("expected ARN %s, got %s", queueArn, parsed.QueueConfigs[0].Arn)
+	}
+	if diff := Diff(config, parsed); !diff.IsEmpty() {
+		t.Fatalf("expected no difference after round trip, got %+v", diff)
+	}
+}
+
+func TestDiffAndEnsure(t *testing.T) {
+	queueArn := NewArn("minio", "sqs", "", "1", "webhook")
+	topicArn := NewArn("minio", "sns", "", "1", "kafka")
+
+	imagesConfig := NewConfig(queueArn)
+	imagesConfig.AddEvents(ObjectCreatedAll, ObjectRemovedAll)
+	imagesConfig.AddFilterPrefix("images/")
+
+	var current Configuration
+	if !current.EnsureQueueConfig(imagesConfig) {
+		t.Fatal("expected queue configuration to be added")
+	}
+	// The server assigns IDs, events and filter rules may be reordered.
+	current.QueueConfigs[0].ID = "server-id"
+	equivalent := NewConfig(queueArn)
+	equivalent.AddEvents(ObjectRemovedAll, ObjectCreatedAll)
+	equivalent.AddFilterPrefix("images/")
+	if current.EnsureQueueConfig(equivalent) {
+		t.Fatal("expected equivalent queue configuration not to be added")
+	}
+
+	desired := current
+	desired.QueueConfigs = nil
+	videosConfig := NewConfig(queueArn)
+	videosConfig.AddEvents(ObjectCreatedAll)
+	videosConfig.AddFilterPrefix("videos/")
+	desired.EnsureQueueConfig(videosConfig)
+	topicConfig := NewConfig(topicArn)
+	topicConfig.AddEvents(ObjectCreatedAll)
+	desired.EnsureTopicConfig(topicConfig)
+
+	diff := Diff(current, desired)
+	if len(diff.RemovedQueues) != 1 || diff.RemovedQueues[0].ID != "server-id" {
+		t.Errorf("expected images configuration to be removed, got %+v", diff.RemovedQueues)
+	}
+	if len(diff.AddedQueues) != 1 || diff.AddedQueues[0].filterValue("prefix") != "videos/" {
+		t.Errorf("expected videos configuration to be added, got %+v", diff.AddedQueues)
+	}
+	if len(diff.AddedTopics) != 1 || diff.AddedTopics[0].Topic != topicArn.String() {
+		t.Errorf("expected topic configuration to be added, got %+v", diff.AddedTopics)
+	}
+	if len(diff.RemovedTopics) != 0 || len(diff.AddedLambdas) != 0 || len(diff.RemovedLambdas) != 0 {
+		t.Errorf("unexpected differences %+v", diff)
+	}
+	if diff = Diff(desired, desired); !diff.IsEmpty() {
+		t.Errorf("expected no difference, got %+v", diff)
+	}
+}
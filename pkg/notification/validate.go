@@ -0,0 +1,248 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxFilterValueLength is the maximum length in bytes of the value of
+// a prefix or suffix filter rule, the maximum length of an object name.
+const MaxFilterValueLength = 1024
+
+// ErrInvalidConfiguration is wrapped by all errors returned by Validate.
+var ErrInvalidConfiguration = errors.New("invalid notification configuration")
+
+var validEventTypes = map[EventType]bool{
+	ObjectCreatedAll:                                   true,
+	ObjectCreatedPut:                                   true,
+	ObjectCreatedPost:                                  true,
+	ObjectCreatedCopy:                                  true,
+	ObjectCreatedDeleteTagging:                         true,
+	ObjectCreatedCompleteMultipartUpload:               true,
+	ObjectCreatedPutLegalHold:                          true,
+	ObjectCreatedPutRetention:                          true,
+	ObjectCreatedPutTagging:                            true,
+	ObjectAccessedGet:                                  true,
+	ObjectAccessedHead:                                 true,
+	ObjectAccessedGetRetention:                         true,
+	ObjectAccessedGetLegalHold:                         true,
+	ObjectAccessedAll:                                  true,
+	ObjectRemovedAll:                                   true,
+	ObjectRemovedDelete:                                true,
+	ObjectRemovedDeleteMarkerCreated:                   true,
+	ILMDelMarkerExpirationDelete:                       true,
+	ObjectReducedRedundancyLostObject:                  true,
+	ObjectTransitionAll:                                true,
+	ObjectTransitionFailed:                             true,
+	ObjectTransitionComplete:                           true,
+	ObjectTransitionPost:                               true,
+	ObjectTransitionCompleted:                          true,
+	ObjectReplicationAll:                               true,
+	ObjectReplicationOperationCompletedReplication:     true,
+	ObjectReplicationOperationFailedReplication:        true,
+	ObjectReplicationOperationMissedThreshold:          true,
+	ObjectReplicationOperationNotTracked:               true,
+	ObjectReplicationOperationReplicatedAfterThreshold: true,
+	ObjectScannerManyVersions:                          true,
+	ObjectScannerBigPrefix:                             true,
+	ObjectScannerAll:                                   true,
+	BucketCreatedAll:                                   true,
+	BucketRemovedAll:                                   true,
+}
+
+// ParseConfiguration parses a notification configuration XML document,
+// as returned by GetBucketNotification, setting the Arn of every
+// configuration from its target, which is not part of the XML.
+func ParseConfiguration(data []byte) (Configuration, error) {
+	var b Configuration
+	if err := xml.Unmarshal(data, &b); err != nil {
+		return Configuration{}, err
+	}
+	for i := range b.TopicConfigs {
+		b.TopicConfigs[i].Arn, _ = NewArnFromString(b.TopicConfigs[i].Topic)
+	}
+	for i := range b.QueueConfigs {
+		b.QueueConfigs[i].Arn, _ = NewArnFromString(b.QueueConfigs[i].Queue)
+	}
+	for i := range b.LambdaConfigs {
+		b.LambdaConfigs[i].Arn, _ = NewArnFromString(b.LambdaConfigs[i].Lambda)
+	}
+	return b, nil
+}
+
+// targetConfig is a configuration along with its target, for the checks
+// common to topic, queue and lambda configurations.
+type targetConfig struct {
+	kind    string
+	service string
+	target  string
+	config  Config
+}
+
+func (b *Configuration) targetConfigs() []targetConfig {
+	var configs []targetConfig
+	for _, c := range b.TopicConfigs {
+		configs = append(configs, targetConfig{"topic", "sns", c.Topic, c.Config})
+	}
+	for _, c := range b.QueueConfigs {
+		configs = append(configs, targetConfig{"queue", "sqs", c.Queue, c.Config})
+	}
+	for _, c := range b.LambdaConfigs {
+		configs = append(configs, targetConfig{"lambda", "lambda", c.Lambda, c.Config})
+	}
+	return configs
+}
+
+// Validate checks the configuration against the rules servers enforce,
+// so it is sent as is and survives the XML round trip:
+//
+//   - every configuration has a target ARN of the service of its kind,
+//     e.g. "arn:minio:sqs::1:webhook" for a queue. The target is
+//     what is sent, the Arn field is not part of the XML.
+//   - every configuration has at least one event, of a known type.
+//   - filters have at most one prefix and one suffix rule, with values
+//     of valid UTF-8 up to MaxFilterValueLength bytes.
+//   - IDs are unique.
+//   - configurations of the same target don't overlap, i.e. no object
+//     matches the filters of two of them for a common event type.
+//
+// All violations are returned, each wrapping ErrInvalidConfiguration.
+func (b *Configuration) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidConfiguration, fmt.Sprintf(format, args...)))
+	}
+
+	configs := b.targetConfigs()
+	names := make([]string, len(configs))
+	ids := map[string]bool{}
+	for i, c := range configs {
+		name := fmt.Sprintf("%s configuration %d", c.kind, i+1)
+		if c.config.ID != "" {
+			name = fmt.Sprintf("%s configuration %q", c.kind, c.config.ID)
+			if ids[c.config.ID] {
+				invalid("duplicate ID %q", c.config.ID)
+			}
+			ids[c.config.ID] = true
+		}
+		names[i] = name
+
+		switch arn, err := NewArnFromString(c.target); {
+		case c.target == "":
+			invalid("%s has no target ARN", name)
+		case err != nil:
+			invalid("%s: %v", name, err)
+		case arn.Service != c.service:
+			invalid("%s: target %s is not a %s ARN", name, c.target, c.service)
+		}
+
+		if len(c.config.Events) == 0 {
+			invalid("%s has no events", name)
+		}
+		for _, event := range c.config.Events {
+			if !validEventTypes[event] {
+				invalid("%s: unknown event type %q", name, event)
+			}
+		}
+
+		var prefixes, suffixes int
+		for _, rule := range c.config.filterRules() {
+			switch strings.ToLower(rule.Name) {
+			case "prefix":
+				prefixes++
+			case "suffix":
+				suffixes++
+			default:
+				invalid("%s: unknown filter rule name %q", name, rule.Name)
+			}
+			if len(rule.Value) > MaxFilterValueLength {
+				invalid("%s: %s filter value longer than %d bytes", name, rule.Name, MaxFilterValueLength)
+			}
+			if !utf8.ValidString(rule.Value) {
+				invalid("%s: %s filter value is not valid UTF-8", name, rule.Name)
+			}
+		}
+		if prefixes > 1 || suffixes > 1 {
+			invalid("%s: more than one prefix or suffix filter rule", name)
+		}
+	}
+
+	for i := range configs {
+		for j := i + 1; j < len(configs); j++ {
+			if configs[i].target == configs[j].target && configs[i].config.overlaps(configs[j].config) {
+				invalid("%s and %s of %s overlap", names[i], names[j], configs[i].target)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// filterRules returns the filter rules of the configuration.
+func (t Config) filterRules() []FilterRule {
+	if t.Filter == nil {
+		return nil
+	}
+	return t.Filter.S3Key.FilterRules
+}
+
+// filterValue returns the value of the prefix or suffix filter rule.
+func (t Config) filterValue(name string) string {
+	for _, rule := range t.filterRules() {
+		if strings.EqualFold(rule.Name, name) {
+			return rule.Value
+		}
+	}
+	return ""
+}
+
+// overlaps tells whether an object event could match both configurations.
+func (t Config) overlaps(o Config) bool {
+	common := false
+	for _, a := range t.Events {
+		for _, b := range o.Events {
+			common = common || eventTypesOverlap(a, b)
+		}
+	}
+	if !common {
+		return false
+	}
+	prefixA, prefixB := t.filterValue("prefix"), o.filterValue("prefix")
+	suffixA, suffixB := t.filterValue("suffix"), o.filterValue("suffix")
+	return (strings.HasPrefix(prefixA, prefixB) || strings.HasPrefix(prefixB, prefixA)) &&
+		(strings.HasSuffix(suffixA, suffixB) || strings.HasSuffix(suffixB, suffixA))
+}
+
+// eventTypesOverlap tells whether two event types, possibly wildcards
+// like "s3:ObjectCreated:*", have events in common.
+func eventTypesOverlap(a, b EventType) bool {
+	if a == b {
+		return true
+	}
+	if category, ok := strings.CutSuffix(string(a), "*"); ok && strings.HasPrefix(string(b), category) {
+		return true
+	}
+	if category, ok := strings.CutSuffix(string(b), "*"); ok && strings.HasPrefix(string(a), category) {
+		return true
+	}
+	return false
+}
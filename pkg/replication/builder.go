@@ -0,0 +1,229 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/xid"
+)
+
+// ARN is the Amazon Resource Name of a replication destination, e.g.
+// arn:minio:replication:us-east-1:<target-id>:<bucket> for MinIO remote
+// targets or arn:aws:s3:::<bucket> for AWS buckets.
+type ARN struct {
+	Partition string
+	Service   string
+	Region    string
+	ID        string
+	Bucket    string
+}
+
+// NewTargetARN returns the ARN of a MinIO remote target, id is the ID
+// the server assigned when the remote target was added.
+func NewTargetARN(region, id, bucket string) ARN {
+	return ARN{Partition: "minio", Service: "replication", Region: region, ID: id, Bucket: bucket}
+}
+
+// NewBucketARN returns the ARN of an AWS S3 bucket.
+func NewBucketARN(bucket string) ARN {
+	return ARN{Partition: "aws", Service: "s3", Bucket: bucket}
+}
+
+// ParseARN parses a replication destination ARN.
+func ParseARN(s string) (ARN, error) {
+	tokens := strings.Split(s, ":")
+	if len(tokens) != 6 || tokens[0] != "arn" || tokens[1] == "" || tokens[2] == "" || tokens[5] == "" {
+		return ARN{}, fmt.Errorf("invalid replication destination ARN: %q", s)
+	}
+	return ARN{Partition: tokens[1], Service: tokens[2], Region: tokens[3], ID: tokens[4], Bucket: tokens[5]}, nil
+}
+
+// String returns the ARN in its textual form.
+func (a ARN) String() string {
+	return strings.Join([]string{"arn", a.Partition, a.Service, a.Region, a.ID, a.Bucket}, ":")
+}
+
+// IsMinIOTarget returns true for ARNs of MinIO remote targets.
+func (a ARN) IsMinIOTarget() bool {
+	return a.Partition == "minio" && a.Service == "replication"
+}
+
+// RuleBuilder builds a Rule step by step.
+//
+//	rule, err := replication.NewRule(arn).Prefix("docs/").Priority(1).ReplicateDeleteMarkers().Build()
+//
+// Rules are enabled, replicate replica metadata changes and do not
+// replicate deletes, delete markers or existing objects unless set
+// otherwise, as with Config.AddRule.
+type RuleBuilder struct {
+	rule   Rule
+	prefix string
+	tags   []Tag
+}
+
+// NewRule returns a builder of a rule replicating to the destination
+// ARN, see NewTargetARN and NewBucketARN.
+func NewRule(destination string) *RuleBuilder {
+	return &RuleBuilder{rule: Rule{
+		Status:                    Enabled,
+		Destination:               Destination{Bucket: destination},
+		DeleteMarkerReplication:   DeleteMarkerReplication{Status: Disabled},
+		DeleteReplication:         DeleteReplication{Status: Disabled},
+		SourceSelectionCriteria:   SourceSelectionCriteria{ReplicaModifications: ReplicaModifications{Status: Enabled}},
+		ExistingObjectReplication: ExistingObjectReplication{Status: Disabled},
+	}}
+}
+
+// ID sets the ID of the rule, a random ID is used if it is not set.
+func (b *RuleBuilder) ID(id string) *RuleBuilder {
+	b.rule.ID = id
+	return b
+}
+
+// Priority sets the priority of the rule, which decides between rules
+// replicating an object to the same destination. Priorities must be
+// unique in a configuration.
+func (b *RuleBuilder) Priority(priority int) *RuleBuilder {
+	b.rule.Priority = priority
+	return b
+}
+
+// Disabled builds a rule which is not applied.
+func (b *RuleBuilder) Disabled() *RuleBuilder {
+	b.rule.Status = Disabled
+	return b
+}
+
+// Prefix limits the rule to objects whose name starts with prefix.
+func (b *RuleBuilder) Prefix(prefix string) *RuleBuilder {
+	b.prefix = prefix
+	return b
+}
+
+// Tag limits the rule to objects having the tag key=value, it can be
+// used several times to require more tags.
+func (b *RuleBuilder) Tag(key, value string) *RuleBuilder {
+	b.tags = append(b.tags, Tag{Key: key, Value: value})
+	return b
+}
+
+// StorageClass sets the storage class of the replicas.
+func (b *RuleBuilder) StorageClass(storageClass string) *RuleBuilder {
+	b.rule.Destination.StorageClass = storageClass
+	return b
+}
+
+// ReplicateDeleteMarkers replicates delete markers.
+func (b *RuleBuilder) ReplicateDeleteMarkers() *RuleBuilder {
+	b.rule.DeleteMarkerReplication.Status = Enabled
+	return b
+}
+
+// ReplicateDeletes replicates deletes of object versions. MinIO only.
+func (b *RuleBuilder) ReplicateDeletes() *RuleBuilder {
+	b.rule.DeleteReplication.Status = Enabled
+	return b
+}
+
+// ReplicateExistingObjects replicates objects created before the rule.
+func (b *RuleBuilder) ReplicateExistingObjects() *RuleBuilder {
+	b.rule.ExistingObjectReplication.Status = Enabled
+	return b
+}
+
+// NoReplicaSync does not replicate metadata changes of replicas back.
+func (b *RuleBuilder) NoReplicaSync() *RuleBuilder {
+	b.rule.SourceSelectionCriteria.ReplicaModifications.Status = Disabled
+	return b
+}
+
+// Build returns the rule, if it is valid.
+func (b *RuleBuilder) Build() (Rule, error) {
+	rule := b.rule
+	if rule.ID == "" {
+		rule.ID = xid.New().String()
+	}
+	switch {
+	case len(b.tags) > 1 || (b.prefix != "" && len(b.tags) > 0):
+		rule.Filter.And = And{Prefix: b.prefix, Tags: slices.Clone(b.tags)}
+	case len(b.tags) == 1:
+		rule.Filter.Tag = b.tags[0]
+	default:
+		rule.Filter.Prefix = b.prefix
+	}
+	if _, err := ParseARN(rule.Destination.Bucket); err != nil {
+		return Rule{}, err
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+// SetRule replaces the rule with the ID of rule, or adds rule if there
+// is none, keeping all other rules.
+func (c *Config) SetRule(rule Rule) error {
+	if rule.ID == "" {
+		return fmt.Errorf("rule ID missing")
+	}
+	for i, r := range c.Rules {
+		if r.ID == rule.ID {
+			c.Rules[i] = rule
+			return nil
+		}
+	}
+	c.Rules = append(c.Rules, rule)
+	return nil
+}
+
+// Validate checks all rules, their destination ARNs and that IDs and
+// priorities are unique.
+func (c *Config) Validate() error {
+	if c.Role != "" {
+		if _, err := ParseARN(c.Role); err != nil {
+			return fmt.Errorf("invalid format for replication Role Arn: %v", c.Role)
+		}
+	}
+	ids := make(map[string]bool, len(c.Rules))
+	priorities := make(map[int]string, len(c.Rules))
+	for _, r := range c.Rules {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("rule %q: %w", r.ID, err)
+		}
+		// The destination of legacy configurations is in the role.
+		if c.Role == "" || r.Destination.Bucket != "" {
+			if _, err := ParseARN(r.Destination.Bucket); err != nil {
+				return fmt.Errorf("rule %q: %w", r.ID, err)
+			}
+		}
+		if r.ID != "" {
+			if ids[r.ID] {
+				return fmt.Errorf("rule %q: ID is not unique", r.ID)
+			}
+			ids[r.ID] = true
+		}
+		if id, ok := priorities[r.Priority]; ok {
+			return fmt.Errorf("rules %q and %q have the same priority %d", id, r.ID, r.Priority)
+		}
+		priorities[r.Priority] = r.ID
+	}
+	return nil
+}
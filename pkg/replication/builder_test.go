@@ -0,0 +1,90 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestARN(t *testing.T) {
+	arn := NewTargetARN("eu-west-1", "c5acb6ac-9918-4dc6-8534-6244ed1a611a", "destbucket")
+	s := "arn:minio:replication:eu-west-1:c5acb6ac-9918-4dc6-8534-6244ed1a611a:destbucket"
+	if arn.String() != s || !arn.IsMinIOTarget() {
+		t.Fatalf("unexpected ARN %s", arn)
+	}
+	parsed, err := ParseARN(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed != arn {
+		t.Fatalf("expected %+v, got %+v", arn, parsed)
+	}
+	if got := NewBucketARN("dest").String(); got != "arn:aws:s3:::dest" {
+		t.Fatalf("unexpected bucket ARN %s", got)
+	}
+	for _, invalid := range []string{"", "destbucket", "arn:minio:replication::id:", "urn:aws:s3:::dest"} {
+		if _, err = ParseARN(invalid); err == nil {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestRuleBuilder(t *testing.T) {
+	dest := NewTargetARN("", "id", "dest").String()
+	rule, err := NewRule(dest).ID("docs").Priority(2).Prefix("docs/").Tag("k", "v").ReplicateDeleteMarkers().ReplicateExistingObjects().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Prefix() != "docs/" || rule.Tags() != "k=v" || rule.Filter.Prefix != "" ||
+		rule.DeleteMarkerReplication.Status != Enabled || rule.DeleteReplication.Status != Disabled ||
+		rule.ExistingObjectReplication.Status != Enabled || rule.SourceSelectionCriteria.ReplicaModifications.Status != Enabled {
+		t.Fatalf("unexpected rule %+v", rule)
+	}
+
+	other, err := NewRule(dest).Priority(1).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.ID == "" || other.Filter.Prefix != "" || !other.Filter.And.isEmpty() {
+		t.Fatalf("unexpected rule %+v", other)
+	}
+	if _, err = NewRule("dest").Build(); err == nil {
+		t.Fatal("expected a destination without ARN to fail")
+	}
+
+	cfg := Config{}
+	for _, r := range []Rule{rule, other} {
+		if err = cfg.SetRule(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = cfg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	rule.Priority = 1
+	if err = cfg.SetRule(rule); err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Rules) != 2 {
+		t.Fatalf("expected the rule to be replaced, got %d rules", len(cfg.Rules))
+	}
+	if err = cfg.Validate(); err == nil || !strings.Contains(err.Error(), "same priority") {
+		t.Fatalf("expected duplicate priorities, got %v", err)
+	}
+}
@@ -797,6 +797,44 @@ type ResyncTarget struct {
 	Object string `json:"object,omitempty"`
 }
 
+// ResyncStatusType is the status of the resync of a replication target.
+type ResyncStatusType string
+
+const (
+	// NoResync means no resync was started for the target.
+	NoResync ResyncStatusType = ""
+	// ResyncPending means the resync is about to start.
+	ResyncPending ResyncStatusType = "Pending"
+	// ResyncCanceled means the resync was canceled.
+	ResyncCanceled ResyncStatusType = "Canceled"
+	// ResyncStarted means the resync is ongoing.
+	ResyncStarted ResyncStatusType = "Ongoing"
+	// ResyncCompleted means all objects were resynced.
+	ResyncCompleted ResyncStatusType = "Completed"
+	// ResyncFailed means the resync stopped on an error.
+	ResyncFailed ResyncStatusType = "Failed"
+)
+
+// IsDone returns true unless the resync is pending or ongoing.
+func (s ResyncStatusType) IsDone() bool {
+	return s != ResyncPending && s != ResyncStarted
+}
+
+// Status returns the status of the resync operation.
+func (t ResyncTarget) Status() ResyncStatusType {
+	return ResyncStatusType(t.ResyncStatus)
+}
+
+// IsDone returns true if the resync of all targets is done.
+func (r ResyncTargetsInfo) IsDone() bool {
+	for _, t := range r.Targets {
+		if !t.Status().IsDone() {
+			return false
+		}
+	}
+	return true
+}
+
 // XferStats holds transfer rate info for uploads/sec
 type XferStats struct {
 	AvgRate  float64 `json:"avgRate"`
@@ -0,0 +1,175 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package s3name validates and normalizes S3 bucket names and object
+// keys as the client does, to check them before calling it.
+package s3name
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxKeyLength is the maximum length of object keys in bytes.
+const MaxKeyLength = 1024
+
+// Rules are the rules bucket names are checked against.
+type Rules int
+
+const (
+	// Relaxed rules accept the bucket names accepted by MinIO and legacy
+	// AWS regions, with upper case letters, underscores and colons.
+	Relaxed Rules = iota
+
+	// Strict rules accept the bucket names accepted by AWS for new
+	// buckets, of lower case letters, digits, dots and hyphens.
+	//   - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingBucket.html
+	Strict
+)
+
+// We support '.' with bucket names but we fallback to using path
+// style requests instead for such buckets.
+var (
+	validBucketName       = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\.\-\_\:]{1,61}[A-Za-z0-9]$`)
+	validBucketNameStrict = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-]{1,61}[a-z0-9]$`)
+	ipAddress             = regexp.MustCompile(`^(\d+\.){3}\d+$`)
+)
+
+// CheckBucketName checks the bucket name against the rules.
+func CheckBucketName(bucketName string, rules Rules) error {
+	if strings.TrimSpace(bucketName) == "" {
+		return errors.New("Bucket name cannot be empty")
+	}
+	if len(bucketName) < 3 {
+		return errors.New("Bucket name cannot be shorter than 3 characters")
+	}
+	if len(bucketName) > 63 {
+		return errors.New("Bucket name cannot be longer than 63 characters")
+	}
+	if ipAddress.MatchString(bucketName) {
+		return errors.New("Bucket name cannot be an ip address")
+	}
+	if strings.Contains(bucketName, "..") || strings.Contains(bucketName, ".-") || strings.Contains(bucketName, "-.") {
+		return errors.New("Bucket name contains invalid characters")
+	}
+	valid := validBucketName
+	if rules == Strict {
+		valid = validBucketNameStrict
+	}
+	if !valid.MatchString(bucketName) {
+		return errors.New("Bucket name contains invalid characters")
+	}
+	return nil
+}
+
+// CheckKeyPrefix checks the object key prefix, which may be empty.
+//   - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingMetadata.html
+func CheckKeyPrefix(prefix string) error {
+	if len(prefix) > MaxKeyLength {
+		return errors.New("Object name cannot be longer than 1024 characters")
+	}
+	if !utf8.ValidString(prefix) {
+		return errors.New("Object name with non UTF-8 strings are not supported")
+	}
+	return nil
+}
+
+// CheckKey checks the object key.
+//   - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingMetadata.html
+func CheckKey(key string) error {
+	if strings.TrimSpace(key) == "" {
+		return errors.New("Object name cannot be empty")
+	}
+	return CheckKeyPrefix(key)
+}
+
+// SanitizeKey returns the key with its invalid UTF-8 sequences replaced
+// by U+FFFD, its control characters removed and its backslashes
+// replaced by slashes, e.g. for keys made of Windows paths, truncated
+// to MaxKeyLength bytes. The result passes CheckKey unless it is blank.
+func SanitizeKey(key string) string {
+	key = strings.ToValidUTF8(key, "\uFFFD")
+	key = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\\':
+			return '/'
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, key)
+	if len(key) > MaxKeyLength {
+		n := MaxKeyLength
+		for n > 0 && !utf8.RuneStart(key[n]) {
+			n--
+		}
+		key = key[:n]
+	}
+	return key
+}
+
+// if object matches reserved string, no need to encode them
+var reservedObjectNames = regexp.MustCompile("^[a-zA-Z0-9-_.~/]+$")
+
+// EncodeKey percent-encodes the object key, or any path, as it is
+// sent in request paths, every byte of its UTF-8 representation but
+// unreserved characters and slashes.
+func EncodeKey(key string) string {
+	if reservedObjectNames.MatchString(key) {
+		return key
+	}
+	var encodedPathname strings.Builder
+	for _, s := range key {
+		if 'A' <= s && s <= 'Z' || 'a' <= s && s <= 'z' || '0' <= s && s <= '9' { // §2.3 Unreserved characters (mark)
+			encodedPathname.WriteRune(s)
+			continue
+		}
+		switch s {
+		case '-', '_', '.', '~', '/': // §2.3 Unreserved characters (mark)
+			encodedPathname.WriteRune(s)
+			continue
+		default:
+			l := utf8.RuneLen(s)
+			if l < 0 {
+				// if utf8 cannot convert return the same string as is
+				return key
+			}
+			u := make([]byte, l)
+			utf8.EncodeRune(u, s)
+			for _, r := range u {
+				hex := hex.EncodeToString([]byte{r})
+				encodedPathname.WriteString("%" + strings.ToUpper(hex))
+			}
+		}
+	}
+	return encodedPathname.String()
+}
+
+// CopySource returns the value of the x-amz-copy-source header of a
+// copy of the object, of its version versionID if not empty.
+func CopySource(bucketName, key, versionID string) string {
+	source := EncodeKey(bucketName + "/" + key)
+	if versionID != "" {
+		source += "?versionId=" + url.QueryEscape(versionID)
+	}
+	return source
+}
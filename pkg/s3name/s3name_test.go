@@ -0,0 +1,116 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3name
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCheckBucketName(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		relaxed, strict bool
+	}{
+		{"mybucket", true, true},
+		{"my.bucket-1", true, true},
+		{"MyBucket", true, false},
+		{"my_bucket", true, false},
+		{"my:bucket", true, false},
+		{"ab", false, false},
+		{strings.Repeat("a", 64), false, false},
+		{"192.168.1.1", false, false},
+		{"my..bucket", false, false},
+		{"my.-bucket", false, false},
+		{"-mybucket", false, false},
+		{"my bucket", false, false},
+		{"", false, false},
+	} {
+		if err := CheckBucketName(tc.name, Relaxed); (err == nil) != tc.relaxed {
+			t.Errorf("CheckBucketName(%q, Relaxed) = %v", tc.name, err)
+		}
+		if err := CheckBucketName(tc.name, Strict); (err == nil) != tc.strict {
+			t.Errorf("CheckBucketName(%q, Strict) = %v", tc.name, err)
+		}
+	}
+}
+
+func TestCheckKey(t *testing.T) {
+	for _, tc := range []struct {
+		key   string
+		valid bool
+	}{
+		{"object", true},
+		{"dir/object name.txt", true},
+		{"日本語", true},
+		{"", false},
+		{"  ", false},
+		{strings.Repeat("a", MaxKeyLength), true},
+		{strings.Repeat("a", MaxKeyLength+1), false},
+		{"bad\xffutf8", false},
+	} {
+		if err := CheckKey(tc.key); (err == nil) != tc.valid {
+			t.Errorf("CheckKey(%q) = %v", tc.key, err)
+		}
+	}
+	if err := CheckKeyPrefix(""); err != nil {
+		t.Errorf("CheckKeyPrefix(\"\") = %v", err)
+	}
+}
+
+func TestSanitizeKey(t *testing.T) {
+	for _, tc := range []struct {
+		key, want string
+	}{
+		{"dir/object", "dir/object"},
+		{`C:\Users\me\file.txt`, "C:/Users/me/file.txt"},
+		{"new\nline\ttab\x7f", "newlinetab"},
+		{"bad\xffutf8", "bad\uFFFDutf8"},
+		{strings.Repeat("a", MaxKeyLength-1) + "é", strings.Repeat("a", MaxKeyLength-1)},
+	} {
+		got := SanitizeKey(tc.key)
+		if got != tc.want {
+			t.Errorf("SanitizeKey(%q) = %q, want %q", tc.key, got, tc.want)
+		}
+		if len(got) > MaxKeyLength || !utf8.ValidString(got) {
+			t.Errorf("SanitizeKey(%q) = %q is invalid", tc.key, got)
+		}
+	}
+}
+
+func TestEncodeKey(t *testing.T) {
+	for _, tc := range []struct {
+		key, want string
+	}{
+		{"dir/object-1_2.~", "dir/object-1_2.~"},
+		{"a b+c", "a%20b%2Bc"},
+		{"日本", "%E6%97%A5%E6%9C%AC"},
+		{"a?b#c", "a%3Fb%23c"},
+	} {
+		if got := EncodeKey(tc.key); got != tc.want {
+			t.Errorf("EncodeKey(%q) = %q, want %q", tc.key, got, tc.want)
+		}
+	}
+	if got, want := CopySource("bucket", "a b", ""), "bucket/a%20b"; got != want {
+		t.Errorf("CopySource = %q, want %q", got, want)
+	}
+	if got, want := CopySource("bucket", "object", "v1+2"), "bucket/object?versionId=v1%2B2"; got != want {
+		t.Errorf("CopySource = %q, want %q", got, want)
+	}
+}
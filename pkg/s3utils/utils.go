@@ -19,14 +19,13 @@ package s3utils
 
 import (
 	"bytes"
-	"encoding/hex"
-	"errors"
 	"net"
 	"net/url"
 	"regexp"
 	"sort"
 	"strings"
-	"unicode/utf8"
+
+	"github.com/jie123108/minio-go/v7/pkg/s3name"
 )
 
 // Sentinel URL is the default url value which is invalid.
@@ -261,9 +260,6 @@ func QueryEncode(v url.Values) string {
 	return buf.String()
 }
 
-// if object matches reserved string, no need to encode them
-var reservedObjectNames = regexp.MustCompile("^[a-zA-Z0-9-_.~/]+$")
-
 // EncodePath encode the strings from UTF-8 byte representations to HTML hex escape sequences
 //
 // This is necessary since regular url.Parse() and url.Encode() functions do not support UTF-8
@@ -272,102 +268,29 @@ var reservedObjectNames = regexp.MustCompile("^[a-zA-Z0-9-_.~/]+$")
 // This function on the other hand is a direct replacement for url.Encode() technique to support
 // pretty much every UTF-8 character.
 func EncodePath(pathName string) string {
-	if reservedObjectNames.MatchString(pathName) {
-		return pathName
-	}
-	var encodedPathname strings.Builder
-	for _, s := range pathName {
-		if 'A' <= s && s <= 'Z' || 'a' <= s && s <= 'z' || '0' <= s && s <= '9' { // §2.3 Unreserved characters (mark)
-			encodedPathname.WriteRune(s)
-			continue
-		}
-		switch s {
-		case '-', '_', '.', '~', '/': // §2.3 Unreserved characters (mark)
-			encodedPathname.WriteRune(s)
-			continue
-		default:
-			l := utf8.RuneLen(s)
-			if l < 0 {
-				// if utf8 cannot convert return the same string as is
-				return pathName
-			}
-			u := make([]byte, l)
-			utf8.EncodeRune(u, s)
-			for _, r := range u {
-				hex := hex.EncodeToString([]byte{r})
-				encodedPathname.WriteString("%" + strings.ToUpper(hex))
-			}
-		}
-	}
-	return encodedPathname.String()
-}
-
-// We support '.' with bucket names but we fallback to using path
-// style requests instead for such buckets.
-var (
-	validBucketName       = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\.\-\_\:]{1,61}[A-Za-z0-9]$`)
-	validBucketNameStrict = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-]{1,61}[a-z0-9]$`)
-	ipAddress             = regexp.MustCompile(`^(\d+\.){3}\d+$`)
-)
-
-// Common checker for both stricter and basic validation.
-func checkBucketNameCommon(bucketName string, strict bool) (err error) {
-	if strings.TrimSpace(bucketName) == "" {
-		return errors.New("Bucket name cannot be empty")
-	}
-	if len(bucketName) < 3 {
-		return errors.New("Bucket name cannot be shorter than 3 characters")
-	}
-	if len(bucketName) > 63 {
-		return errors.New("Bucket name cannot be longer than 63 characters")
-	}
-	if ipAddress.MatchString(bucketName) {
-		return errors.New("Bucket name cannot be an ip address")
-	}
-	if strings.Contains(bucketName, "..") || strings.Contains(bucketName, ".-") || strings.Contains(bucketName, "-.") {
-		return errors.New("Bucket name contains invalid characters")
-	}
-	if strict {
-		if !validBucketNameStrict.MatchString(bucketName) {
-			err = errors.New("Bucket name contains invalid characters")
-		}
-		return err
-	}
-	if !validBucketName.MatchString(bucketName) {
-		err = errors.New("Bucket name contains invalid characters")
-	}
-	return err
+	return s3name.EncodeKey(pathName)
 }
 
 // CheckValidBucketName - checks if we have a valid input bucket name.
 func CheckValidBucketName(bucketName string) (err error) {
-	return checkBucketNameCommon(bucketName, false)
+	return s3name.CheckBucketName(bucketName, s3name.Relaxed)
 }
 
 // CheckValidBucketNameStrict - checks if we have a valid input bucket name.
 // This is a stricter version.
 // - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingBucket.html
 func CheckValidBucketNameStrict(bucketName string) (err error) {
-	return checkBucketNameCommon(bucketName, true)
+	return s3name.CheckBucketName(bucketName, s3name.Strict)
 }
 
 // CheckValidObjectNamePrefix - checks if we have a valid input object name prefix.
 //   - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingMetadata.html
 func CheckValidObjectNamePrefix(objectName string) error {
-	if len(objectName) > 1024 {
-		return errors.New("Object name cannot be longer than 1024 characters")
-	}
-	if !utf8.ValidString(objectName) {
-		return errors.New("Object name with non UTF-8 strings are not supported")
-	}
-	return nil
+	return s3name.CheckKeyPrefix(objectName)
 }
 
 // CheckValidObjectName - checks if we have a valid input object name.
 //   - http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingMetadata.html
 func CheckValidObjectName(objectName string) error {
-	if strings.TrimSpace(objectName) == "" {
-		return errors.New("Object name cannot be empty")
-	}
-	return CheckValidObjectNamePrefix(objectName)
+	return s3name.CheckKey(objectName)
 }
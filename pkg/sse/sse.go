@@ -17,7 +17,20 @@
 
 package sse
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"errors"
+)
+
+// Server side encryption algorithms.
+const (
+	// AlgorithmAES256 is SSE-S3, encryption with server managed keys.
+	AlgorithmAES256 = "AES256"
+	// AlgorithmKMS is SSE-KMS, encryption with KMS managed keys.
+	AlgorithmKMS = "aws:kms"
+	// AlgorithmKMSDSSE is DSSE-KMS, dual-layer encryption with KMS managed keys.
+	AlgorithmKMSDSSE = "aws:kms:dsse"
+)
 
 // ApplySSEByDefault defines default encryption configuration, KMS or SSE. To activate
 // KMS, SSEAlgoritm needs to be set to "aws:kms"
@@ -27,9 +40,16 @@ type ApplySSEByDefault struct {
 	SSEAlgorithm   string `xml:"SSEAlgorithm"`
 }
 
+// IsKMS returns true if the algorithm uses KMS managed keys.
+func (a ApplySSEByDefault) IsKMS() bool {
+	return a.SSEAlgorithm == AlgorithmKMS || a.SSEAlgorithm == AlgorithmKMSDSSE
+}
+
 // Rule layer encapsulates default encryption configuration
 type Rule struct {
 	Apply ApplySSEByDefault `xml:"ApplyServerSideEncryptionByDefault"`
+	// BucketKeyEnabled makes SSE-KMS use an S3 Bucket Key.
+	BucketKeyEnabled bool `xml:"BucketKeyEnabled,omitempty"`
 }
 
 // Configuration is the default encryption configuration structure
@@ -38,13 +58,43 @@ type Configuration struct {
 	Rules   []Rule   `xml:"Rule"`
 }
 
+// Validate checks the configuration before it is sent to the server.
+func (c *Configuration) Validate() error {
+	if len(c.Rules) != 1 {
+		return errors.New("sse: configuration must have exactly one rule")
+	}
+	rule := c.Rules[0]
+	switch rule.Apply.SSEAlgorithm {
+	case AlgorithmAES256:
+		if rule.Apply.KmsMasterKeyID != "" {
+			return errors.New("sse: KMS master key requires a KMS algorithm")
+		}
+		if rule.BucketKeyEnabled {
+			return errors.New("sse: bucket key requires a KMS algorithm")
+		}
+	case AlgorithmKMS, AlgorithmKMSDSSE:
+	default:
+		return errors.New("sse: unsupported algorithm " + rule.Apply.SSEAlgorithm)
+	}
+	return nil
+}
+
+// Default returns the default encryption applied by the
+// configuration, false if it has none.
+func (c *Configuration) Default() (Rule, bool) {
+	if c == nil || len(c.Rules) == 0 {
+		return Rule{}, false
+	}
+	return c.Rules[0], true
+}
+
 // NewConfigurationSSES3 initializes a new SSE-S3 configuration
 func NewConfigurationSSES3() *Configuration {
 	return &Configuration{
 		Rules: []Rule{
 			{
 				Apply: ApplySSEByDefault{
-					SSEAlgorithm: "AES256",
+					SSEAlgorithm: AlgorithmAES256,
 				},
 			},
 		},
@@ -58,9 +108,17 @@ func NewConfigurationSSEKMS(kmsMasterKey string) *Configuration {
 			{
 				Apply: ApplySSEByDefault{
 					KmsMasterKeyID: kmsMasterKey,
-					SSEAlgorithm:   "aws:kms",
+					SSEAlgorithm:   AlgorithmKMS,
 				},
 			},
 		},
 	}
 }
+
+// NewConfigurationSSEKMSBucketKey initializes a new SSE-KMS
+// configuration using an S3 Bucket Key.
+func NewConfigurationSSEKMSBucketKey(kmsMasterKey string) *Configuration {
+	config := NewConfigurationSSEKMS(kmsMasterKey)
+	config.Rules[0].BucketKeyEnabled = true
+	return config
+}
@@ -0,0 +1,111 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tags
+
+import (
+	"strings"
+)
+
+var errInvalidExpression = &errTag{"InvalidArgument", "The tag expression you have provided is invalid"}
+
+type operator int
+
+const (
+	opExists operator = iota
+	opAbsent
+	opEqual
+	opNotEqual
+)
+
+type condition struct {
+	op    operator
+	key   string
+	value string
+}
+
+func (c condition) match(tagMap map[string]string) bool {
+	value, found := tagMap[c.key]
+	switch c.op {
+	case opExists:
+		return found
+	case opAbsent:
+		return !found
+	case opEqual:
+		return found && value == c.value
+	default:
+		return !found || value != c.value
+	}
+}
+
+// Expression is a set of tag conditions which must all hold.
+type Expression []condition
+
+// ParseExpression parses comma separated tag conditions, e.g.
+// "env=prod,team!=red,owner,!tmp" matches tags with env set to
+// prod, team not set to red, an owner tag and no tmp tag.
+func ParseExpression(s string) (Expression, error) {
+	var expr Expression
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		var c condition
+		switch {
+		case term == "":
+			return nil, errInvalidExpression
+		case strings.Contains(term, "!="):
+			c.op = opNotEqual
+			c.key, c.value, _ = stringsCut(term, "!=")
+		case strings.Contains(term, "="):
+			c.op = opEqual
+			c.key, c.value, _ = stringsCut(term, "=")
+		case strings.HasPrefix(term, "!"):
+			c.op = opAbsent
+			c.key = term[1:]
+		default:
+			c.op = opExists
+			c.key = term
+		}
+		if err := checkKey(c.key); err != nil {
+			return nil, err
+		}
+		if c.op == opEqual || c.op == opNotEqual {
+			if err := checkValue(c.value); err != nil {
+				return nil, err
+			}
+		}
+		expr = append(expr, c)
+	}
+	return expr, nil
+}
+
+// Match returns true if tagMap satisfies all conditions.
+func (expr Expression) Match(tagMap map[string]string) bool {
+	for _, c := range expr {
+		if !c.match(tagMap) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns true if the tags satisfy all conditions of expr.
+func (tags Tags) Matches(expr Expression) bool {
+	if tags.TagSet == nil {
+		return expr.Match(nil)
+	}
+	return expr.Match(tags.TagSet.tagMap)
+}
@@ -293,6 +293,37 @@ func (tags Tags) ToMap() map[string]string {
 	return tags.TagSet.toMap()
 }
 
+// Get returns the value of a tag and whether it is set.
+func (tags Tags) Get(key string) (string, bool) {
+	if tags.TagSet == nil {
+		return "", false
+	}
+	value, found := tags.TagSet.tagMap[key]
+	return value, found
+}
+
+// Merge sets the tags in tagMap and removes the tags in remove,
+// keeping all other tags. Tags are left unchanged on error.
+func (tags *Tags) Merge(tagMap map[string]string, remove ...string) error {
+	merged := &tagSet{tagMap: make(map[string]string)}
+	if tags.TagSet != nil {
+		merged.tagMap = tags.TagSet.toMap()
+		merged.isObject = tags.TagSet.isObject
+	}
+	for _, key := range remove {
+		merged.remove(key)
+	}
+	for key, value := range tagMap {
+		// Replacing a tag must not count against the limit.
+		merged.remove(key)
+		if err := merged.set(key, value, false); err != nil {
+			return err
+		}
+	}
+	tags.TagSet = merged
+	return nil
+}
+
 // MapToObjectTags converts an input map of key and value into
 // *Tags data structure with validation.
 func MapToObjectTags(tagMap map[string]string) (*Tags, error) {
@@ -107,6 +107,76 @@ func TestParseTags(t *testing.T) {
 	}
 }
 
+func TestParseExpression(t *testing.T) {
+	tagMap := map[string]string{"env": "prod", "team": "blue", "owner": ""}
+	testCases := []struct {
+		expr        string
+		expectedErr bool
+		match       bool
+	}{
+		{"env=prod", false, true},
+		{"env=dev", false, false},
+		{"env=prod, team!=red", false, true},
+		{"team!=blue", false, false},
+		{"missing!=value", false, true},
+		{"owner,!tmp", false, true},
+		{"!owner", false, false},
+		{"owner=", false, true},
+		{"", true, false},
+		{"env=prod,,team", true, false},
+		{"key$=value", true, false},
+		{"!", true, false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.expr, func(t *testing.T) {
+			expr, err := ParseExpression(testCase.expr)
+			if testCase.expectedErr != (err != nil) {
+				t.Fatalf("Expected error %v, got %v", testCase.expectedErr, err)
+			}
+			if err == nil && expr.Match(tagMap) != testCase.match {
+				t.Errorf("Expected match %v", testCase.match)
+			}
+		})
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	tags, err := ParseObjectTags("a=1&b=2&c=3&d=4&e=5&f=6&g=7&h=8&i=9&j=10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Replacing tags of a full tag set is allowed.
+	if err = tags.Merge(map[string]string{"a": "one"}, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := tags.Get("a"); v != "one" || tags.Count() != 9 {
+		t.Fatalf("Unexpected tags %s", tags)
+	}
+	if _, found := tags.Get("b"); found {
+		t.Fatal("Expected tag b to be removed")
+	}
+	if err = tags.Merge(map[string]string{"k": "11", "l": "12"}); err == nil {
+		t.Fatal("Expected too many tags")
+	}
+	if tags.Count() != 9 {
+		t.Fatalf("Expected tags to be unchanged, got %s", tags)
+	}
+
+	// The zero value has no tags.
+	var zero Tags
+	if _, found := zero.Get("a"); found {
+		t.Fatal("Expected no tags")
+	}
+	if err = zero.Merge(map[string]string{"a": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := zero.Get("a"); v != "1" || zero.Count() != 1 {
+		t.Fatalf("Unexpected tags %s", zero)
+	}
+}
+
 func BenchmarkParseTags(b *testing.B) {
 	b.ResetTimer()
 	b.ReportAllocs()
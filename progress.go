@@ -0,0 +1,112 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// ProgressFunc is called with the number of bytes transferred so far
+// and the total size of the transfer, total is -1 if unknown.
+//
+// Calls are serialized, also for parallel multipart uploads. When a
+// request is retried the bytes already sent for it are subtracted
+// again, so transferred may decrease.
+type ProgressFunc func(transferred, total int64)
+
+// progressTracker accumulates progress of a single transfer.
+type progressTracker struct {
+	mu          sync.Mutex
+	fn          ProgressFunc
+	transferred int64
+	total       int64
+}
+
+func newProgressTracker(fn ProgressFunc, total int64) *progressTracker {
+	if total < 0 {
+		total = -1
+	}
+	return &progressTracker{fn: fn, total: total}
+}
+
+// add reports n more bytes, n is negative when regressing.
+func (p *progressTracker) add(n int64) {
+	if n == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.transferred += n
+	p.fn(p.transferred, p.total)
+}
+
+// setTotal sets the total size once it is known.
+func (p *progressTracker) setTotal(total int64) {
+	p.mu.Lock()
+	p.total = total
+	p.mu.Unlock()
+}
+
+// progressPart reports the bytes of a single request body to a
+// tracker. It is used as a hook, see newHook, and forwards reads to
+// the user provided Progress reader if any. Seeking back, which
+// happens when a request is retried, takes back the bytes reported
+// since.
+type progressPart struct {
+	tracker *progressTracker
+	hook    io.Reader
+	pos     int64
+}
+
+// Read implements io.Reader.
+func (p *progressPart) Read(b []byte) (int, error) {
+	if p.hook != nil {
+		if _, err := p.hook.Read(b); err != nil && err != io.EOF {
+			return 0, err
+		}
+	}
+	p.pos += int64(len(b))
+	p.tracker.add(int64(len(b)))
+	return len(b), nil
+}
+
+// Seek implements io.Seeker.
+func (p *progressPart) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = p.pos + offset
+	default:
+		return 0, errors.New("progress: unsupported whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("progress: negative position")
+	}
+	if hookSeeker, ok := p.hook.(io.Seeker); ok {
+		if _, err := hookSeeker.Seek(offset, whence); err != nil {
+			return 0, err
+		}
+	}
+	p.tracker.add(pos - p.pos)
+	p.pos = pos
+	return pos, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+	"github.com/jie123108/minio-go/v7/pkg/miniotest"
+)
+
+func TestProgressRegressOnRetry(t *testing.T) {
+	srv := miniotest.NewServer(t)
+
+	// Fail the first attempt of part 2 after reading its body.
+	var once sync.Once
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Query().Get("partNumber") == "2" {
+			failed := false
+			once.Do(func() {
+				io.Copy(io.Discard, r.Body)
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				io.WriteString(w, `<Error><Code>SlowDown</Code><Message>retry</Message></Error>`)
+				failed = true
+			})
+			if failed {
+				return
+			}
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer flaky.Close()
+
+	clnt, err := New(flaky.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region: srv.Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	const size = 12 << 20
+	var (
+		last, peak int64
+		regressed  bool
+	)
+	_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(make([]byte, size)), size, PutObjectOptions{
+		PartSize: 5 << 20,
+		OnProgress: func(transferred, total int64) {
+			if total != size {
+				t.Errorf("expected total %d, got %d", size, total)
+			}
+			if transferred < last {
+				regressed = true
+			}
+			if transferred > peak {
+				peak = transferred
+			}
+			last = transferred
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last != size {
+		t.Fatalf("expected %d bytes transferred, got %d", size, last)
+	}
+	if !regressed {
+		t.Fatal("expected progress to regress on retry")
+	}
+	if peak > size {
+		t.Fatalf("progress overcounted, peak %d", peak)
+	}
+}
+
+func TestProgressDownload(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	data := strings.Repeat("a", 1<<20)
+	if _, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader(data), int64(len(data)), PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var transferred, total int64
+	obj, err := clnt.GetObject(ctx, "bucket", "object", GetObjectOptions{
+		OnProgress: func(n, t int64) { transferred, total = n, t },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.Copy(io.Discard, obj); err != nil {
+		t.Fatal(err)
+	}
+	if transferred != int64(len(data)) || total != int64(len(data)) {
+		t.Fatalf("unexpected progress %d/%d", transferred, total)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// rateDecreaseFactor multiplies the request rate on throttling.
+	rateDecreaseFactor = 0.5
+	// rateIncrease is the number of requests per second the rate
+	// increases by per second without throttling.
+	rateIncrease = 5
+	// minRate is the lowest request rate, in requests per second.
+	minRate = 1
+	// rateDecreaseInterval is the minimum interval between decreases,
+	// so the throttled responses of the requests sent at the same time
+	// decrease the rate once.
+	rateDecreaseInterval = 500 * time.Millisecond
+)
+
+// rateLimiter is an adaptive limit of the rate of the requests of a
+// client, shared by all its goroutines. It does not limit requests
+// until the server throttles them with 503 Service Unavailable (e.g.
+// SlowDown) or 429 Too Many Requests responses, then halves the rate
+// of requests sent on every throttling and increases it additively
+// while none happens, up to the rate it first throttled at, where it
+// stops limiting. A nil limiter does not limit requests.
+type rateLimiter struct {
+	sync.Mutex
+
+	rate    float64   // Requests per second, 0 if unlimited.
+	ceiling float64   // Rate requests were throttled at when unlimited.
+	next    time.Time // Time the next request may be sent.
+
+	lastDecrease time.Time
+	lastIncrease time.Time
+
+	// Rate of the requests sent, measured over windows of a second.
+	measured    float64
+	windowStart time.Time
+	windowCount int
+}
+
+// newRateLimiter returns a rate limiter if enabled, nil otherwise.
+func newRateLimiter(enabled bool) *rateLimiter {
+	if !enabled {
+		return nil
+	}
+	return &rateLimiter{}
+}
+
+// wait waits until a request may be sent.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve reserves the next send slot, returning how long to wait for it.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	if elapsed := now.Sub(l.windowStart); elapsed >= time.Second {
+		l.measured = float64(l.windowCount) / elapsed.Seconds()
+		l.windowStart, l.windowCount = now, 0
+	}
+	l.windowCount++
+
+	if l.rate == 0 {
+		return 0
+	}
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(time.Second) / l.rate))
+	return delay
+}
+
+// observe adjusts the rate to the status of a response.
+func (l *rateLimiter) observe(statusCode int, now time.Time) {
+	if l == nil {
+		return
+	}
+	switch statusCode {
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		l.throttled(now)
+	default:
+		l.succeeded(now)
+	}
+}
+
+// throttled decreases the rate multiplicatively.
+func (l *rateLimiter) throttled(now time.Time) {
+	l.Lock()
+	defer l.Unlock()
+	if now.Sub(l.lastDecrease) < rateDecreaseInterval {
+		return
+	}
+	rate := l.rate
+	if rate == 0 {
+		// Start from the rate requests were sent at.
+		rate = max(l.measured, float64(l.windowCount)/max(now.Sub(l.windowStart).Seconds(), 1))
+		l.ceiling = rate
+	}
+	l.rate = max(rate*rateDecreaseFactor, minRate)
+	l.lastDecrease, l.lastIncrease = now, now
+}
+
+// succeeded increases the rate additively.
+func (l *rateLimiter) succeeded(now time.Time) {
+	l.Lock()
+	defer l.Unlock()
+	if l.rate == 0 {
+		return
+	}
+	l.rate += rateIncrease * now.Sub(l.lastIncrease).Seconds()
+	l.lastIncrease = now
+	if l.rate >= l.ceiling {
+		l.rate = 0
+	}
+}
+
+// limit returns the current rate, 0 if unlimited.
+func (l *rateLimiter) limit() float64 {
+	if l == nil {
+		return 0
+	}
+	l.Lock()
+	defer l.Unlock()
+	return l.rate
+}
+
+// RequestRate returns the number of requests per second the client is
+// limited to by Options.AdaptiveRateLimit after the server throttled
+// requests, 0 if requests are not limited.
+func (c *Client) RequestRate() float64 {
+	return c.rateLimiter.limit()
+}
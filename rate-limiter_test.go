@@ -0,0 +1,124 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(true)
+	now := time.Now()
+
+	// Unlimited until throttled, at 100 requests per second.
+	for i := 0; i < 100; i++ {
+		if d := l.reserve(now.Add(time.Duration(i) * 10 * time.Millisecond)); d != 0 {
+			t.Fatalf("unexpected delay %v of unlimited requests", d)
+		}
+	}
+	now = now.Add(time.Second)
+	l.reserve(now)
+	l.throttled(now)
+	if rate := l.limit(); rate != 50 {
+		t.Fatalf("expected rate of 50 after throttling, got %v", rate)
+	}
+
+	// Throttling of concurrent requests decreases the rate once.
+	l.throttled(now.Add(10 * time.Millisecond))
+	if rate := l.limit(); rate != 50 {
+		t.Fatalf("expected rate of 50 after concurrent throttling, got %v", rate)
+	}
+	now = now.Add(time.Second)
+	l.throttled(now)
+	if rate := l.limit(); rate != 25 {
+		t.Fatalf("expected rate of 25 after throttling, got %v", rate)
+	}
+
+	// Requests are spaced by the rate.
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("unexpected delay %v of first request", d)
+	}
+	if d := l.reserve(now); d != 40*time.Millisecond {
+		t.Fatalf("expected delay of 40ms, got %v", d)
+	}
+
+	// The rate increases additively, up to unlimited.
+	now = now.Add(2 * time.Second)
+	l.succeeded(now)
+	if rate := l.limit(); rate != 35 {
+		t.Fatalf("expected rate of 35 after 2s without throttling, got %v", rate)
+	}
+	now = now.Add(20 * time.Second)
+	l.succeeded(now)
+	if rate := l.limit(); rate != 0 {
+		t.Fatalf("expected unlimited rate, got %v", rate)
+	}
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("unexpected delay %v of unlimited request", d)
+	}
+}
+
+func TestAdaptiveRateLimit(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`<Error><Code>SlowDown</Code><Message>Please reduce your request rate.</Message></Error>`))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:             credentials.NewStaticV4("access", "secret", ""),
+		Region:            "us-east-1",
+		MaxRetries:        2,
+		AdaptiveRateLimit: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.BucketExists(context.Background(), "bucket"); ToErrorResponse(err).StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 error, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Fatalf("expected the throttled request to be retried, got %d requests", n)
+	}
+	if rate := clnt.RequestRate(); rate != minRate {
+		t.Fatalf("expected rate of %v after throttling, got %v", float64(minRate), rate)
+	}
+
+	// Waiting for the rate limiter does not mark the endpoint offline.
+	atomic.StoreInt32(&clnt.healthStatus, online)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err = clnt.BucketExists(ctx, "bucket"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to wait for the rate limiter, got %v", err)
+	}
+	if clnt.IsOffline() {
+		t.Fatal("expected the client to stay online")
+	}
+}
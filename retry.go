@@ -48,6 +48,12 @@ var DefaultRetryCap = time.Second
 // newRetryTimer creates a timer with exponentially increasing
 // delays until the maximum retry attempts are reached.
 func (c *Client) newRetryTimer(ctx context.Context, maxRetry int, baseSleep, maxSleep time.Duration, jitter float64) iter.Seq[int] {
+	return c.newRetryTimerWithDelay(ctx, maxRetry, baseSleep, maxSleep, jitter, nil)
+}
+
+// newRetryTimerWithDelay is newRetryTimer where a positive *delay,
+// set by the loop body, replaces the delay of the next attempt.
+func (c *Client) newRetryTimerWithDelay(ctx context.Context, maxRetry int, baseSleep, maxSleep time.Duration, jitter float64, delay *time.Duration) iter.Seq[int] {
 	// computes the exponential backoff duration according to
 	// https://www.awsarchitectureblog.com/2015/03/backoff.html
 	exponentialBackoffWait := func(attempt int) time.Duration {
@@ -83,8 +89,12 @@ func (c *Client) newRetryTimer(ctx context.Context, maxRetry int, baseSleep, max
 				return
 			}
 
+			wait := exponentialBackoffWait(i)
+			if delay != nil && *delay > 0 {
+				wait, *delay = *delay, 0
+			}
 			select {
-			case <-time.After(exponentialBackoffWait(i)):
+			case <-time.After(wait):
 			case <-ctx.Done():
 				return
 			}
@@ -92,6 +102,60 @@ func (c *Client) newRetryTimer(ctx context.Context, maxRetry int, baseSleep, max
 	}
 }
 
+// RetryInfo describes a failed attempt of a request, see RetryPolicy.
+type RetryInfo struct {
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt    int
+	Method     string
+	BucketName string
+	ObjectName string
+
+	// Err is the error of the attempt, an ErrorResponse if the server
+	// answered, with its StatusCode, RetryAfter and Throttled.
+	Err error
+
+	// Retryable tells whether the client retries by default.
+	Retryable bool
+
+	// Retries is the number of attempts left.
+	Retries int
+}
+
+// RetryPolicy decides whether a failed request is retried, and after
+// which delay, a zero delay meaning the default exponential backoff.
+// Requests are not retried once all retries are used, whatever the
+// policy. See Options.RetryPolicy.
+//
+// Policies let orchestrators retry throttled requests themselves, e.g.
+// not retrying requests the server asks to retry much later:
+//
+//	func(info minio.RetryInfo) (bool, time.Duration) {
+//		if minio.ToErrorResponse(info.Err).RetryAfter > time.Second {
+//			return false, 0 // Reschedule the job.
+//		}
+//		return info.Retryable, minio.ToErrorResponse(info.Err).RetryAfter
+//	}
+type RetryPolicy func(info RetryInfo) (retry bool, delay time.Duration)
+
+// retryAttempt tells whether a failed attempt of a request is retried,
+// setting delay to the delay of the retry, see Options.RetryPolicy.
+func (c *Client) retryAttempt(method string, metadata requestMetadata, attempt, maxRetry int, err error, retryable bool, delay *time.Duration) bool {
+	if c.retryPolicy == nil {
+		return retryable
+	}
+	retry, d := c.retryPolicy(RetryInfo{
+		Attempt:    attempt,
+		Method:     method,
+		BucketName: metadata.bucketName,
+		ObjectName: metadata.objectName,
+		Err:        err,
+		Retryable:  retryable,
+		Retries:    maxRetry - attempt,
+	})
+	*delay = d
+	return retry
+}
+
 // List of AWS S3 error codes which are retryable.
 var retryableS3Codes = map[string]struct{}{
 	"RequestError":          {},
@@ -107,6 +171,20 @@ var retryableS3Codes = map[string]struct{}{
 	// Add more AWS S3 codes here.
 }
 
+// List of S3 error codes throttling requests, including the ones of
+// MinIO limiting the requests in flight.
+var throttlingS3Codes = map[string]struct{}{
+	"Throttling":           {},
+	"ThrottlingException":  {},
+	"RequestLimitExceeded": {},
+	"RequestThrottled":     {},
+	"SlowDown":             {},
+	"SlowDownRead":         {},
+	"SlowDownWrite":        {},
+	"TooManyRequests":      {},
+	// Add more S3 codes here.
+}
+
 // isS3CodeRetryable - is s3 error code retryable.
 func isS3CodeRetryable(s3Code string) (ok bool) {
 	_, ok = retryableS3Codes[s3Code]
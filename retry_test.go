@@ -3,8 +3,13 @@ package minio
 import (
 	"context"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
 )
 
 func TestRetryTimer(t *testing.T) {
@@ -136,3 +141,71 @@ func TestRetryContinuous(t *testing.T) {
 		}
 	})
 }
+
+func TestRetryPolicy(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("<Error><Code>SlowDown</Code><Message>Please reduce your request rate.</Message></Error>"))
+	}))
+	defer srv.Close()
+
+	var infos []RetryInfo
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+		RetryPolicy: func(info RetryInfo) (bool, time.Duration) {
+			infos = append(infos, info)
+			return false, 0
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{})
+	errResp := ToErrorResponse(err)
+	if errResp.StatusCode != http.StatusServiceUnavailable || errResp.RetryAfter != 3*time.Second {
+		t.Fatalf("unexpected error %#v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected a single request, got %d", n)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("expected the policy to be called once, got %+v", infos)
+	}
+	info := infos[0]
+	if info.Attempt != 1 || info.Method != http.MethodHead || info.BucketName != "bucket" || info.ObjectName != "object" || !info.Retryable || info.Retries != MaxRetry-1 {
+		t.Fatalf("unexpected retry info %+v", info)
+	}
+	if ToErrorResponse(info.Err).RetryAfter != 3*time.Second {
+		t.Fatalf("unexpected retry info error %#v", info.Err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	for _, tc := range []struct {
+		header http.Header
+		want   time.Duration
+	}{
+		{http.Header{}, 0},
+		{http.Header{"Retry-After": {"5"}}, 5 * time.Second},
+		{http.Header{"Retry-After": {"-5"}}, 0},
+		{http.Header{"Retry-After": {"soon"}}, 0},
+		{http.Header{"X-Amz-Retry-After": {"2"}}, 2 * time.Second},
+		{http.Header{"Retry-After": {"Mon, 02 Jan 2006 15:04:05 GMT"}}, 0},
+	} {
+		if got := parseRetryAfter(tc.header); got != tc.want {
+			t.Errorf("parseRetryAfter(%v) = %v, want %v", tc.header, got, tc.want)
+		}
+	}
+	if got := parseRetryAfter(http.Header{"Retry-After": {date}}); got < 58*time.Second || got > time.Minute {
+		t.Errorf("parseRetryAfter(%v) = %v, want about a minute", date, got)
+	}
+	if !(ErrorResponse{Code: "SlowDown"}).Throttled() || !(ErrorResponse{StatusCode: http.StatusTooManyRequests}).Throttled() || (ErrorResponse{Code: "NoSuchKey"}).Throttled() {
+		t.Error("unexpected Throttled")
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// Priority is the priority class of the requests of an API call, see
+// WithPriority and Options.MaxRequestsInFlight.
+type Priority int
+
+const (
+	// PriorityHigh is the priority of calls without a priority, meant
+	// for interactive requests.
+	PriorityHigh Priority = iota
+	// PriorityLow is meant for background work like bulk uploads,
+	// which must not starve interactive requests.
+	PriorityLow
+)
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	}
+	return "unknown"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a context making the API calls it is passed to
+// send their requests with priority p.
+//
+//	ctx = minio.WithPriority(ctx, minio.PriorityLow)
+//	_, err = client.FPutObject(ctx, "bucket", "backup.tar", "/backup.tar", minio.PutObjectOptions{})
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// priorityFromContext returns the priority set by WithPriority.
+func priorityFromContext(ctx context.Context) Priority {
+	p, _ := ctx.Value(priorityKey{}).(Priority)
+	return p
+}
+
+type unscheduledKey struct{}
+
+// unscheduled returns a context whose requests bypass the scheduler,
+// like the probes of the health check, which must not queue behind
+// API calls.
+func unscheduled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscheduledKey{}, true)
+}
+
+// requestScheduler bounds the number of requests in flight per
+// priority. Requests are in flight until their response body is
+// closed. A nil scheduler does not limit requests.
+type requestScheduler struct {
+	slots map[Priority]chan struct{}
+}
+
+// newRequestScheduler returns a scheduler enforcing limits, or nil if
+// there are no limits.
+func newRequestScheduler(limits map[Priority]int) *requestScheduler {
+	s := &requestScheduler{slots: make(map[Priority]chan struct{})}
+	for p, n := range limits {
+		if n > 0 {
+			s.slots[p] = make(chan struct{}, n)
+		}
+	}
+	if len(s.slots) == 0 {
+		return nil
+	}
+	return s
+}
+
+// acquire waits for a slot of the priority of ctx, and returns the
+// function releasing it.
+func (s *requestScheduler) acquire(ctx context.Context) (release func(), err error) {
+	if s == nil || ctx.Value(unscheduledKey{}) != nil {
+		return func() {}, nil
+	}
+	slots, ok := s.slots[priorityFromContext(ctx)]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-slots })
+	}, nil
+}
+
+// inFlight returns the number of requests in flight with priority p.
+func (s *requestScheduler) inFlight(p Priority) int {
+	if s == nil {
+		return 0
+	}
+	return len(s.slots[p])
+}
+
+// releaseOnClose releases the scheduler slot of a request once its
+// response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
+
+// RequestsInFlight returns the number of requests in flight with
+// priority p, if Options.MaxRequestsInFlight limits them.
+func (c *Client) RequestsInFlight(p Priority) int {
+	return c.scheduler.inFlight(p)
+}
@@ -0,0 +1,79 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/credentials"
+)
+
+func TestRequestPriority(t *testing.T) {
+	srv, direct := newTestServerClient(t)
+	putTestObjects(t, direct, map[string]string{"object": "data"})
+
+	clnt, err := New(srv.Endpoint(), &Options{
+		Creds:               credentials.NewStaticV4(srv.AccessKey, srv.SecretKey, ""),
+		Region:              srv.Region,
+		MaxRequestsInFlight: map[Priority]int{PriorityLow: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	low := WithPriority(context.Background(), PriorityLow)
+
+	// An open response body holds its request in flight.
+	body, _, _, err := Core{clnt}.GetObject(low, "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := clnt.RequestsInFlight(PriorityLow); n != 1 {
+		t.Fatalf("expected 1 low priority request in flight, got %d", n)
+	}
+
+	// Waiting for a slot does not mark the endpoint offline.
+	atomic.StoreInt32(&clnt.healthStatus, online)
+	ctx, cancel := context.WithTimeout(low, 50*time.Millisecond)
+	defer cancel()
+	if _, err = clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the low priority request to wait, got %v", err)
+	}
+	if clnt.IsOffline() {
+		t.Fatal("expected the client to stay online")
+	}
+	// Health probes are not held up.
+	if !clnt.probeHealth(low, "probe-bucket") {
+		t.Fatal("expected the health probe to succeed")
+	}
+	// Requests of other priorities are not held up.
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	body.Close()
+	if _, err = clnt.StatObject(low, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := clnt.RequestsInFlight(PriorityLow); n != 0 {
+		t.Fatalf("expected no low priority request in flight, got %d", n)
+	}
+}
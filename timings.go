@@ -0,0 +1,151 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestTimings are the connection-level timings of a request, which
+// separate the network latency from the server latency. The durations
+// of the phases skipped on a reused connection are zero.
+type RequestTimings struct {
+	// ReusedConn is true if the request reused an idle connection.
+	ReusedConn bool
+
+	// DNSLookup is the time spent resolving the host name.
+	DNSLookup time.Duration
+
+	// Connect is the time spent establishing the TCP connection.
+	Connect time.Duration
+
+	// TLSHandshake is the time spent in the TLS handshake.
+	TLSHandshake time.Duration
+
+	// ServerProcessing is the time from writing the request to the
+	// first byte of the response.
+	ServerProcessing time.Duration
+
+	// TimeToFirstByte is the time from sending the request, including
+	// getting a connection, to the first byte of the response.
+	TimeToFirstByte time.Duration
+
+	// Total is the time until the response headers were read or the
+	// request failed.
+	Total time.Duration
+}
+
+type requestTimingsKey struct{}
+
+// WithRequestTimings returns a copy of ctx with which every request
+// sent, including retries, calls fn with its connection-level timings
+// once its response headers are read or it failed. Unlike Options.Trace
+// it applies only to the calls made with the returned context.
+func WithRequestTimings(ctx context.Context, fn func(req *http.Request, timings RequestTimings)) context.Context {
+	fns, _ := ctx.Value(requestTimingsKey{}).([]func(*http.Request, RequestTimings))
+	return context.WithValue(ctx, requestTimingsKey{}, append(fns[:len(fns):len(fns)], fn))
+}
+
+// requestTimer records the timings of a request with httptrace. Its
+// hooks may be called concurrently while dialing.
+type requestTimer struct {
+	mu sync.Mutex
+
+	start, dnsStart, connectStart, tlsStart, wroteRequest, firstByte time.Time
+
+	timings RequestTimings
+}
+
+// traceRequestTimings returns the request with its timings recorded
+// and a function to report them, or the request as is and a nil
+// function if its context has no WithRequestTimings callbacks.
+func traceRequestTimings(req *http.Request) (*http.Request, func()) {
+	fns, _ := req.Context().Value(requestTimingsKey{}).([]func(*http.Request, RequestTimings))
+	if len(fns) == 0 {
+		return req, nil
+	}
+	t := &requestTimer{start: time.Now()}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), t.clientTrace()))
+	return req, func() {
+		t.mu.Lock()
+		timings := t.timings
+		timings.Total = time.Since(t.start)
+		t.mu.Unlock()
+		for _, fn := range fns {
+			fn(req, timings)
+		}
+	}
+}
+
+func (t *requestTimer) record(fn func()) {
+	t.mu.Lock()
+	fn()
+	t.mu.Unlock()
+}
+
+func (t *requestTimer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.record(func() { t.timings.ReusedConn = info.Reused })
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.record(func() { t.dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.record(func() { t.timings.DNSLookup = time.Since(t.dnsStart) })
+		},
+		ConnectStart: func(_, _ string) {
+			t.record(func() {
+				// Only the first of parallel dials is timed.
+				if t.connectStart.IsZero() {
+					t.connectStart = time.Now()
+				}
+			})
+		},
+		ConnectDone: func(_, _ string, err error) {
+			t.record(func() {
+				if err == nil && t.timings.Connect == 0 {
+					t.timings.Connect = time.Since(t.connectStart)
+				}
+			})
+		},
+		TLSHandshakeStart: func() {
+			t.record(func() { t.tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.record(func() { t.timings.TLSHandshake = time.Since(t.tlsStart) })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			t.record(func() { t.wroteRequest = time.Now() })
+		},
+		GotFirstResponseByte: func() {
+			t.record(func() {
+				now := time.Now()
+				t.timings.TimeToFirstByte = now.Sub(t.start)
+				if !t.wroteRequest.IsZero() {
+					t.timings.ServerProcessing = now.Sub(t.wroteRequest)
+				}
+			})
+		},
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithRequestTimings(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "hello"})
+
+	var timings []RequestTimings
+	ctx := WithRequestTimings(context.Background(), func(req *http.Request, rt RequestTimings) {
+		if req.Method != http.MethodHead {
+			t.Errorf("unexpected request %s", req.Method)
+		}
+		timings = append(timings, rt)
+	})
+	for range 2 {
+		if _, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Not timed.
+	if _, err := clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(timings) != 2 {
+		t.Fatalf("expected 2 timings, got %d", len(timings))
+	}
+	for _, rt := range timings {
+		if rt.TimeToFirstByte <= 0 || rt.ServerProcessing <= 0 || rt.Total < rt.TimeToFirstByte ||
+			rt.ServerProcessing > rt.TimeToFirstByte {
+			t.Fatalf("unexpected timings: %+v", rt)
+		}
+	}
+	if !timings[1].ReusedConn || timings[1].Connect != 0 {
+		t.Fatalf("expected the connection to be reused: %+v", timings[1])
+	}
+}
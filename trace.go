@@ -0,0 +1,179 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TraceOptions configures the HTTP tracing enabled by TraceOnWithOptions.
+type TraceOptions struct {
+	// Output receives the traces, os.Stdout if nil.
+	Output io.Writer
+
+	// ErrorsOnly traces only the requests whose response status is not
+	// 200 OK, as TraceErrorsOnlyOn.
+	ErrorsOnly bool
+
+	// Operations restricts tracing to the listed operations. An
+	// operation is named by its HTTP method followed by its sorted
+	// sub-resources, as in Options.Logger, e.g. "GET ?tagging" or
+	// "PUT ?partNumber&uploadId". A bare method such as "DELETE"
+	// matches all the requests with that method. All operations are
+	// traced if empty.
+	Operations []string
+
+	// SampleRate is the fraction of successful requests traced,
+	// between 0 and 1. Failed requests are always traced. All requests
+	// are traced if zero.
+	SampleRate float64
+
+	// MaxBodyBytes limits the bytes of error response bodies written to
+	// the trace, the rest is left unread for the caller. Error bodies
+	// are traced in full if zero.
+	MaxBodyBytes int
+
+	// JSON writes one JSON object per request instead of the raw HTTP
+	// dump, with the redacted request and response headers.
+	JSON bool
+}
+
+// TraceOnWithOptions - enable HTTP tracing configured by opts.
+func (c *Client) TraceOnWithOptions(opts TraceOptions) {
+	// if the output is nil then default to os.Stdout.
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	c.traceOutput = opts.Output
+	c.traceErrorsOnly = opts.ErrorsOnly
+	c.traceOpts = opts
+
+	// Enable tracing.
+	c.isTraceEnabled = true
+}
+
+// traceSelected reports whether the request passes the operation filter
+// and sampling of the trace options.
+func (c *Client) traceSelected(req *http.Request, resp *http.Response) bool {
+	if ops := c.traceOpts.Operations; len(ops) > 0 {
+		op := requestOperation(req.Method, req.URL.Query())
+		if !slices.Contains(ops, op) && !slices.Contains(ops, req.Method) {
+			return false
+		}
+	}
+	if !slices.Contains(successStatus, resp.StatusCode) {
+		return true
+	}
+	rate := c.traceOpts.SampleRate
+	return rate <= 0 || rate >= 1 || c.random.Float64() < rate
+}
+
+// readTraceBody reads up to limit bytes of the response body for the
+// trace, all of it if limit is not positive, and puts them back in
+// front of the unread rest of the body.
+func readTraceBody(resp *http.Response, limit int) (body []byte, truncated bool, err error) {
+	r := io.Reader(resp.Body)
+	if limit > 0 {
+		r = io.LimitReader(resp.Body, int64(limit)+1)
+	}
+	body, err = io.ReadAll(r)
+	if err != nil {
+		return nil, false, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if limit > 0 && len(body) > limit {
+		return body[:limit], true, nil
+	}
+	return body, false, nil
+}
+
+// dumpResponseLimited dumps the response with at most limit bytes of
+// its body.
+func dumpResponseLimited(resp *http.Response, limit int) ([]byte, error) {
+	dump, err := httputil.DumpResponse(resp, false)
+	if err != nil {
+		return nil, err
+	}
+	body, truncated, err := readTraceBody(resp, limit)
+	if err != nil {
+		return nil, err
+	}
+	dump = append(dump, body...)
+	if truncated {
+		dump = append(dump, "...(truncated)"...)
+	}
+	return append(dump, "\r\n"...), nil
+}
+
+// traceEntry is a request traced in JSON.
+type traceEntry struct {
+	Time           time.Time   `json:"time"`
+	Operation      string      `json:"operation"`
+	Method         string      `json:"method"`
+	URL            string      `json:"url"`
+	Status         int         `json:"status"`
+	Duration       string      `json:"duration"`
+	RequestHeader  http.Header `json:"requestHeader"`
+	ResponseHeader http.Header `json:"responseHeader"`
+	ResponseBody   string      `json:"responseBody,omitempty"`
+	Truncated      bool        `json:"truncated,omitempty"`
+}
+
+// dumpHTTPJSON writes the request and response as a JSON trace entry,
+// with the body of error responses.
+func (c *Client) dumpHTTPJSON(req *http.Request, resp *http.Response, duration time.Duration) error {
+	reqHeader := req.Header.Clone()
+	for k := range reqHeader {
+		switch {
+		case k == "Authorization":
+			reqHeader.Set(k, redactSignature(reqHeader.Get(k)))
+		case redactedHeaders[k]:
+			reqHeader.Set(k, "**REDACTED**")
+		}
+	}
+	entry := traceEntry{
+		Time:           time.Now().UTC(),
+		Operation:      requestOperation(req.Method, req.URL.Query()),
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		Status:         resp.StatusCode,
+		Duration:       duration.String(),
+		RequestHeader:  reqHeader,
+		ResponseHeader: resp.Header,
+	}
+	if !slices.Contains(successStatus, resp.StatusCode) {
+		body, truncated, err := readTraceBody(resp, c.traceOpts.MaxBodyBytes)
+		if err != nil {
+			return err
+		}
+		entry.ResponseBody = strings.TrimSpace(string(body))
+		entry.Truncated = truncated
+	}
+	return json.NewEncoder(c.traceOutput).Encode(entry)
+}
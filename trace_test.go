@@ -0,0 +1,91 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTraceOnWithOptions(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	putTestObjects(t, clnt, map[string]string{"object": "hello"})
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	clnt.TraceOnWithOptions(TraceOptions{
+		Output:       &buf,
+		Operations:   []string{"HEAD", "GET ?tagging"},
+		MaxBodyBytes: 16,
+		JSON:         true,
+	})
+	if _, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clnt.GetObjectTagging(ctx, "bucket", "missing", GetObjectTaggingOptions{}); err == nil {
+		t.Fatal("expected an error")
+	} else if ToErrorResponse(err).Code != "NoSuchKey" {
+		// The truncated body trace must leave the error readable.
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Not a traced operation.
+	if _, err := clnt.PutObject(ctx, "bucket", "other", strings.NewReader("x"), 1, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []traceEntry
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry traceEntry
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Operation != "HEAD" || entries[0].Status != 200 || entries[0].ResponseBody != "" {
+		t.Fatalf("unexpected entry: %+v", entries[0])
+	}
+	if entries[1].Operation != "GET ?tagging" || entries[1].Status != 404 ||
+		len(entries[1].ResponseBody) != 16 || !entries[1].Truncated {
+		t.Fatalf("unexpected entry: %+v", entries[1])
+	}
+	if auth := entries[0].RequestHeader.Get("Authorization"); !strings.Contains(auth, "**REDACTED**") {
+		t.Fatalf("expected a redacted authorization header, got %q", auth)
+	}
+
+	// Successful requests are sampled, failed ones are always traced.
+	buf.Reset()
+	clnt.TraceOnWithOptions(TraceOptions{Output: &buf, SampleRate: 0.000001})
+	for range 10 {
+		clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no sampled traces, got %s", buf.String())
+	}
+	clnt.StatObject(ctx, "bucket", "missing", StatObjectOptions{})
+	if !strings.Contains(buf.String(), "404 Not Found") {
+		t.Fatalf("expected the failed request to be traced, got %s", buf.String())
+	}
+	clnt.TraceOff()
+}
@@ -0,0 +1,289 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding"
+	"fmt"
+	"mime"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+// UserMetadataEncoding is the encoding of the values of user metadata,
+// which must be valid HTTP header values, i.e. printable ASCII.
+type UserMetadataEncoding int
+
+const (
+	// UserMetadataRFC2047 encodes values which are not printable ASCII
+	// as RFC 2047 encoded words like "=?utf-8?q?caf=C3=A9?=", the way
+	// S3 returns such values and most clients decode them.
+	UserMetadataRFC2047 UserMetadataEncoding = iota
+	// UserMetadataPercent percent-encodes all values, like URL paths.
+	UserMetadataPercent
+)
+
+// userMetadataTag is the struct tag of fields of user metadata, see
+// MarshalUserMetadata.
+const userMetadataTag = "minio"
+
+// validUserMetadataKey checks the key of user metadata, with or without
+// the "X-Amz-Meta-" prefix, the way PutObject does.
+func validUserMetadataKey(k string) error {
+	if !httpguts.ValidHeaderFieldName(k) || isStandardHeader(k) || isSSEHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
+		return errInvalidArgument(k + " unsupported user defined metadata name")
+	}
+	return nil
+}
+
+// EncodeUserMetadata checks the keys of user metadata and encodes its
+// values with enc, so it can be set as PutObjectOptions.UserMetadata
+// without failing the upload or its signature. Keys are case
+// insensitive, they must be HTTP header names which are not standard
+// headers like Content-Type.
+func EncodeUserMetadata(meta map[string]string, enc UserMetadataEncoding) (map[string]string, error) {
+	encoded := make(map[string]string, len(meta))
+	for k, v := range meta {
+		if err := validUserMetadataKey(k); err != nil {
+			return nil, err
+		}
+		switch enc {
+		case UserMetadataRFC2047:
+			v = mime.QEncoding.Encode("utf-8", v)
+		case UserMetadataPercent:
+			v = url.PathEscape(v)
+		default:
+			return nil, errInvalidArgument(fmt.Sprintf("unknown user metadata encoding %d", enc))
+		}
+		if !httpguts.ValidHeaderFieldValue(v) {
+			return nil, errInvalidArgument(v + " unsupported user defined metadata value")
+		}
+		encoded[k] = v
+	}
+	return encoded, nil
+}
+
+// DecodeUserMetadata decodes the values of user metadata encoded with
+// enc, e.g. ObjectInfo.UserMetadata. Values which are not encoded, or
+// not validly, are returned as is.
+func DecodeUserMetadata(meta map[string]string, enc UserMetadataEncoding) map[string]string {
+	decoded := make(map[string]string, len(meta))
+	dec := new(mime.WordDecoder)
+	for k, v := range meta {
+		switch enc {
+		case UserMetadataRFC2047:
+			if s, err := dec.DecodeHeader(v); err == nil {
+				v = s
+			}
+		case UserMetadataPercent:
+			if s, err := url.PathUnescape(v); err == nil {
+				v = s
+			}
+		}
+		decoded[k] = v
+	}
+	return decoded
+}
+
+// userMetadataField is a struct field of user metadata.
+type userMetadataField struct {
+	index     int
+	key       string
+	omitEmpty bool
+}
+
+// userMetadataFields returns the fields of user metadata of a struct
+// type, tagged `minio:"meta,<key>"` with an optional ",omitempty".
+func userMetadataFields(t reflect.Type) ([]userMetadataField, error) {
+	var fields []userMetadataField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(userMetadataTag)
+		if !ok || tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		if opts[0] != "meta" {
+			continue
+		}
+		if len(opts) < 2 || opts[1] == "" {
+			return nil, errInvalidArgument(fmt.Sprintf("field %s has no user metadata key", f.Name))
+		}
+		if !f.IsExported() {
+			return nil, errInvalidArgument(fmt.Sprintf("field %s of user metadata is not exported", f.Name))
+		}
+		if err := validUserMetadataKey(opts[1]); err != nil {
+			return nil, err
+		}
+		field := userMetadataField{index: i, key: opts[1]}
+		for _, opt := range opts[2:] {
+			if opt != "omitempty" {
+				return nil, errInvalidArgument(fmt.Sprintf("field %s has unknown option %q", f.Name, opt))
+			}
+			field.omitEmpty = true
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// structValue returns the struct v points to, or v if it is a struct.
+func structValue(v interface{}, settable bool) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	} else if settable {
+		return reflect.Value{}, errInvalidArgument(fmt.Sprintf("user metadata must be decoded into a struct pointer, not %T", v))
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, errInvalidArgument(fmt.Sprintf("user metadata must be a struct, not %T", v))
+	}
+	return rv, nil
+}
+
+// MarshalUserMetadata returns the user metadata of the fields of v, a
+// struct or a pointer to a struct, tagged `minio:"meta,<key>"`:
+//
+//	type Document struct {
+//		Author  string    `minio:"meta,author"`
+//		Created time.Time `minio:"meta,created,omitempty"`
+//	}
+//
+// Fields may be strings, booleans, numbers, time.Time, formatted with
+// RFC 3339, or implement encoding.TextMarshaler. Fields tagged
+// omitempty are left out if zero. The values are not encoded, see
+// EncodeUserMetadata.
+func MarshalUserMetadata(v interface{}) (map[string]string, error) {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := userMetadataFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	meta := make(map[string]string, len(fields))
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+		if field.omitEmpty && fv.IsZero() {
+			continue
+		}
+		s, err := formatUserMetadataValue(fv)
+		if err != nil {
+			return nil, errInvalidArgument(fmt.Sprintf("user metadata %s: %v", field.key, err))
+		}
+		meta[field.key] = s
+	}
+	return meta, nil
+}
+
+// UnmarshalUserMetadata sets the fields of the struct v points to from
+// user metadata, e.g. the decoded ObjectInfo.UserMetadata, see
+// MarshalUserMetadata. Keys are matched case insensitively, with or
+// without the "X-Amz-Meta-" prefix. Fields without metadata are left
+// unchanged.
+func UnmarshalUserMetadata(meta map[string]string, v interface{}) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+	fields, err := userMetadataFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string, len(meta))
+	for key, value := range meta {
+		values[strings.TrimPrefix(strings.ToLower(key), "x-amz-meta-")] = value
+	}
+	for _, field := range fields {
+		s, ok := values[strings.TrimPrefix(strings.ToLower(field.key), "x-amz-meta-")]
+		if !ok {
+			continue
+		}
+		if err := parseUserMetadataValue(rv.Field(field.index), s); err != nil {
+			return errInvalidArgument(fmt.Sprintf("user metadata %s: %v", field.key, err))
+		}
+	}
+	return nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// formatUserMetadataValue formats a field of user metadata, time.Time
+// being a TextMarshaler formatted with RFC 3339.
+func formatUserMetadataValue(v reflect.Value) (string, error) {
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// parseUserMetadataValue sets a field of user metadata.
+func parseUserMetadataValue(v reflect.Value, s string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testDocument struct {
+	Author   string    `minio:"meta,author"`
+	Pages    int       `minio:"meta,pages"`
+	Draft    bool      `minio:"meta,draft,omitempty"`
+	Ratio    float64   `minio:"meta,X-Amz-Meta-Ratio,omitempty"`
+	Created  time.Time `minio:"meta,created"`
+	Internal string
+	Skipped  string `minio:"-"`
+}
+
+func TestUserMetadataEncoding(t *testing.T) {
+	meta := map[string]string{
+		"author": "José Müller",
+		"title":  "plain ascii",
+		"note":   "100% \"quoted\"\nline",
+	}
+	for _, enc := range []UserMetadataEncoding{UserMetadataRFC2047, UserMetadataPercent} {
+		encoded, err := EncodeUserMetadata(meta, enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enc == UserMetadataRFC2047 && encoded["title"] != "plain ascii" {
+			t.Errorf("expected ASCII value not to be encoded, got %q", encoded["title"])
+		}
+		if decoded := DecodeUserMetadata(encoded, enc); !reflect.DeepEqual(decoded, meta) {
+			t.Errorf("encoding %d: expected %v, got %v", enc, meta, decoded)
+		}
+	}
+
+	for _, key := range []string{"Content-Type", "invalid key", "X-Minio-Internal", "X-Amz-Server-Side-Encryption", ""} {
+		if _, err := EncodeUserMetadata(map[string]string{key: "value"}, UserMetadataRFC2047); err == nil {
+			t.Errorf("expected key %q to be invalid", key)
+		}
+	}
+}
+
+func TestMarshalUserMetadata(t *testing.T) {
+	created := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	doc := testDocument{Author: "José", Pages: 12, Created: created, Internal: "x", Skipped: "y"}
+	meta, err := MarshalUserMetadata(&doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"author": "José", "pages": "12", "created": "2025-03-04T05:06:07Z"}
+	if !reflect.DeepEqual(meta, expected) {
+		t.Fatalf("expected %v, got %v", expected, meta)
+	}
+
+	var decoded testDocument
+	if err = UnmarshalUserMetadata(map[string]string{
+		"Author":           "José",
+		"X-Amz-Meta-Pages": "12",
+		"Draft":            "true",
+		"Ratio":            "0.5",
+		"Created":          "2025-03-04T05:06:07Z",
+	}, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Author != "José" || decoded.Pages != 12 || !decoded.Draft || decoded.Ratio != 0.5 || !decoded.Created.Equal(created) {
+		t.Fatalf("unexpected document %+v", decoded)
+	}
+
+	if err = UnmarshalUserMetadata(map[string]string{"pages": "many"}, &decoded); err == nil {
+		t.Error("expected invalid number to fail")
+	}
+	if err = UnmarshalUserMetadata(nil, decoded); err == nil {
+		t.Error("expected non pointer to fail")
+	}
+	if _, err = MarshalUserMetadata(struct {
+		Tags []string `minio:"meta,tags"`
+	}{}); err == nil {
+		t.Error("expected unsupported type to fail")
+	}
+	if _, err = MarshalUserMetadata(struct {
+		Type string `minio:"meta,Content-Type"`
+	}{}); err == nil {
+		t.Error("expected standard header key to fail")
+	}
+}
+
+func TestUserMetadataRoundTrip(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+
+	doc := testDocument{Author: "Zoë Ångström", Pages: 3, Created: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)}
+	meta, err := MarshalUserMetadata(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta, err = EncodeUserMetadata(meta, UserMetadataRFC2047); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.PutObject(ctx, "bucket", "doc", strings.NewReader("content"), 7, PutObjectOptions{UserMetadata: meta}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := clnt.StatObject(ctx, "bucket", "doc", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded testDocument
+	if err = UnmarshalUserMetadata(DecodeUserMetadata(info.UserMetadata, UserMetadataRFC2047), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Author != doc.Author || decoded.Pages != doc.Pages || !decoded.Created.Equal(doc.Created) {
+		t.Fatalf("expected %+v, got %+v", doc, decoded)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -49,17 +50,33 @@ func trimEtag(etag string) string {
 	return strings.TrimSuffix(etag, "\"")
 }
 
-var expirationRegex = regexp.MustCompile(`expiry-date="(.*?)", rule-id="(.*?)"`)
+var expirationRegex = regexp.MustCompile(`([a-z-]+)="(.*?)"`)
 
+// amzExpirationToExpiryDateRuleID parses the x-amz-expiration header,
+// e.g. `expiry-date="Fri, 23 Dec 2012 00:00:00 GMT", rule-id="rule%201"`.
+// S3 URL-encodes the rule ID.
 func amzExpirationToExpiryDateRuleID(expiration string) (time.Time, string) {
-	if matches := expirationRegex.FindStringSubmatch(expiration); len(matches) == 3 {
-		expTime, err := parseRFC7231Time(matches[1])
-		if err != nil {
-			return time.Time{}, ""
+	var expTime time.Time
+	var ruleID string
+	for _, matches := range expirationRegex.FindAllStringSubmatch(expiration, -1) {
+		switch matches[1] {
+		case "expiry-date":
+			t, err := parseRFC7231Time(matches[2])
+			if err != nil {
+				return time.Time{}, ""
+			}
+			expTime = t
+		case "rule-id":
+			ruleID = matches[2]
+			if id, err := url.PathUnescape(ruleID); err == nil {
+				ruleID = id
+			}
 		}
-		return expTime, matches[2]
 	}
-	return time.Time{}, ""
+	if expTime.IsZero() {
+		return time.Time{}, ""
+	}
+	return expTime, ruleID
 }
 
 var restoreRegex = regexp.MustCompile(`ongoing-request="(.*?)"(, expiry-date="(.*?)")?`)
@@ -193,6 +210,41 @@ func isValidExpiry(expires time.Duration) error {
 	return nil
 }
 
+// genericResponseHeaders are the standard HTTP headers which are not
+// kept by extractResponseHeader.
+var genericResponseHeaders = map[string]bool{
+	"Accept-Ranges":             true,
+	"Connection":                true,
+	"Content-Length":            true,
+	"Content-Security-Policy":   true,
+	"Date":                      true,
+	"Keep-Alive":                true,
+	"Server":                    true,
+	"Strict-Transport-Security": true,
+	"Transfer-Encoding":         true,
+	"Vary":                      true,
+	"X-Content-Type-Options":    true,
+	"X-Xss-Protection":          true,
+}
+
+// uploadResponseHeader returns the response headers kept by UploadInfo.
+func uploadResponseHeader(header http.Header) *http.Header {
+	h := extractResponseHeader(header)
+	return &h
+}
+
+// extractResponseHeader returns a copy of the response headers without
+// the generic ones, keeping x-amz-*, x-minio-* and custom headers.
+func extractResponseHeader(header http.Header) http.Header {
+	filteredHeader := make(http.Header, len(header))
+	for k, v := range header {
+		if !genericResponseHeaders[k] {
+			filteredHeader[k] = slices.Clone(v)
+		}
+	}
+	return filteredHeader
+}
+
 // Extract only necessary metadata header key/values by
 // filtering them out with a list of custom header keys.
 func extractObjMetadata(header http.Header) http.Header {
@@ -357,6 +409,25 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 		restore = &RestoreInfo{OngoingRestore: ongoing, ExpiryTime: expTime}
 	}
 
+	lock := ObjectLockInfo{
+		Mode:      RetentionMode(h.Get(amzLockMode)),
+		LegalHold: LegalHoldStatus(h.Get(amzLegalHoldHeader)),
+	}
+	if until := h.Get(amzLockRetainUntil); until != "" {
+		lock.RetainUntilDate, err = time.Parse(time.RFC3339, until)
+		if err != nil {
+			return ObjectInfo{}, ErrorResponse{
+				Code:       "InternalError",
+				Message:    fmt.Sprintf("'%s' is not in supported format: %v", amzLockRetainUntil, err),
+				BucketName: bucketName,
+				Key:        objectName,
+				RequestID:  h.Get("x-amz-request-id"),
+				HostID:     h.Get("x-amz-id-2"),
+				Region:     h.Get("x-amz-bucket-region"),
+			}
+		}
+	}
+
 	// extract lifecycle expiry date and rule ID
 	expTime, ruleID := amzExpirationToExpiryDateRuleID(h.Get(amzExpiration))
 
@@ -370,6 +441,7 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 		LastModified:      mtime,
 		ContentType:       contentType,
 		Expires:           expiry,
+		StorageClass:      h.Get(amzStorageClass),
 		VersionID:         h.Get(amzVersionID),
 		IsDeleteMarker:    deleteMarker,
 		ReplicationStatus: h.Get(amzReplicationStatus),
@@ -391,6 +463,11 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 		ChecksumSHA256:    h.Get(ChecksumSHA256.Key()),
 		ChecksumCRC64NVME: h.Get(ChecksumCRC64NVME.Key()),
 		ChecksumMode:      h.Get(ChecksumFullObjectMode.Key()),
+
+		Encryption: encryptionInfo(h),
+		ObjectLock: lock,
+
+		ResponseHeader: extractResponseHeader(h),
 	}, nil
 }
 
@@ -68,6 +68,27 @@ func TestParseRFC7231Time(t *testing.T) {
 	}
 }
 
+func TestAmzExpirationToExpiryDateRuleID(t *testing.T) {
+	date := time.Date(2012, 12, 23, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		header string
+		date   time.Time
+		ruleID string
+	}{
+		{`expiry-date="Sun, 23 Dec 2012 00:00:00 GMT", rule-id="picture-deletion-rule"`, date, "picture-deletion-rule"},
+		{`rule-id="my%20rule", expiry-date="Sun, 23 Dec 2012 00:00:00 GMT"`, date, "my rule"},
+		{`expiry-date="Sun, 23 Dec 2012 00:00:00 GMT"`, date, ""},
+		{`expiry-date="yesterday", rule-id="rule"`, time.Time{}, ""},
+		{``, time.Time{}, ""},
+	}
+	for i, tc := range testCases {
+		gotDate, gotRuleID := amzExpirationToExpiryDateRuleID(tc.header)
+		if !gotDate.Equal(tc.date) || gotRuleID != tc.ruleID {
+			t.Errorf("Test %d: expected %v %q, got %v %q", i+1, tc.date, tc.ruleID, gotDate, gotRuleID)
+		}
+	}
+}
+
 // Tests signature redacting function used
 // in filtering on-wire Authorization header.
 func TestRedactSignature(t *testing.T) {
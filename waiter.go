@@ -0,0 +1,155 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jie123108/minio-go/v7/pkg/replication"
+)
+
+// WaiterOptions configures how the WaitUntil functions poll.
+type WaiterOptions struct {
+	// MinDelay is the delay before the second poll, defaults to
+	// one second. The delay doubles after every poll.
+	MinDelay time.Duration
+	// MaxDelay caps the delay between polls, defaults to 30 seconds.
+	MaxDelay time.Duration
+	// MaxWait bounds the total time waited, after which the waiter
+	// fails with context.DeadlineExceeded. Zero waits until ctx is
+	// done.
+	MaxWait time.Duration
+
+	// StatOptions are used to look up objects, e.g. to wait for a
+	// specific version.
+	StatOptions StatObjectOptions
+}
+
+func (o WaiterOptions) delays() (minDelay, maxDelay time.Duration) {
+	minDelay, maxDelay = o.MinDelay, o.MaxDelay
+	if minDelay <= 0 {
+		minDelay = time.Second
+	}
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	return minDelay, max(minDelay, maxDelay)
+}
+
+// wait calls check with exponentially increasing delays until it
+// reports done, fails, or ctx is done.
+func wait(ctx context.Context, opts WaiterOptions, check func(context.Context) (bool, error)) error {
+	if opts.MaxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.MaxWait)
+		defer cancel()
+	}
+	minDelay, maxDelay := opts.delays()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for delay := minDelay; ; delay = min(2*delay, maxDelay) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+		done, err := check(ctx)
+		if err != nil {
+			// Report the deadline rather than the failed request.
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+		timer.Reset(delay)
+	}
+}
+
+// isNotFound returns true for errors of missing buckets and objects.
+func isNotFound(err error) bool {
+	errResp := ToErrorResponse(err)
+	switch errResp.Code {
+	case "NoSuchKey", "NoSuchBucket", "NoSuchVersion":
+		return true
+	}
+	return errResp.StatusCode == http.StatusNotFound
+}
+
+// WaitUntilObjectExists polls until the object exists and returns its
+// info, e.g. to wait for an upload by another process or replication.
+func WaitUntilObjectExists(ctx context.Context, c *Client, bucketName, objectName string, opts WaiterOptions) (info ObjectInfo, err error) {
+	err = wait(ctx, opts, func(ctx context.Context) (bool, error) {
+		var serr error
+		info, serr = c.StatObject(ctx, bucketName, objectName, opts.StatOptions)
+		if isNotFound(serr) {
+			return false, nil
+		}
+		return serr == nil, serr
+	})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return info, nil
+}
+
+// WaitUntilObjectNotExists polls until the object is removed.
+func WaitUntilObjectNotExists(ctx context.Context, c *Client, bucketName, objectName string, opts WaiterOptions) error {
+	return wait(ctx, opts, func(ctx context.Context) (bool, error) {
+		_, err := c.StatObject(ctx, bucketName, objectName, opts.StatOptions)
+		if isNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// WaitUntilBucketExists polls until the bucket exists.
+func WaitUntilBucketExists(ctx context.Context, c *Client, bucketName string, opts WaiterOptions) error {
+	return wait(ctx, opts, func(ctx context.Context) (bool, error) {
+		return c.BucketExists(ctx, bucketName)
+	})
+}
+
+// WaitUntilBucketNotExists polls until the bucket is removed.
+func WaitUntilBucketNotExists(ctx context.Context, c *Client, bucketName string, opts WaiterOptions) error {
+	return wait(ctx, opts, func(ctx context.Context) (bool, error) {
+		found, err := c.BucketExists(ctx, bucketName)
+		return !found, err
+	})
+}
+
+// WaitUntilReplicationResyncDone polls the resync status of the bucket
+// until the resync of the target arn, or of all targets if arn is
+// empty, is no longer pending or ongoing, and returns the final status.
+func WaitUntilReplicationResyncDone(ctx context.Context, c *Client, bucketName, arn string, opts WaiterOptions) (info replication.ResyncTargetsInfo, err error) {
+	err = wait(ctx, opts, func(ctx context.Context) (bool, error) {
+		var serr error
+		info, serr = c.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+		return serr == nil && info.IsDone(), serr
+	})
+	if err != nil {
+		return replication.ResyncTargetsInfo{}, err
+	}
+	return info, nil
+}
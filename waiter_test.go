@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaiters(t *testing.T) {
+	_, clnt := newTestServerClient(t)
+	ctx := context.Background()
+	opts := WaiterOptions{MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, MaxWait: 5 * time.Second}
+
+	errCh := make(chan error, 1)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		if err := clnt.MakeBucket(ctx, "later", MakeBucketOptions{}); err != nil {
+			errCh <- err
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, err := clnt.PutObject(ctx, "later", "object", strings.NewReader("data"), 4, PutObjectOptions{})
+		errCh <- err
+	}()
+
+	if err := WaitUntilBucketExists(ctx, clnt, "later", opts); err != nil {
+		t.Fatal(err)
+	}
+	info, err := WaitUntilObjectExists(ctx, clnt, "later", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != 4 {
+		t.Fatalf("unexpected object info %+v", info)
+	}
+	if err = <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		errCh <- clnt.RemoveObject(ctx, "later", "object", RemoveObjectOptions{})
+	}()
+	if err = WaitUntilObjectNotExists(ctx, clnt, "later", "object", opts); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-errCh; err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RemoveBucket(ctx, "later"); err != nil {
+		t.Fatal(err)
+	}
+	if err = WaitUntilBucketNotExists(ctx, clnt, "later", opts); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.MaxWait = 30 * time.Millisecond
+	if _, err = WaitUntilObjectExists(ctx, clnt, "bucket", "never", opts); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if err = WaitUntilBucketExists(ctx, clnt, "x", opts); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected invalid bucket name error, got %v", err)
+	}
+}